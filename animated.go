@@ -0,0 +1,86 @@
+package giosvg
+
+import (
+	"image"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/inkeliz/giosvg/internal/svgdraw"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// AnimatedIcon draws the Document with the SMIL animations, such as
+// <animate>, <animateTransform>, <animateMotion> and <set>. The
+// animation time is based on gtx.Now, and a redraw is requested
+// while it's playing.
+//
+// The AnimatedIcon starts playing, use Pause to stop it.
+type AnimatedIcon struct {
+	doc *Document
+
+	playing  bool
+	position time.Duration
+	last     time.Time
+}
+
+// NewAnimatedIcon creates the layout.Widget from the Document.
+func NewAnimatedIcon(doc *Document) *AnimatedIcon {
+	return &AnimatedIcon{doc: doc, playing: true}
+}
+
+// Play resumes the animation from the current position.
+func (icon *AnimatedIcon) Play() {
+	if !icon.playing {
+		icon.playing, icon.last = true, time.Time{}
+	}
+}
+
+// Pause stops the animation at the current position.
+func (icon *AnimatedIcon) Pause() {
+	icon.playing = false
+}
+
+// Seek moves the animation to the given time.
+func (icon *AnimatedIcon) Seek(t time.Duration) {
+	if t < 0 {
+		t = 0
+	}
+	icon.position = t
+}
+
+// Playing reports whether the animation is playing.
+func (icon *AnimatedIcon) Playing() bool {
+	return icon.playing
+}
+
+// Position returns the current time of the animation.
+func (icon *AnimatedIcon) Position() time.Duration {
+	return icon.position
+}
+
+// Layout implements widget.Layout.
+// It will render the icon based on the given layout.Constraints.Max.
+// If the SVG uses `currentColor` you can set the color using
+// paint.ColorOp.
+func (icon *AnimatedIcon) Layout(gtx layout.Context) layout.Dimensions {
+	if icon.playing {
+		if !icon.last.IsZero() && gtx.Now.After(icon.last) {
+			icon.position += gtx.Now.Sub(icon.last)
+		}
+		icon.last = gtx.Now
+
+		if end, ok := icon.doc.Duration(); !ok || icon.position < end {
+			op.InvalidateOp{}.Add(gtx.Ops)
+		}
+	}
+
+	size, transform, scale := icon.doc.target(newConstraintsFromGio(gtx.Constraints))
+	icon.doc.render.DrawWith(&svgdraw.Driver{Ops: gtx.Ops, Scale: scale}, svgparser.DrawOptions{
+		Transform: transform,
+		Opacity:   1.0,
+		Overrides: icon.doc.animated(icon.position),
+	})
+
+	return layout.Dimensions{Size: image.Point{X: int(size.X), Y: int(size.Y)}}
+}
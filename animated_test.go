@@ -0,0 +1,58 @@
+package giosvg
+
+import (
+	"image"
+	"testing"
+	"time"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+func TestAnimatedIcon(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect width="10" height="10">
+			<animate attributeName="x" from="0" to="90" dur="1s" fill="freeze"/>
+		</rect>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, ok := doc.Duration(); !ok || d != time.Second {
+		t.Fatalf("expected the duration of 1s, got %v", d)
+	}
+
+	icon := NewAnimatedIcon(doc)
+	now := time.Unix(0, 0)
+	frame := func(elapsed time.Duration) {
+		now = now.Add(elapsed)
+		icon.Layout(layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(100, 100)), Now: now})
+	}
+
+	frame(0)
+	frame(250 * time.Millisecond)
+	if p := icon.Position(); p != 250*time.Millisecond {
+		t.Errorf("expected the position 250ms, got %v", p)
+	}
+
+	icon.Pause()
+	frame(time.Second)
+	if p := icon.Position(); p != 250*time.Millisecond || icon.Playing() {
+		t.Errorf("expected the paused position 250ms, got %v", p)
+	}
+
+	icon.Play()
+	frame(time.Second)
+	frame(100 * time.Millisecond)
+	if p := icon.Position(); p != 350*time.Millisecond {
+		t.Errorf("expected the position 350ms, got %v", p)
+	}
+
+	icon.Seek(500 * time.Millisecond)
+	frame(0)
+	options := svgparser.DrawOptions{Transform: svgparser.Identity, Overrides: doc.animated(icon.Position())}
+	if x := doc.render.PathBounds(0, options).Min.X; x != 45 {
+		t.Errorf("expected the rect at x = 45, got %v", x)
+	}
+}
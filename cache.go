@@ -0,0 +1,152 @@
+package giosvg
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+// IconCache keeps the drawings of many icons, for each size, and evicts the
+// least recently used once the capacity is reached. Unlike Icon, the same
+// CachedIcon can be used any number of times in the same frame, with
+// different sizes.
+//
+// The IconCache is safe to use from multiple goroutines, and it's
+// expected to be shared by all icons of the application.
+type IconCache struct {
+	capacity int
+
+	mutex   sync.Mutex
+	entries map[iconCacheKey]*list.Element
+	recent  *list.List // recent holds the *iconCacheEntry, the most recently used first
+	lastID  uint64
+}
+
+type iconCacheKey struct {
+	id          uint64
+	revision    uint64 // revision is the revision of the Document, see DocumentIcon
+	constraints layout.Constraints
+}
+
+type iconCacheEntry struct {
+	key        iconCacheKey
+	dimensions layout.Dimensions
+	macro      op.CallOp
+}
+
+// NewIconCache creates an IconCache which keeps, at most, `capacity`
+// drawings. Each size of each CachedIcon is one drawing.
+func NewIconCache(capacity int) *IconCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &IconCache{
+		capacity: capacity,
+		entries:  make(map[iconCacheKey]*list.Element),
+		recent:   list.New(),
+	}
+}
+
+// Icon creates the layout.Widget from the Vector, using the IconCache.
+// The CachedIcon should be created once, and used by all widgets which
+// draw the same Vector. The variants of the same Document, such as
+// the ThemeVector of each Theme, are different CachedIcon.
+//
+// The drawings are snapshots: once cached, the changes made by Element
+// aren't drawn. Use DocumentIcon for a Document which is changed.
+func (c *IconCache) Icon(vector Vector) *CachedIcon {
+	return &CachedIcon{cache: c, vector: vector, id: atomic.AddUint64(&c.lastID, 1)}
+}
+
+// DocumentIcon is like Icon, for the ThemeVector of the Document. The
+// Document is drawn again after the changes made by Element, and the
+// drawings of the previous changes are evicted as the least recently used.
+func (c *IconCache) DocumentIcon(doc *Document, theme Theme) *CachedIcon {
+	icon := c.Icon(doc.ThemeVector(theme))
+	icon.doc = doc
+	return icon
+}
+
+// Len returns the number of drawings in the IconCache.
+func (c *IconCache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.recent.Len()
+}
+
+// Reset removes all drawings from the IconCache.
+func (c *IconCache) Reset() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = make(map[iconCacheKey]*list.Element)
+	c.recent.Init()
+}
+
+// get returns the drawing, and marks it as the most recently used.
+func (c *IconCache) get(key iconCacheKey) (*iconCacheEntry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.recent.MoveToFront(e)
+	return e.Value.(*iconCacheEntry), true
+}
+
+// put adds the drawing, unless another goroutine added it first, and
+// evicts the least recently used drawings.
+func (c *IconCache) put(entry *iconCacheEntry) *iconCacheEntry {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if e, ok := c.entries[entry.key]; ok {
+		c.recent.MoveToFront(e)
+		return e.Value.(*iconCacheEntry)
+	}
+	c.entries[entry.key] = c.recent.PushFront(entry)
+	for c.recent.Len() > c.capacity {
+		last := c.recent.Back()
+		c.recent.Remove(last)
+		delete(c.entries, last.Value.(*iconCacheEntry).key)
+	}
+	return entry
+}
+
+// CachedIcon is the layout.Widget of one Vector, drawn using
+// the IconCache.
+type CachedIcon struct {
+	cache  *IconCache
+	vector Vector
+	id     uint64
+	doc    *Document // doc is nil unless created by DocumentIcon
+}
+
+// Layout implements widget.Layout.
+// It will render the icon based on the given layout.Constraints.Max.
+// If the SVG uses `currentColor` you can set the color using
+// paint.ColorOp.
+func (icon *CachedIcon) Layout(gtx layout.Context) layout.Dimensions {
+	key := iconCacheKey{id: icon.id, constraints: gtx.Constraints}
+	if icon.doc != nil {
+		key.revision = icon.doc.currentRevision()
+	}
+	entry, ok := icon.cache.get(key)
+	if !ok {
+		// Each drawing has its own op.Ops, which is never reset, so
+		// the evicted drawings remain valid in the current frame.
+		ops := new(op.Ops)
+		macro := op.Record(ops)
+		dimensions := icon.vector(ops, newConstraintsFromGio(gtx.Constraints))
+		entry = icon.cache.put(&iconCacheEntry{key: key, dimensions: dimensions, macro: macro.Stop()})
+	}
+
+	entry.macro.Add(gtx.Ops)
+	return entry.dimensions
+}
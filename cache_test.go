@@ -0,0 +1,126 @@
+package giosvg
+
+import (
+	"image"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestIconCache(t *testing.T) {
+	vector, err := NewVector([]byte(testIcon))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var draws int32
+	counted := func(ops *op.Ops, constraints Constraints) layout.Dimensions {
+		atomic.AddInt32(&draws, 1)
+		return vector(ops, constraints)
+	}
+
+	cache := NewIconCache(3)
+	icon := cache.Icon(counted)
+	other := cache.Icon(counted)
+
+	gtx := layout.Context{Ops: new(op.Ops)}
+	frame := func(icon *CachedIcon, sizes ...int) {
+		for _, size := range sizes {
+			gtx.Constraints = layout.Exact(image.Pt(size, size))
+			if dims := icon.Layout(gtx); dims.Size.X != size {
+				t.Errorf("unexpected size %v for %v", dims.Size, size)
+			}
+		}
+	}
+
+	frame(icon, 16, 24, 48, 16, 24, 48)
+	if draws != 3 || cache.Len() != 3 {
+		t.Errorf("expected 3 drawings, got %d draws and %d cached", draws, cache.Len())
+	}
+	frame(icon, 48, 24, 16)
+	if draws != 3 {
+		t.Errorf("expected the cached drawings, got %d draws", draws)
+	}
+
+	// The other icon evicts the least recently used, which is 48.
+	frame(other, 16)
+	if draws != 4 || cache.Len() != 3 {
+		t.Errorf("expected 4 drawings, got %d draws and %d cached", draws, cache.Len())
+	}
+	frame(icon, 24, 16)
+	if draws != 4 {
+		t.Errorf("expected the cached drawings, got %d draws", draws)
+	}
+	frame(icon, 48)
+	if draws != 5 {
+		t.Errorf("expected the evicted drawing, got %d draws", draws)
+	}
+
+	cache.Reset()
+	if cache.Len() != 0 {
+		t.Errorf("expected the empty cache, got %d", cache.Len())
+	}
+}
+
+func TestIconCacheDocument(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<rect id="valve" x="2" y="2" width="8" height="8"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewIconCache(4)
+	icon, snapshot := cache.DocumentIcon(doc, Theme{}), cache.Icon(doc.Vector())
+
+	gtx := layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(24, 24))}
+	icon.Layout(gtx)
+	snapshot.Layout(gtx)
+	if cache.Len() != 2 {
+		t.Fatalf("expected 2 drawings, got %d", cache.Len())
+	}
+
+	// Only the DocumentIcon is drawn again after the change.
+	doc.Element("valve").SetVisible(false)
+	icon.Layout(gtx)
+	snapshot.Layout(gtx)
+	if cache.Len() != 3 {
+		t.Errorf("expected 3 drawings, got %d", cache.Len())
+	}
+	icon.Layout(gtx)
+	if cache.Len() != 3 {
+		t.Errorf("expected the cached drawing, got %d drawings", cache.Len())
+	}
+}
+
+func TestIconCacheConcurrent(t *testing.T) {
+	vector, err := NewVector([]byte(testIcon))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache := NewIconCache(8)
+	icon := cache.Icon(vector)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			gtx := layout.Context{Ops: new(op.Ops)}
+			for j := 0; j < 50; j++ {
+				gtx.Ops.Reset()
+				size := 16 + (i+j)%12*4
+				gtx.Constraints = layout.Exact(image.Pt(size, size))
+				if dims := icon.Layout(gtx); dims.Size.X != size || dims.Size.Y != size {
+					t.Errorf("unexpected size %v for %v", dims.Size, size)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if cache.Len() > 8 {
+		t.Errorf("expected at most 8 drawings, got %d", cache.Len())
+	}
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/inkeliz/giosvg/internal/svgparser"
+	"github.com/inkeliz/giosvg/internal/svgraster"
+)
+
+var (
+	input      string
+	output     string
+	sizes      string
+	scales     string
+	background string
+	current    string
+	crop       bool
+)
+
+// target is one of the sizes or scales, and the suffix of the file name.
+type target struct {
+	size   float64 // size is the maximum width and height, if not zero
+	scale  float64 // scale multiplies the size of the viewBox, if size is zero
+	suffix string
+}
+
+func main() {
+	flag.StringVar(&input, "i", "", "folder containing svg icons or the path of svg file")
+	flag.StringVar(&output, "o", "", "folder to save the png files (default is the folder of the svg)")
+	flag.StringVar(&sizes, "size", "", "comma-separated sizes in pixels, such as 16,24,48, keeping the aspect ratio")
+	flag.StringVar(&scales, "scale", "", "comma-separated scales of the viewBox, such as 1,2,3 (default 1)")
+	flag.StringVar(&background, "bg", "none", "background color, such as white or #202020")
+	flag.StringVar(&current, "color", "black", "color used by currentColor")
+	flag.BoolVar(&crop, "crop", false, "replace the viewBox by the area drawn, removing the empty space around the icon")
+	flag.Parse()
+
+	if input == "" {
+		panic("invalid input")
+	}
+
+	targets, err := parseTargets(sizes, scales)
+	if err != nil {
+		panic(err)
+	}
+	bg, err := svgparser.ParseColor(background)
+	if err != nil {
+		panic(fmt.Errorf("invalid background: %w", err))
+	}
+	fg, err := svgparser.ParseColor(current)
+	if err != nil {
+		panic(fmt.Errorf("invalid color: %w", err))
+	}
+
+	var paths []string
+	s, err := os.Stat(input)
+	if err != nil {
+		panic(err)
+	}
+
+	if s.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(input, "*.svg")); err != nil {
+			panic(err)
+		}
+	} else {
+		paths = []string{input}
+	}
+
+	failed := false
+	for _, path := range paths {
+		if err := convert(path, targets, bg, fg); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// parseTargets returns the targets of the flags, the suffix is empty
+// if there is only one target.
+func parseTargets(sizes, scales string) (targets []target, err error) {
+	for _, v := range strings.Split(sizes, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		size, err := strconv.ParseFloat(v, 64)
+		if err != nil || size <= 0 {
+			return nil, fmt.Errorf("invalid size %q", v)
+		}
+		targets = append(targets, target{size: size, suffix: "-" + v})
+	}
+	for _, v := range strings.Split(scales, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+		scale, err := strconv.ParseFloat(v, 64)
+		if err != nil || scale <= 0 {
+			return nil, fmt.Errorf("invalid scale %q", v)
+		}
+		targets = append(targets, target{scale: scale, suffix: "@" + v + "x"})
+	}
+	if len(targets) == 0 {
+		targets = []target{{scale: 1}}
+	}
+	if len(targets) == 1 {
+		targets[0].suffix = ""
+	}
+	return targets, nil
+}
+
+// convert renders the svg file into one png file for each target.
+func convert(path string, targets []target, bg, fg color.NRGBA) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	svg, err := svgparser.ReadIcon(f)
+	f.Close()
+	if err != nil {
+		return err
+	}
+	if crop {
+		b := svg.TightBounds(svgparser.DrawOptions{Transform: svgparser.Identity})
+		svg.ViewBox = svgparser.Bounds{X: float64(b.Min.X), Y: float64(b.Min.Y), W: float64(b.Dx()), H: float64(b.Dy())}
+	}
+	if svg.ViewBox.W <= 0 || svg.ViewBox.H <= 0 {
+		return errors.New("the viewBox is empty")
+	}
+
+	dir := output
+	if dir == "" {
+		dir = filepath.Dir(path)
+	}
+	name := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+
+	for _, t := range targets {
+		scale := t.scale
+		if t.size > 0 {
+			scale = t.size / math.Max(svg.ViewBox.W, svg.ViewBox.H)
+		}
+		w, h := int(math.Round(svg.ViewBox.W*scale)), int(math.Round(svg.ViewBox.H*scale))
+		if w <= 0 || h <= 0 {
+			return fmt.Errorf("the size %dx%d is empty", w, h)
+		}
+
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		draw.Draw(img, img.Rect, image.NewUniform(bg), image.Point{}, draw.Src)
+
+		sx, sy := float64(w)/svg.ViewBox.W, float64(h)/svg.ViewBox.H
+		svg.DrawWith(&svgraster.Driver{Image: img, Scale: float32(sx+sy) / 2, Color: fg}, svgparser.DrawOptions{
+			Transform: svgparser.Identity.Scale(sx, sy).Translate(-svg.ViewBox.X, -svg.ViewBox.Y),
+			Opacity:   1.0,
+		})
+
+		if err := save(filepath.Join(dir, name+t.suffix+".png"), img); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func save(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
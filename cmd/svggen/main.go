@@ -2,9 +2,11 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"flag"
 	"fmt"
 	"go/format"
+	"image/color"
 	"io"
 	"math"
 	"os"
@@ -63,6 +65,7 @@ package %s
 import (
 	"image"	
 	"image/color"
+	"math"
 
 	"gioui.org/f32"
 	"gioui.org/op"
@@ -72,7 +75,7 @@ import (
 	"github.com/inkeliz/giosvg"
 )
 
-var _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil), (*paint.PaintOp)(nil), (*giosvg.Vector)(nil), (*color.NRGBA)(nil), (*layout.Dimensions)(nil), (*image.Image)(nil)
+var _, _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil), (*paint.PaintOp)(nil), (*giosvg.Vector)(nil), (*color.NRGBA)(nil), (*layout.Dimensions)(nil), (*image.Image)(nil), math.Round
 `+"\r\n", pkg)
 
 	for _, path := range paths {
@@ -91,12 +94,42 @@ var _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil),
 		name = strings.Title(strings.Replace(name, filepath.Ext(name), "", -1))
 		name = strings.Replace(name, " ", "", -1)
 
-		fmt.Fprintf(out, `var Vector%s giosvg.Vector = func(ops *op.Ops, constraints giosvg.Constraints) layout.Dimensions {`+"\r\n", name)
+		if err := writeVector(out, name, svg); err != nil {
+			panic(fmt.Errorf("%s: %w", path, err))
+		}
+
+		f.Close()
+	}
+
+	var save io.WriteCloser
+	if output == "" {
+		save = os.Stdout
+	} else {
+		if save, err = os.Create(output); err != nil {
+			panic(err)
+		}
+	}
+	defer save.Close()
+
+	result, err := format.Source(out.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	save.Write(result)
+}
+
+// writeVector writes the Vector and the ThemeVector of the SVG, with the given name.
+func writeVector(out io.Writer, name string, svg *svgparser.SVGRender) error {
+	fmt.Fprintf(out, `var Vector%s giosvg.Vector = ThemeVector%s(giosvg.Theme{})`+"\r\n\r\n", name, name)
+	fmt.Fprintf(out, `// ThemeVector%s returns the Vector%s using the colors replaced by the given Theme.`+"\r\n", name, name)
+	fmt.Fprintf(out, `func ThemeVector%s(theme giosvg.Theme) giosvg.Vector {`+"\r\n", name)
+	fmt.Fprintf(out, `return func(ops *op.Ops, constraints giosvg.Constraints) layout.Dimensions {`+"\r\n")
 
-		fmt.Fprintf(out, `var w, h float32`+"\r\n")
-		fmt.Fprintf(out, `if constraints.Max != constraints.Min {`+"\r\n")
-		if svg.ViewBox.W >= svg.ViewBox.H {
-			fmt.Fprintf(out, `
+	fmt.Fprintf(out, `var w, h float32`+"\r\n")
+	fmt.Fprintf(out, `if constraints.Max != constraints.Min {`+"\r\n")
+	if svg.ViewBox.W >= svg.ViewBox.H {
+		fmt.Fprintf(out, `
 			d := float32(%f)
 			if constraints.Max.Y*d > constraints.Max.X {
 				w, h = constraints.Max.X, constraints.Max.X/d
@@ -104,18 +137,18 @@ var _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil),
 				w, h = constraints.Max.Y*d, constraints.Max.Y
 			}`, svg.ViewBox.W/svg.ViewBox.H)
 
-		} else {
-			fmt.Fprintf(out, `
+	} else {
+		fmt.Fprintf(out, `
 			d := float32(%f)
 			if constraints.Max.X*d > constraints.Max.Y {
 				w, h = constraints.Max.Y/d, constraints.Max.Y
 			} else {
 				w, h = constraints.Max.X, constraints.Max.X*d
 			}`, svg.ViewBox.H/svg.ViewBox.W)
-		}
-		fmt.Fprintf(out, `}`+"\r\n")
+	}
+	fmt.Fprintf(out, `}`+"\r\n")
 
-		fmt.Fprintf(out, `
+	fmt.Fprintf(out, `
 		if constraints.Min.X > w {
 			w = constraints.Min.X
 		}
@@ -124,96 +157,159 @@ var _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil),
 		}
 `)
 
-		fmt.Fprintf(out, `
+	fmt.Fprintf(out, `
 var (
 	size = f32.Point{X: w / %f, Y: h / %f}
 	avg = (size.X + size.Y) / 2
-	affBase = f32.Affine2D{}.Scale(f32.Point{X: float32(0 - %f), Y: float32(0 - %f)}, size)
+	affBase = f32.Affine2D{}.Offset(f32.Point{X: %f, Y: %f}).Scale(f32.Point{}, size)
 	aff = affBase
 
 	end 		clip.PathSpec
 	path		clip.Path
 	stroke, outline clip.Stack
-)`+"\r\n", svg.ViewBox.W, svg.ViewBox.H, svg.ViewBox.X, svg.ViewBox.Y)
+	c		color.NRGBA
+	ok		bool
+	gradient	paint.LinearGradientOp
+	transform	op.TransformStack
+)`+"\r\n", svg.ViewBox.W, svg.ViewBox.H, -svg.ViewBox.X, -svg.ViewBox.Y)
 
-		fmt.Fprintf(out, `_, _, _, _, _, _ = avg, aff, end, path, stroke, outline`+"\r\n")
+	fmt.Fprintf(out, `_, _, _, _, _, _, _, _, _, _, _ = avg, aff, end, path, stroke, outline, c, ok, gradient, transform, theme`+"\r\n")
 
-		for _, v := range svg.SVGPaths {
-			if v.Style.FillerColor == nil && v.Style.LinerColor == nil {
-				continue
-			}
+	for _, v := range svg.SVGPaths {
+		if v.Style.FillerColor == nil && v.Style.LinerColor == nil {
+			continue
+		}
 
-			if t := v.Style.Transform; t != svgparser.Identity {
-				fmt.Fprintf(out, `aff = affBase.Mul(f32.NewAffine2D(%f, %f, %f, %f, %f, %f))`+"\r\n", t.A, t.C, t.E, t.B, t.D, t.F)
-			} else {
-				fmt.Fprintf(out, `aff = affBase`+"\r\n")
-			}
+		if t := v.Style.Transform; t != svgparser.Identity {
+			fmt.Fprintf(out, `aff = affBase.Mul(f32.NewAffine2D(%f, %f, %f, %f, %f, %f))`+"\r\n", t.A, t.C, t.E, t.B, t.D, t.F)
+		} else {
+			fmt.Fprintf(out, `aff = affBase`+"\r\n")
+		}
 
-			fmt.Fprintf(out, "\r\n"+`path = clip.Path{}`+"\r\n")
-			fmt.Fprintf(out, `path.Begin(ops)`+"\r\n")
-
-			for _, op := range v.Path {
-				switch op := op.(type) {
-				case svgparser.OpMoveTo:
-					fmt.Fprintf(out, `path.MoveTo(aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op.X, op.Y)
-				case svgparser.OpLineTo:
-					fmt.Fprintf(out, `path.LineTo(aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op.X, op.Y)
-				case svgparser.OpQuadTo:
-					fmt.Fprintf(out, `path.QuadTo(aff.Transform(f32.Point{X: %f, Y: %f}), aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op[0].X, op[0].Y, op[1].X, op[1].Y)
-				case svgparser.OpCubicTo:
-					fmt.Fprintf(out, `path.CubeTo(aff.Transform(f32.Point{X: %f, Y: %f}), aff.Transform(f32.Point{X: %f, Y: %f}), aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op[0].X, op[0].Y, op[1].X, op[1].Y, op[2].X, op[2].Y)
-				case svgparser.OpClose:
-					fmt.Fprintf(out, `path.Close()`+"\r\n")
-				}
+		fmt.Fprintf(out, "\r\n"+`path = clip.Path{}`+"\r\n")
+		fmt.Fprintf(out, `path.Begin(ops)`+"\r\n")
+
+		for _, op := range v.Path {
+			switch op := op.(type) {
+			case svgparser.OpMoveTo:
+				fmt.Fprintf(out, `path.MoveTo(aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op.X, op.Y)
+			case svgparser.OpLineTo:
+				fmt.Fprintf(out, `path.LineTo(aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op.X, op.Y)
+			case svgparser.OpQuadTo:
+				fmt.Fprintf(out, `path.QuadTo(aff.Transform(f32.Point{X: %f, Y: %f}), aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op[0].X, op[0].Y, op[1].X, op[1].Y)
+			case svgparser.OpCubicTo:
+				fmt.Fprintf(out, `path.CubeTo(aff.Transform(f32.Point{X: %f, Y: %f}), aff.Transform(f32.Point{X: %f, Y: %f}), aff.Transform(f32.Point{X: %f, Y: %f}))`+"\r\n", op[0].X, op[0].Y, op[1].X, op[1].Y, op[2].X, op[2].Y)
+			case svgparser.OpClose:
+				fmt.Fprintf(out, `path.Close()`+"\r\n")
 			}
+		}
 
-			paint := func(pattern svgparser.Pattern, opacity float64) {
-				switch c := pattern.(type) {
-				case svgparser.CurrentColor:
-					fmt.Fprintf(out, `paint.PaintOp{}.Add(ops)`+"\r\n")
-				case svgparser.PlainColor:
-					if opacity < 1 {
-						c.NRGBA.A = uint8(math.Round(256 * opacity))
-					}
-					fmt.Fprintf(out, `paint.ColorOp{Color: color.NRGBA{R: %d, G: %d, B: %d, A: %d}}.Add(ops)`+"\r\n", c.NRGBA.R, c.NRGBA.G, c.NRGBA.B, c.NRGBA.A)
-					fmt.Fprintf(out, `paint.PaintOp{}.Add(ops)`+"\r\n")
+		var paint func(pattern svgparser.Pattern, opacity float64) error
+		paint = func(pattern svgparser.Pattern, opacity float64) error {
+			switch c := pattern.(type) {
+			case svgparser.CurrentColor:
+				fmt.Fprintf(out, `paint.PaintOp{}.Add(ops)`+"\r\n")
+			case svgparser.PlainColor:
+				fmt.Fprintf(out, `c = theme.Color(color.NRGBA{R: %d, G: %d, B: %d, A: %d})`+"\r\n", c.NRGBA.R, c.NRGBA.G, c.NRGBA.B, c.NRGBA.A)
+				if opacity < 1 {
+					fmt.Fprintf(out, `c.A = uint8(math.Round(float64(c.A) * %f))`+"\r\n", opacity)
+				}
+				fmt.Fprintf(out, `paint.ColorOp{Color: c}.Add(ops)`+"\r\n")
+				fmt.Fprintf(out, `paint.PaintOp{}.Add(ops)`+"\r\n")
+			case svgparser.Variable:
+				fmt.Fprintf(out, `if c, ok = theme.Variable(%q); ok {`+"\r\n", c.Name)
+				if opacity < 1 {
+					fmt.Fprintf(out, `c.A = uint8(math.Round(float64(c.A) * %f))`+"\r\n", opacity)
 				}
+				fmt.Fprintf(out, `paint.ColorOp{Color: c}.Add(ops)`+"\r\n")
+				fmt.Fprintf(out, `paint.PaintOp{}.Add(ops)`+"\r\n")
+				fmt.Fprintf(out, `} else {`+"\r\n")
+				if err := paint(c.Fallback, opacity); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, `}`+"\r\n")
+			case svgparser.Gradient:
+				return writeGradient(out, c, v.Path, opacity)
 			}
+			return nil
+		}
 
-			fmt.Fprintf(out, `end = path.End()`+"\r\n")
+		fmt.Fprintf(out, `end = path.End()`+"\r\n")
 
-			if v.Style.FillerColor != nil {
-				fmt.Fprintf(out, `outline = clip.Outline{Path: end}.Op().Push(ops)`+"\r\n")
-				paint(v.Style.FillerColor, v.Style.FillOpacity)
-				fmt.Fprintf(out, `outline.Pop()`+"\r\n")
+		if v.Style.FillerColor != nil {
+			fmt.Fprintf(out, `outline = clip.Outline{Path: end}.Op().Push(ops)`+"\r\n")
+			if err := paint(v.Style.FillerColor, v.Style.FillOpacity); err != nil {
+				return err
 			}
-			if v.Style.LinerColor != nil {
-				fmt.Fprintf(out, `stroke = clip.Stroke{Path: end, Width: %f * avg}.Op().Push(ops)`+"\r\n", v.Style.LineWidth)
-				paint(v.Style.LinerColor, v.Style.LineOpacity)
-				fmt.Fprintf(out, `stroke.Pop()`+"\r\n")
+			fmt.Fprintf(out, `outline.Pop()`+"\r\n")
+		}
+		if v.Style.LinerColor != nil {
+			fmt.Fprintf(out, `stroke = clip.Stroke{Path: end, Width: %f * avg}.Op().Push(ops)`+"\r\n", v.Style.LineWidth)
+			if err := paint(v.Style.LinerColor, v.Style.LineOpacity); err != nil {
+				return err
 			}
+			fmt.Fprintf(out, `stroke.Pop()`+"\r\n")
 		}
+	}
 
-		fmt.Fprintf(out, `return layout.Dimensions{Size: image.Point{X: int(w), Y: int(h)}}`+"\r\n")
-		fmt.Fprintf(out, `}`+"\r\n\r\n")
+	fmt.Fprintf(out, `return layout.Dimensions{Size: image.Point{X: int(w), Y: int(h)}}`+"\r\n")
+	fmt.Fprintf(out, `}`+"\r\n")
+	fmt.Fprintf(out, `}`+"\r\n\r\n")
+	return nil
+}
 
-		f.Close()
+// writeGradient writes the paint of the linear gradient, with the stops
+// replaced by the Theme. Gio only draws linear gradients with two colors,
+// so other gradients return an error, instead of drawing nothing.
+func writeGradient(out io.Writer, g svgparser.Gradient, path svgparser.Path, opacity float64) error {
+	direction, ok := g.Direction.(svgparser.Linear)
+	switch {
+	case !ok:
+		return errors.New("radial gradients are not supported")
+	case len(g.Stops) == 0 || len(g.Stops) > 2:
+		return fmt.Errorf("gradients with %d stops are not supported, only one or two", len(g.Stops))
+	case g.Spread != svgparser.PadSpread:
+		return errors.New("gradients with spreadMethod reflect or repeat are not supported")
 	}
 
-	var save io.WriteCloser
-	if output == "" {
-		save = os.Stdout
-	} else {
-		if save, err = os.Create(output); err != nil {
-			panic(err)
+	first, last := g.Stops[0], g.Stops[len(g.Stops)-1]
+	for i, s := range []svgparser.GradStop{first, last} {
+		if s.StopColor == nil {
+			return errors.New("gradient stops without stop-color are not supported")
+		}
+		c := color.NRGBAModel.Convert(s.StopColor).(color.NRGBA)
+		if s.Variable != "" {
+			fmt.Fprintf(out, `if c, ok = theme.Variable(%q); !ok {`+"\r\n", s.Variable)
+			fmt.Fprintf(out, `c = theme.Color(color.NRGBA{R: %d, G: %d, B: %d, A: %d})`+"\r\n", c.R, c.G, c.B, c.A)
+			fmt.Fprintf(out, `}`+"\r\n")
+		} else {
+			fmt.Fprintf(out, `c = theme.Color(color.NRGBA{R: %d, G: %d, B: %d, A: %d})`+"\r\n", c.R, c.G, c.B, c.A)
 		}
+		if a := s.Opacity * opacity; a < 1 {
+			fmt.Fprintf(out, `c.A = uint8(math.Round(float64(c.A) * %f))`+"\r\n", a)
+		}
+		fmt.Fprintf(out, `gradient.Color%d = c`+"\r\n", i+1)
 	}
-	defer save.Close()
 
-	result, err := format.Source(out.Bytes())
-	if err != nil {
-		panic(err)
+	// The offsets of the stops move the points of the direction, since
+	// the colors before the first stop and after the last stop are padded.
+	offset1 := math.Min(math.Max(first.Offset, 0), 1)
+	offset2 := math.Max(math.Min(math.Max(last.Offset, 0), 1), offset1)
+	x1, y1, x2, y2 := direction[0], direction[1], direction[2], direction[3]
+	fmt.Fprintf(out, `gradient.Stop1 = f32.Point{X: %f, Y: %f}`+"\r\n", x1+(x2-x1)*offset1, y1+(y2-y1)*offset1)
+	fmt.Fprintf(out, `gradient.Stop2 = f32.Point{X: %f, Y: %f}`+"\r\n", x1+(x2-x1)*offset2, y1+(y2-y1)*offset2)
+
+	// The direction uses the coordinates of the gradient, which are
+	// relative to the bounding box of the path, by default.
+	m := g.Matrix
+	if g.Units == svgparser.ObjectBoundingBox {
+		bounds := path.Bounds(svgparser.Identity)
+		m = svgparser.Identity.Translate(float64(bounds.Min.X), float64(bounds.Min.Y)).
+			Scale(float64(bounds.Dx()), float64(bounds.Dy())).Mult(g.Matrix)
 	}
-
-	save.Write(result)
+	fmt.Fprintf(out, `transform = op.Affine(aff.Mul(f32.NewAffine2D(%f, %f, %f, %f, %f, %f))).Push(ops)`+"\r\n", m.A, m.C, m.E, m.B, m.D, m.F)
+	fmt.Fprintf(out, `gradient.Add(ops)`+"\r\n")
+	fmt.Fprintf(out, `paint.PaintOp{}.Add(ops)`+"\r\n")
+	fmt.Fprintf(out, `transform.Pop()`+"\r\n")
+	return nil
 }
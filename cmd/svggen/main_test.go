@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// generate returns the formatted code of writeVector for the SVG.
+func generate(t *testing.T, svg string) (string, error) {
+	t.Helper()
+	icon, err := svgparser.ReadIcon(strings.NewReader(svg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	out.WriteString("package icons\r\n")
+	if err := writeVector(&out, "Icon", icon); err != nil {
+		return "", err
+	}
+	code, err := format.Source(out.Bytes())
+	if err != nil {
+		t.Fatalf("%v, in:\n%s", err, out.String())
+	}
+	return string(code), nil
+}
+
+func TestWriteViewBox(t *testing.T) {
+	code, err := generate(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="-50 -25 100 50">
+		<rect x="-50" y="-25" width="100" height="50"/>
+	</svg>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The origin of the viewBox is moved to zero, then scaled, like
+	// the TargetTransform.
+	expected := `affBase = f32.Affine2D{}.Offset(f32.Point{X: 50.000000, Y: 25.000000}).Scale(f32.Point{}, size)`
+	if !strings.Contains(code, expected) {
+		t.Errorf("expected %s, in:\n%s", expected, code)
+	}
+	size := f32.Point{X: 2, Y: 2}
+	aff := f32.Affine2D{}.Offset(f32.Point{X: 50, Y: 25}).Scale(f32.Point{}, size)
+	if min, max := aff.Transform(f32.Pt(-50, -25)), aff.Transform(f32.Pt(50, 25)); min != (f32.Point{}) || max != f32.Pt(200, 100) {
+		t.Errorf("expected the viewBox from 0,0 to 200,100, got %v and %v", min, max)
+	}
+}
+
+func TestWriteGradient(t *testing.T) {
+	code, err := generate(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<defs>
+			<linearGradient id="g" x1="0" y1="0" x2="1" y2="0">
+				<stop offset="0.25" stop-color="var(--primary, red)"/>
+				<stop offset="1" stop-color="blue" stop-opacity="0.5"/>
+			</linearGradient>
+		</defs>
+		<rect x="10" y="20" width="80" height="40" fill="url(#g)"/>
+	</svg>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each stop is replaced by the Theme, and the offsets move the points.
+	for _, expected := range []string{
+		`if c, ok = theme.Variable("primary"); !ok {`,
+		`c = theme.Color(color.NRGBA{R: 255, G: 0, B: 0, A: 255})`,
+		`c = theme.Color(color.NRGBA{R: 0, G: 0, B: 255, A: 255})`,
+		`c.A = uint8(math.Round(float64(c.A) * 0.500000))`,
+		`gradient.Stop1 = f32.Point{X: 0.250000, Y: 0.000000}`,
+		`gradient.Stop2 = f32.Point{X: 1.000000, Y: 0.000000}`,
+		`transform = op.Affine(aff.Mul(f32.NewAffine2D(80.000000, 0.000000, 10.000000, 0.000000, 40.000000, 20.000000))).Push(ops)`,
+		`gradient.Add(ops)`,
+	} {
+		if !strings.Contains(code, expected) {
+			t.Errorf("expected %s, in:\n%s", expected, code)
+		}
+	}
+
+	for _, svg := range []string{
+		`<radialGradient id="g"><stop offset="0" stop-color="red"/><stop offset="1" stop-color="blue"/></radialGradient>`,
+		`<linearGradient id="g"><stop offset="0" stop-color="red"/><stop offset="0.5" stop-color="lime"/><stop offset="1" stop-color="blue"/></linearGradient>`,
+		`<linearGradient id="g" spreadMethod="repeat"><stop offset="0" stop-color="red"/><stop offset="1" stop-color="blue"/></linearGradient>`,
+	} {
+		_, err := generate(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+			<defs>`+svg+`</defs>
+			<rect width="100" height="100" fill="url(#g)"/>
+		</svg>`)
+		if err == nil {
+			t.Errorf("expected an error for %s", svg)
+		}
+	}
+}
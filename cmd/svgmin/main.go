@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/inkeliz/giosvg/internal/svgparser"
+	"github.com/inkeliz/giosvg/internal/svgraster"
+)
+
+var (
+	input     string
+	output    string
+	precision int
+	relative  bool
+	merge     bool
+	check     bool
+	crop      bool
+)
+
+// checkSize is the maximum width and height of the images compared by -check.
+const checkSize = 256
+
+func main() {
+	flag.StringVar(&input, "i", "", "folder containing svg icons or the path of svg file")
+	flag.StringVar(&output, "o", "", "folder to save the svg files (default replaces the input files)")
+	flag.IntVar(&precision, "precision", 3, "number of decimals of the coordinates")
+	flag.BoolVar(&relative, "relative", true, "use the relative path commands when shorter")
+	flag.BoolVar(&merge, "merge", true, "join the paths with the same style, and remove the invisible paths")
+	flag.BoolVar(&crop, "crop", false, "replace the viewBox by the area drawn, removing the empty space around the icon")
+	flag.BoolVar(&check, "check", false, "fail if the render of the output differs from the input, without saving")
+	flag.Parse()
+
+	if input == "" {
+		panic("invalid input")
+	}
+	if precision < 0 {
+		panic("invalid precision")
+	}
+
+	var paths []string
+	s, err := os.Stat(input)
+	if err != nil {
+		panic(err)
+	}
+
+	if s.IsDir() {
+		if paths, err = filepath.Glob(filepath.Join(input, "*.svg")); err != nil {
+			panic(err)
+		}
+	} else {
+		paths = []string{input}
+	}
+
+	failed := false
+	for _, path := range paths {
+		if err := minify(path); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// minify writes the minified svg, or compares it with the original if
+// -check is set.
+func minify(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	svg, err := svgparser.ReadIcon(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	if crop {
+		cropViewBox(svg)
+	}
+
+	var out bytes.Buffer
+	if err := svg.WriteSVG(&out, svgparser.SVGOptions{Precision: precision, Relative: relative, Merge: merge}); err != nil {
+		return err
+	}
+
+	if check {
+		minified, err := svgparser.ReadIcon(bytes.NewReader(out.Bytes()))
+		if err != nil {
+			return fmt.Errorf("the output is invalid: %w", err)
+		}
+		if n := differences(svg, minified); n > 0 {
+			return fmt.Errorf("%d pixels of the output are different", n)
+		}
+		fmt.Printf("%s: %d -> %d bytes, same render\n", path, len(data), out.Len())
+		return nil
+	}
+
+	result := out.Bytes()
+	if !crop && len(data) <= len(result) {
+		// The original is already smaller, such as when it uses
+		// shapes instead of paths.
+		result = data
+	}
+
+	dir := output
+	if dir == "" {
+		dir = filepath.Dir(path)
+	}
+	if err := os.WriteFile(filepath.Join(dir, filepath.Base(path)), result, 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("%s: %d -> %d bytes\n", path, len(data), len(result))
+	return nil
+}
+
+// cropViewBox replaces the viewBox by the area drawn, rounded outwards
+// to the precision, so the edges aren't clipped.
+func cropViewBox(svg *svgparser.SVGRender) {
+	b := svg.TightBounds(svgparser.DrawOptions{Transform: svgparser.Identity})
+	if b.Empty() {
+		return
+	}
+	p := math.Pow(10, float64(precision))
+	x0, y0 := math.Floor(float64(b.Min.X)*p)/p, math.Floor(float64(b.Min.Y)*p)/p
+	x1, y1 := math.Ceil(float64(b.Max.X)*p)/p, math.Ceil(float64(b.Max.Y)*p)/p
+	svg.ViewBox = svgparser.Bounds{X: x0, Y: y0, W: x1 - x0, H: y1 - y0}
+}
+
+// differences renders both svgs and returns the number of pixels whose
+// colors differ by more than the anti-aliasing.
+func differences(a, b *svgparser.SVGRender) int {
+	if a.ViewBox.W <= 0 || a.ViewBox.H <= 0 {
+		return 0
+	}
+	scale := checkSize / math.Max(a.ViewBox.W, a.ViewBox.H)
+	w, h := int(math.Ceil(a.ViewBox.W*scale)), int(math.Ceil(a.ViewBox.H*scale))
+
+	render := func(svg *svgparser.SVGRender) *image.RGBA {
+		img := image.NewRGBA(image.Rect(0, 0, w, h))
+		svg.DrawWith(&svgraster.Driver{Image: img, Scale: float32(scale), Color: color.NRGBA{A: 0xff}}, svgparser.DrawOptions{
+			Transform: svgparser.Identity.Scale(scale, scale).Translate(-a.ViewBox.X, -a.ViewBox.Y),
+			Opacity:   1.0,
+		})
+		return img
+	}
+	imgA, imgB := render(a), render(b)
+
+	n := 0
+	for i := 0; i < len(imgA.Pix); i += 4 {
+		for c := 0; c < 4; c++ {
+			if d := int(imgA.Pix[i+c]) - int(imgB.Pix[i+c]); d > 0x40 || d < -0x40 {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,453 @@
+package giosvg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/inkeliz/giosvg/geom"
+	"github.com/inkeliz/giosvg/internal/svgdraw"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+	"golang.org/x/image/font/sfnt"
+)
+
+// Document is the parsed SVG/XML file. Unlike Vector, it can be inspected
+// and it can create multiple Vector with different Theme.
+//
+// The parsed data is never modified after NewDocument, and changes
+// made by Element are synchronized, so it's safe to use the same
+// Document from multiple goroutines.
+type Document struct {
+	render *svgparser.SVGRender
+
+	mutex     sync.RWMutex
+	overrides []svgparser.Override // overrides is nil until some Element is changed
+	revisions []uint64             // revisions is incremented for each change of overrides
+	revision  uint64               // revision is incremented for each change of any Element
+}
+
+// NewDocument creates a Document from the given data. The data is
+// expected to be an SVG/XML
+func NewDocument(data []byte) (*Document, error) {
+	return NewDocumentReader(bytes.NewReader(data))
+}
+
+// NewDocumentReader creates a Document from the given io.Reader. The data is
+// expected to be an SVG/XML
+func NewDocumentReader(reader io.Reader) (*Document, error) {
+	render, err := svgparser.ReadIcon(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &Document{render: render}, nil
+}
+
+// NewLottieDocument creates a Document from the given Lottie JSON. The
+// shape layers are converted to paths, and the keyframes to animations
+// which repeat indefinitely, see AnimatedIcon.
+func NewLottieDocument(data []byte) (*Document, error) {
+	return NewLottieDocumentReader(bytes.NewReader(data))
+}
+
+// NewLottieDocumentReader creates a Document from the given io.Reader. The
+// data is expected to be a Lottie JSON.
+func NewLottieDocumentReader(reader io.Reader) (*Document, error) {
+	render, err := svgparser.ReadLottie(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &Document{render: render}, nil
+}
+
+// NewVectorDrawableDocument creates a Document from the given Android
+// VectorDrawable XML. The color resources, such as `@color/primary`, are
+// the Theme.Variables, and the theme attributes are the current color.
+func NewVectorDrawableDocument(data []byte) (*Document, error) {
+	return NewVectorDrawableDocumentReader(bytes.NewReader(data))
+}
+
+// NewVectorDrawableDocumentReader creates a Document from the given io.Reader.
+// The data is expected to be an Android VectorDrawable XML.
+func NewVectorDrawableDocumentReader(reader io.Reader) (*Document, error) {
+	render, err := svgparser.ReadVectorDrawable(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &Document{render: render}, nil
+}
+
+// WriteVectorDrawable writes the Document as Android VectorDrawable XML,
+// including the changes made by Element. The animations are not written.
+func (d *Document) WriteVectorDrawable(w io.Writer) error {
+	return d.baked().WriteVectorDrawable(w)
+}
+
+// NewIconVGDocument creates a Document from the given IconVG graphic, such
+// as the icons used by Gio's widget.Icon. The palette index zero is
+// the current color.
+func NewIconVGDocument(data []byte) (*Document, error) {
+	return NewIconVGDocumentReader(bytes.NewReader(data))
+}
+
+// NewIconVGDocumentReader creates a Document from the given io.Reader.
+// The data is expected to be an IconVG graphic.
+func NewIconVGDocumentReader(reader io.Reader) (*Document, error) {
+	render, err := svgparser.ReadIconVG(reader)
+	if err != nil {
+		return nil, err
+	}
+	return &Document{render: render}, nil
+}
+
+// WriteIconVG writes the Document as IconVG, which can be used by
+// widget.NewIcon. The strokes are converted to filled outlines, and the
+// current color is written as the palette index zero.
+// The changes made by Element are included, but the animations are not
+// written.
+func (d *Document) WriteIconVG(w io.Writer) error {
+	return d.baked().WriteIconVG(w)
+}
+
+// SVGOptions controls how WriteSVG serializes the Document.
+type SVGOptions struct {
+	Precision int  // Precision is the number of decimals of the coordinates, such as 3
+	Relative  bool // Relative uses the relative path commands, such as `l`, when shorter
+	Merge     bool // Merge removes the paths which draw nothing, and joins those with the same style
+}
+
+// WriteSVG writes the Document as SVG, such as to minify the SVG before
+// embedding it. Each path is written as one <path>, and the groups are
+// flattened. The changes made by Element are included, such as the shapes
+// made by Combine, so the result can be given to svggen. The animations are
+// not written.
+func (d *Document) WriteSVG(w io.Writer, options SVGOptions) error {
+	return d.baked().WriteSVG(w, svgparser.SVGOptions(options))
+}
+
+// baked returns the SVGRender with the changes made by Element applied.
+func (d *Document) baked() *svgparser.SVGRender {
+	overrides, _ := d.snapshot()
+	return d.render.WithOverrides(overrides)
+}
+
+// NewGlyphDocument creates a Document from the glyph of the rune, such
+// as an icon of Material Symbols or Font Awesome. The glyph is filled with
+// the current color, and the size is the advance width and the height of
+// the line.
+func NewGlyphDocument(f *sfnt.Font, r rune) (*Document, error) {
+	var buf sfnt.Buffer
+	x, err := f.GlyphIndex(&buf, r)
+	if err != nil {
+		return nil, err
+	}
+	if x == 0 {
+		return nil, sfnt.ErrNotFound
+	}
+	return newGlyphDocument(f, x)
+}
+
+// NewGlyphDocumentByName creates a Document from the glyph with the given
+// name, such as `home`, see NewGlyphDocument.
+func NewGlyphDocumentByName(f *sfnt.Font, name string) (*Document, error) {
+	x, err := svgparser.GlyphIndexByName(f, name)
+	if err != nil {
+		return nil, err
+	}
+	return newGlyphDocument(f, x)
+}
+
+func newGlyphDocument(f *sfnt.Font, x sfnt.GlyphIndex) (*Document, error) {
+	render, err := svgparser.ReadGlyph(f, x)
+	if err != nil {
+		return nil, err
+	}
+	return &Document{render: render}, nil
+}
+
+// Palette returns the distinct colors used by the Document, in the order
+// they are drawn, including the colors of gradient stops.
+// The `currentColor` is not included, since it's defined by paint.ColorOp.
+func (d *Document) Palette() []color.NRGBA {
+	return d.render.Palette()
+}
+
+// Variables returns the names of the CSS custom properties used by the Document,
+// such as `primary` for `fill="var(--primary, red)"`. The values
+// can be defined by Theme.Variables.
+func (d *Document) Variables() []string {
+	return d.render.Variables()
+}
+
+// Vector returns the Vector which draws the Document with the
+// original colors.
+func (d *Document) Vector() Vector {
+	return d.ThemeVector(Theme{})
+}
+
+// ThemeVector returns the Vector which draws the Document using
+// the colors replaced by the given Theme.
+func (d *Document) ThemeVector(theme Theme) Vector {
+	return d.themeVector(theme, func() []svgparser.Override {
+		overrides, _ := d.snapshot()
+		return overrides
+	})
+}
+
+// VectorAt returns the Vector which draws the Document at the time `t`
+// of the SMIL animations, with the original colors. See AnimatedIcon.
+func (d *Document) VectorAt(t time.Duration) Vector {
+	return d.ThemeVectorAt(Theme{}, t)
+}
+
+// ThemeVectorAt is like VectorAt, but using the colors replaced by
+// the given Theme.
+func (d *Document) ThemeVectorAt(theme Theme, t time.Duration) Vector {
+	return d.themeVector(theme, func() []svgparser.Override {
+		return d.animated(t)
+	})
+}
+
+// Duration returns the time when all SMIL animations end. It returns
+// false if some animation repeats indefinitely.
+func (d *Document) Duration() (time.Duration, bool) {
+	end := d.render.AnimationEnd()
+	return end, end != svgparser.Indefinite
+}
+
+// ViewBox returns the viewBox of the Document, which is the area
+// drawn by the Vector.
+func (d *Document) ViewBox() f32.Rectangle {
+	vb := d.render.ViewBox
+	return f32.Rect(float32(vb.X), float32(vb.Y), float32(vb.X+vb.W), float32(vb.Y+vb.H))
+}
+
+// Bounds returns the area drawn by the Document, in the coordinates of
+// the viewBox, including the stroke and the changes made by Element. Unlike
+// the viewBox, it doesn't include the empty space around the drawing, which
+// is useful to crop icons or to align them with text.
+func (d *Document) Bounds() f32.Rectangle {
+	overrides, _ := d.snapshot()
+	return d.render.TightBounds(svgparser.DrawOptions{Transform: svgparser.Identity, Overrides: overrides})
+}
+
+// Paths returns the geometry of the visible paths of the Document, in the
+// order they are drawn and in the coordinates of the viewBox, including
+// the changes made by Element. Each shape, such as <circle>, is one path.
+func (d *Document) Paths() []geom.Path {
+	overrides, _ := d.snapshot()
+	return d.paths(nil, overrides)
+}
+
+// Area returns the area drawn by the Document as one path, in the
+// coordinates of the viewBox, including the stroke and the changes made
+// by Element. The fill and the outline of the stroke are joined using
+// geom.Union, so the area can be combined with other paths, see Combine.
+func (d *Document) Area() geom.Path {
+	overrides, _ := d.snapshot()
+	o := svgparser.DrawOptions{Transform: svgparser.Identity, Overrides: overrides}
+	return d.render.Area(o, d.tolerance())
+}
+
+// Combine replaces the geometry of all paths of the Document by the result
+// of the operation between each path and the shapes, such as geom.Difference
+// to punch a hole in the drawing. The shapes are in the coordinates of the
+// viewBox, such as returned by Area, and are joined by their union. See
+// Element.Combine.
+func (d *Document) Combine(op geom.BooleanOp, shapes ...geom.Path) {
+	indexes := make([]int, len(d.render.SVGPaths))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	d.combine(indexes, op, shapes)
+}
+
+// combine replaces the geometry of the given paths, see Combine.
+func (d *Document) combine(indexes []int, op geom.BooleanOp, shapes []geom.Path) {
+	var shape geom.Path
+	for _, s := range shapes {
+		shape = append(shape, s...)
+	}
+	tolerance := d.tolerance()
+	for _, i := range indexes {
+		i := i
+		d.override([]int{i}, func(o *svgparser.Override) {
+			o.Path = d.render.CombinedPath(i, *o, op, shape, tolerance)
+		})
+	}
+}
+
+// tolerance returns the tolerance of the boolean operations, in the
+// coordinates of the viewBox, which is invisible at any reasonable size.
+func (d *Document) tolerance() float64 {
+	vb := d.render.ViewBox
+	return math.Max(vb.W, vb.H) * 1e-4
+}
+
+// paths returns the geometry of the given paths, or of all paths if nil.
+func (d *Document) paths(indexes []int, overrides []svgparser.Override) (paths []geom.Path) {
+	o := svgparser.DrawOptions{Transform: svgparser.Identity, Overrides: overrides}
+	if indexes == nil {
+		for i := range d.render.SVGPaths {
+			indexes = append(indexes, i)
+		}
+	}
+	for _, i := range indexes {
+		if path := d.render.TransformedPath(i, o); path != nil {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+func (d *Document) themeVector(theme Theme, overrides func() []svgparser.Override) Vector {
+	render := d.render
+	colors, variables := svgparser.ColorMap(theme.Colors), theme.Variables
+
+	return func(ops *op.Ops, constraints Constraints) layout.Dimensions {
+		size, transform, scale := d.target(constraints)
+		overrides := overrides()
+		render.DrawWith(&svgdraw.Driver{Ops: ops, Scale: scale}, svgparser.DrawOptions{
+			Transform: transform,
+			Opacity:   1.0,
+			Colors:    colors,
+			Variables: variables,
+			Overrides: overrides,
+		})
+
+		return layout.Dimensions{Size: image.Point{X: int(size.X), Y: int(size.Y)}}
+	}
+}
+
+// target returns the size, keeping the aspect ratio of the viewBox, and
+// the transform to draw the Document within the given constraints.
+func (d *Document) target(constraints Constraints) (size f32.Point, transform svgparser.Matrix2D, scale float32) {
+	render := d.render
+
+	var w, h float32
+	if constraints.Max != constraints.Min {
+		if render.ViewBox.W >= render.ViewBox.H {
+			d := float32(render.ViewBox.W) / float32(render.ViewBox.H)
+			if constraints.Max.Y*d > constraints.Max.X {
+				w, h = constraints.Max.X, constraints.Max.X/d
+			} else {
+				w, h = constraints.Max.Y*d, constraints.Max.Y
+			}
+		} else {
+			d := float32(render.ViewBox.H) / float32(render.ViewBox.W)
+			if constraints.Max.X*d > constraints.Max.Y {
+				w, h = constraints.Max.Y/d, constraints.Max.Y
+			} else {
+				w, h = constraints.Max.X, constraints.Max.X*d
+			}
+		}
+	}
+
+	if constraints.Min.X > w {
+		w = constraints.Min.X
+	}
+	if constraints.Min.Y > h {
+		h = constraints.Min.Y
+	}
+
+	transform = render.TargetTransform(0, 0, float64(w), float64(h))
+	scale = float32(float32(float64(w)/render.ViewBox.W)+float32(float64(h)/render.ViewBox.H)) / 2
+	return f32.Pt(w, h), transform, scale
+}
+
+// snapshot returns a copy of the overrides and revisions, both are
+// nil if no Element was changed.
+func (d *Document) snapshot() (overrides []svgparser.Override, revisions []uint64) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	if d.overrides == nil {
+		return nil, nil
+	}
+	overrides = append([]svgparser.Override(nil), d.overrides...)
+	revisions = append([]uint64(nil), d.revisions...)
+	return overrides, revisions
+}
+
+// animated returns the overrides at the time `t` of the animations,
+// composed with the changes made by Element.
+func (d *Document) animated(t time.Duration) []svgparser.Override {
+	overrides, _ := d.snapshot()
+	animated := d.render.Animate(t)
+	if animated == nil {
+		return overrides
+	}
+	if overrides != nil {
+		for i := range animated {
+			animated[i] = overrides[i].Compose(animated[i])
+		}
+	}
+	return animated
+}
+
+// override changes the overrides of the given paths, while locked.
+func (d *Document) override(paths []int, fn func(o *svgparser.Override)) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.overrides == nil {
+		d.overrides = make([]svgparser.Override, len(d.render.SVGPaths))
+		d.revisions = make([]uint64, len(d.render.SVGPaths))
+		for i := range d.overrides {
+			d.overrides[i] = svgparser.DefaultOverride
+		}
+	}
+	for _, i := range paths {
+		fn(&d.overrides[i])
+		d.revisions[i]++
+	}
+	d.revision++
+}
+
+// currentRevision returns the revision, which changes whenever
+// some Element is changed.
+func (d *Document) currentRevision() uint64 {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	return d.revision
+}
+
+// Theme replaces the colors of a Vector when it's drawn.
+// The zero value keeps the original colors.
+//
+// The Theme is used by Document.ThemeVector and by the vectors
+// generated by svggen.
+type Theme struct {
+	// Colors maps the original colors, as reported by Document.Palette,
+	// to the new colors. Gradient stops are replaced too.
+	Colors map[color.NRGBA]color.NRGBA
+
+	// Variables defines the values of CSS custom properties used
+	// by `var(--name, fallback)` in fill, stroke and stop-color. The key
+	// is the name without the `--` prefix. The values are not
+	// replaced by Colors.
+	Variables map[string]color.NRGBA
+}
+
+// Color returns the color which replaces the given original color.
+func (t Theme) Color(c color.NRGBA) color.NRGBA {
+	if n, ok := t.Colors[c]; ok {
+		return n
+	}
+	return c
+}
+
+// Variable returns the value of the variable, the name must not
+// include the `--` prefix. It returns false if the Theme doesn't
+// define the variable, and the fallback must be used.
+func (t Theme) Variable(name string) (color.NRGBA, bool) {
+	c, ok := t.Variables[name]
+	return c, ok
+}
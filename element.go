@@ -0,0 +1,255 @@
+package giosvg
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"github.com/inkeliz/giosvg/geom"
+	"github.com/inkeliz/giosvg/internal/svgdraw"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// Element is a handle to one element of the Document, found by the `id`
+// attribute. Changing the Element affects all Vector created by the Document,
+// without parsing the SVG again.
+//
+// If the element is a group, such as `<g id="layer">`, the changes are
+// applied to all elements inside the group.
+type Element struct {
+	doc   *Document
+	id    string
+	paths []int
+}
+
+// Element returns the element with the given id, or nil if the Document
+// doesn't have such element.
+func (d *Document) Element(id string) *Element {
+	if id == "" {
+		return nil
+	}
+
+	var paths []int
+	for i, svgp := range d.render.SVGPaths {
+		if svgp.ID == id {
+			paths = append(paths, i)
+			continue
+		}
+		for _, parent := range svgp.Parents {
+			if parent == id {
+				paths = append(paths, i)
+				break
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+	return &Element{doc: d, id: id, paths: paths}
+}
+
+// ID returns the id of the element.
+func (e *Element) ID() string { return e.id }
+
+// Bounds returns the area drawn by the element, in the coordinates of the
+// viewBox, including the stroke and the changes made to the element.
+func (e *Element) Bounds() (bounds f32.Rectangle) {
+	overrides, _ := e.doc.snapshot()
+	o := svgparser.DrawOptions{Transform: svgparser.Identity, Overrides: overrides}
+	for _, i := range e.paths {
+		bounds = bounds.Union(e.doc.render.TightPathBounds(i, o))
+	}
+	return bounds
+}
+
+// Paths returns the geometry of the visible paths of the element, in the
+// coordinates of the viewBox, see Document.Paths.
+func (e *Element) Paths() []geom.Path {
+	overrides, _ := e.doc.snapshot()
+	return e.doc.paths(e.paths, overrides)
+}
+
+// Area returns the area drawn by the element as one path, in the
+// coordinates of the viewBox, see Document.Area.
+func (e *Element) Area() geom.Path {
+	overrides, _ := e.doc.snapshot()
+	o := svgparser.DrawOptions{Transform: svgparser.Identity, Overrides: overrides}
+	var area geom.Path
+	for _, i := range e.paths {
+		area = append(area, e.doc.render.PathArea(i, o, e.doc.tolerance())...)
+	}
+	if len(area) == 0 {
+		return nil
+	}
+	return area.Union(nil, e.doc.tolerance())
+}
+
+// Combine replaces the geometry of the element by the result of the
+// operation between each of its paths and the shapes, in the coordinates of
+// the viewBox. For instance, to punch the notification dot out of a bell,
+// with some space around it:
+//
+//	var ring geom.Path
+//	ring.Ellipse(18, 6, 5, 5)
+//	bell.Combine(geom.Difference, ring)
+//
+// Only the fill is combined: the stroke follows the new outline. The change
+// is removed by Reset, and it's written by Document.WriteSVG.
+func (e *Element) Combine(op geom.BooleanOp, shapes ...geom.Path) {
+	e.doc.combine(e.paths, op, shapes)
+}
+
+// SetVisible shows or hides the element.
+func (e *Element) SetVisible(visible bool) {
+	e.doc.override(e.paths, func(o *svgparser.Override) { o.Hidden = !visible })
+}
+
+// SetTransform sets an extra transform, applied after the transform
+// defined by the SVG. The transform uses the coordinates of the viewBox,
+// so rotating around the center of a 24x24 icon is:
+//
+//	f32.Affine2D{}.Rotate(f32.Pt(12, 12), angle)
+func (e *Element) SetTransform(t f32.Affine2D) {
+	sx, hx, ox, hy, sy, oy := t.Elems()
+	m := svgparser.Matrix2D{
+		A: float64(sx), C: float64(hx), E: float64(ox),
+		B: float64(hy), D: float64(sy), F: float64(oy),
+	}
+	e.doc.override(e.paths, func(o *svgparser.Override) { o.Transform = m })
+}
+
+// SetFill replaces the fill color of the element. The fill is not
+// added to elements without fill.
+func (e *Element) SetFill(c color.NRGBA) {
+	e.doc.override(e.paths, func(o *svgparser.Override) { o.FillerColor = svgparser.PlainColor{NRGBA: c} })
+}
+
+// SetStroke replaces the stroke color of the element. The stroke is not
+// added to elements without stroke.
+func (e *Element) SetStroke(c color.NRGBA) {
+	e.doc.override(e.paths, func(o *svgparser.Override) { o.LinerColor = svgparser.PlainColor{NRGBA: c} })
+}
+
+// SetOpacity sets the opacity of the element, which is composed with the
+// opacity defined by the SVG.
+func (e *Element) SetOpacity(opacity float32) {
+	e.doc.override(e.paths, func(o *svgparser.Override) { o.Opacity = float64(opacity) })
+}
+
+// Reset removes all changes made to the element.
+func (e *Element) Reset() {
+	e.doc.override(e.paths, func(o *svgparser.Override) { *o = svgparser.DefaultOverride })
+}
+
+// HitTest returns the IDs of the topmost element at the point `p`, or nil if there's
+// no element at that point. The first ID is the element itself, if it has an ID, followed
+// by the IDs of the groups which contain the element.
+//
+// The `size` is the size of the Vector, as returned by layout.Dimensions, and the
+// point uses the same coordinates. The fill rule, the stroke width, the transforms and
+// the changes made by Element are respected.
+func (d *Document) HitTest(size image.Point, p f32.Point) []string {
+	i := d.hitTest(size, p)
+	if i < 0 {
+		return nil
+	}
+	return d.ids(i)
+}
+
+// TitleAt returns the <title> of the topmost element at the point `p`, or
+// of the closest group with title. It returns an empty string if there's no element
+// at that point, or if it has no title. See HitTest.
+func (d *Document) TitleAt(size image.Point, p f32.Point) string {
+	i := d.hitTest(size, p)
+	if i < 0 {
+		return ""
+	}
+	return d.render.SVGPaths[i].Title
+}
+
+// hitTest returns the index of the topmost SvgPath at the point, or -1.
+func (d *Document) hitTest(size image.Point, p f32.Point) int {
+	render := d.render
+	overrides, _ := d.snapshot()
+	transform := render.TargetTransform(0, 0, float64(size.X), float64(size.Y))
+
+	return render.HitTest(p, svgparser.DrawOptions{Transform: transform, Overrides: overrides})
+}
+
+// ids returns the ID and the IDs of the groups of the SvgPath at the given index.
+func (d *Document) ids(i int) []string {
+	var ids []string
+	if id := d.render.SVGPaths[i].ID; id != "" {
+		ids = append(ids, id)
+	}
+	return append(ids, d.render.SVGPaths[i].Parents...)
+}
+
+// DocumentIcon is similar to Icon, but it keeps one cache for each element
+// of the Document. When an Element changes, only the affected elements are
+// drawn again.
+//
+// The same restrictions of Icon applies, the DocumentIcon must not be used
+// twice in the same frame.
+type DocumentIcon struct {
+	doc *Document
+
+	lastDimensions layout.Dimensions
+	lastSize       layout.Constraints
+	paths          []pathCache
+}
+
+type pathCache struct {
+	valid    bool
+	revision uint64
+	macro    op.CallOp
+	op       *op.Ops
+}
+
+// NewDocumentIcon creates the layout.Widget from the Document.
+func NewDocumentIcon(doc *Document) *DocumentIcon {
+	return &DocumentIcon{doc: doc, paths: make([]pathCache, len(doc.render.SVGPaths))}
+}
+
+// Layout implements widget.Layout.
+// It will render the icon based on the given layout.Constraints.Max.
+// If the SVG uses `currentColor` you can set the color using
+// paint.ColorOp.
+func (icon *DocumentIcon) Layout(gtx layout.Context) layout.Dimensions {
+	size, transform, scale := icon.doc.target(newConstraintsFromGio(gtx.Constraints))
+	if icon.lastSize != gtx.Constraints {
+		// If the size changes, we can't re-use any macro.
+		icon.lastSize = gtx.Constraints
+		icon.lastDimensions = layout.Dimensions{Size: image.Point{X: int(size.X), Y: int(size.Y)}}
+		for i := range icon.paths {
+			icon.paths[i].valid = false
+		}
+	}
+
+	overrides, revisions := icon.doc.snapshot()
+	options := svgparser.DrawOptions{Transform: transform, Opacity: 1.0, Overrides: overrides}
+
+	for i := range icon.paths {
+		cache := &icon.paths[i]
+
+		var revision uint64
+		if revisions != nil {
+			revision = revisions[i]
+		}
+		if !cache.valid || cache.revision != revision {
+			if cache.op == nil {
+				cache.op = new(op.Ops)
+			}
+			cache.op.Reset()
+			macro := op.Record(cache.op)
+			icon.doc.render.DrawPath(&svgdraw.Driver{Ops: cache.op, Scale: scale}, i, options)
+			cache.macro = macro.Stop()
+			cache.valid, cache.revision = true, revision
+		}
+		cache.macro.Add(gtx.Ops)
+	}
+
+	return icon.lastDimensions
+}
@@ -4,6 +4,7 @@ package main
 import (
 	"image"
 	"image/color"
+	"math"
 
 	"gioui.org/f32"
 	"gioui.org/layout"
@@ -13,1639 +14,1845 @@ import (
 	"github.com/inkeliz/giosvg"
 )
 
-var _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil), (*paint.PaintOp)(nil), (*giosvg.Vector)(nil), (*color.NRGBA)(nil), (*layout.Dimensions)(nil), (*image.Image)(nil)
+var _, _, _, _, _, _, _, _, _ = (*f32.Point)(nil), (*op.Ops)(nil), (*clip.Op)(nil), (*paint.PaintOp)(nil), (*giosvg.Vector)(nil), (*color.NRGBA)(nil), (*layout.Dimensions)(nil), (*image.Image)(nil), math.Round
 
-var VectorSchoolBus giosvg.Vector = func(ops *op.Ops, constraints giosvg.Constraints) layout.Dimensions {
-	var w, h float32
-	if constraints.Max != constraints.Min {
+var VectorSchoolBus giosvg.Vector = ThemeVectorSchoolBus(giosvg.Theme{})
 
-		d := float32(1.000000)
-		if constraints.Max.Y*d > constraints.Max.X {
-			w, h = constraints.Max.X, constraints.Max.X/d
-		} else {
-			w, h = constraints.Max.Y*d, constraints.Max.Y
+// ThemeVectorSchoolBus returns the VectorSchoolBus using the colors replaced by the given Theme.
+func ThemeVectorSchoolBus(theme giosvg.Theme) giosvg.Vector {
+	return func(ops *op.Ops, constraints giosvg.Constraints) layout.Dimensions {
+		var w, h float32
+		if constraints.Max != constraints.Min {
+
+			d := float32(1.000000)
+			if constraints.Max.Y*d > constraints.Max.X {
+				w, h = constraints.Max.X, constraints.Max.X/d
+			} else {
+				w, h = constraints.Max.Y*d, constraints.Max.Y
+			}
 		}
-	}
 
-	if constraints.Min.X > w {
-		w = constraints.Min.X
-	}
-	if constraints.Min.Y > h {
-		h = constraints.Min.Y
-	}
+		if constraints.Min.X > w {
+			w = constraints.Min.X
+		}
+		if constraints.Min.Y > h {
+			h = constraints.Min.Y
+		}
 
-	var (
-		size = f32.Point{X: w / 512.001000, Y: h / 512.001000}
-		avg  = (size.X + size.Y) / 2
-		aff  = f32.Affine2D{}.Scale(f32.Point{X: float32(0 - 0.000000), Y: float32(0 - 0.000000)}, size)
-
-		end             clip.PathSpec
-		path            clip.Path
-		stroke, outline clip.Stack
-	)
-	_, _, _, _, _, _ = avg, aff, end, path, stroke, outline
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 57.089001, Y: 196.932999}))
-	path.LineTo(aff.Transform(f32.Point{X: 51.643002, Y: 196.932999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.817001, Y: 196.932999}), aff.Transform(f32.Point{X: 43.817001, Y: 187.345001}), aff.Transform(f32.Point{X: 43.817001, Y: 184.195007}))
-	path.LineTo(aff.Transform(f32.Point{X: 43.817001, Y: 79.553001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.817001, Y: 78.065002}), aff.Transform(f32.Point{X: 43.779999, Y: 76.612000}), aff.Transform(f32.Point{X: 43.743999, Y: 75.253998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.594002, Y: 69.420998}), aff.Transform(f32.Point{X: 43.504002, Y: 65.900002}), aff.Transform(f32.Point{X: 46.250999, Y: 64.764000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 48.740002, Y: 63.737000}), aff.Transform(f32.Point{X: 50.935001, Y: 66.051003}), aff.Transform(f32.Point{X: 51.873001, Y: 67.043999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 56.721001, Y: 72.162003}), aff.Transform(f32.Point{X: 61.618999, Y: 76.543999}), aff.Transform(f32.Point{X: 61.667000, Y: 76.586998}))
-	path.LineTo(aff.Transform(f32.Point{X: 58.348000, Y: 80.305000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 58.148998, Y: 80.126999}), aff.Transform(f32.Point{X: 53.525002, Y: 75.990997}), aff.Transform(f32.Point{X: 48.678001, Y: 70.913002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 48.651001, Y: 72.203003}), aff.Transform(f32.Point{X: 48.691002, Y: 73.801003}), aff.Transform(f32.Point{X: 48.724998, Y: 75.125999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 48.762001, Y: 76.524002}), aff.Transform(f32.Point{X: 48.799999, Y: 78.022003}), aff.Transform(f32.Point{X: 48.799999, Y: 79.553001}))
-	path.LineTo(aff.Transform(f32.Point{X: 48.799999, Y: 184.195007}))
-	path.CubeTo(aff.Transform(f32.Point{X: 48.799999, Y: 191.949997}), aff.Transform(f32.Point{X: 50.863998, Y: 191.949997}), aff.Transform(f32.Point{X: 51.643002, Y: 191.949997}))
-	path.LineTo(aff.Transform(f32.Point{X: 57.089001, Y: 191.949997}))
-	path.LineTo(aff.Transform(f32.Point{X: 57.089001, Y: 196.932999}))
-	path.LineTo(aff.Transform(f32.Point{X: 57.089001, Y: 196.932999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 47.421001, Y: 171.419006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 47.421001, Y: 175.087997}), aff.Transform(f32.Point{X: 44.445999, Y: 178.063995}), aff.Transform(f32.Point{X: 40.777000, Y: 178.063995}))
-	path.LineTo(aff.Transform(f32.Point{X: 28.318001, Y: 178.063995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 24.649000, Y: 178.063995}), aff.Transform(f32.Point{X: 21.674000, Y: 175.087997}), aff.Transform(f32.Point{X: 21.674000, Y: 171.419006}))
-	path.LineTo(aff.Transform(f32.Point{X: 21.674000, Y: 90.030998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 21.674000, Y: 86.361000}), aff.Transform(f32.Point{X: 24.649000, Y: 83.386002}), aff.Transform(f32.Point{X: 28.318001, Y: 83.386002}))
-	path.LineTo(aff.Transform(f32.Point{X: 40.776001, Y: 83.386002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 44.445000, Y: 83.386002}), aff.Transform(f32.Point{X: 47.419998, Y: 86.361000}), aff.Transform(f32.Point{X: 47.419998, Y: 90.030998}))
-	path.LineTo(aff.Transform(f32.Point{X: 47.421001, Y: 171.419006}))
-	path.LineTo(aff.Transform(f32.Point{X: 47.421001, Y: 171.419006}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 36.478001, Y: 172.994003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 29.898001, Y: 172.994003}), aff.Transform(f32.Point{X: 26.563000, Y: 167.634003}), aff.Transform(f32.Point{X: 26.563000, Y: 157.324997}))
-	path.CubeTo(aff.Transform(f32.Point{X: 26.563000, Y: 148.748001}), aff.Transform(f32.Point{X: 26.563000, Y: 99.835999}), aff.Transform(f32.Point{X: 26.563000, Y: 83.651001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 23.752001, Y: 84.426003}), aff.Transform(f32.Point{X: 21.676001, Y: 86.973000}), aff.Transform(f32.Point{X: 21.676001, Y: 90.030998}))
-	path.LineTo(aff.Transform(f32.Point{X: 21.676001, Y: 171.419006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 21.676001, Y: 175.087997}), aff.Transform(f32.Point{X: 24.650999, Y: 178.063995}), aff.Transform(f32.Point{X: 28.320000, Y: 178.063995}))
-	path.LineTo(aff.Transform(f32.Point{X: 40.778000, Y: 178.063995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.903000, Y: 178.063995}), aff.Transform(f32.Point{X: 46.504002, Y: 175.899994}), aff.Transform(f32.Point{X: 47.214001, Y: 172.994995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 44.157001, Y: 172.994003}), aff.Transform(f32.Point{X: 40.030998, Y: 172.994003}), aff.Transform(f32.Point{X: 36.478001, Y: 172.994003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 34.444000, Y: 89.261002}))
-	path.LineTo(aff.Transform(f32.Point{X: 41.021000, Y: 121.442001}))
-	path.LineTo(aff.Transform(f32.Point{X: 41.021000, Y: 89.261002}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 454.260010, Y: 196.932999}))
-	path.LineTo(aff.Transform(f32.Point{X: 459.707001, Y: 196.932999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.532990, Y: 196.932999}), aff.Transform(f32.Point{X: 467.532990, Y: 187.345001}), aff.Transform(f32.Point{X: 467.532990, Y: 184.195007}))
-	path.LineTo(aff.Transform(f32.Point{X: 467.532990, Y: 79.553001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.532990, Y: 78.065002}), aff.Transform(f32.Point{X: 467.570007, Y: 76.612000}), aff.Transform(f32.Point{X: 467.605011, Y: 75.253998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.755005, Y: 69.420998}), aff.Transform(f32.Point{X: 467.845001, Y: 65.900002}), aff.Transform(f32.Point{X: 465.097992, Y: 64.764000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 462.609009, Y: 63.737000}), aff.Transform(f32.Point{X: 460.414001, Y: 66.051003}), aff.Transform(f32.Point{X: 459.476013, Y: 67.043999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 454.627991, Y: 72.162003}), aff.Transform(f32.Point{X: 449.730988, Y: 76.543999}), aff.Transform(f32.Point{X: 449.682007, Y: 76.586998}))
-	path.LineTo(aff.Transform(f32.Point{X: 453.001007, Y: 80.305000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 453.200012, Y: 80.126999}), aff.Transform(f32.Point{X: 457.822998, Y: 75.990997}), aff.Transform(f32.Point{X: 462.670990, Y: 70.913002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 462.697998, Y: 72.203003}), aff.Transform(f32.Point{X: 462.657013, Y: 73.801003}), aff.Transform(f32.Point{X: 462.623993, Y: 75.125999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 462.587006, Y: 76.524002}), aff.Transform(f32.Point{X: 462.549011, Y: 78.022003}), aff.Transform(f32.Point{X: 462.549011, Y: 79.553001}))
-	path.LineTo(aff.Transform(f32.Point{X: 462.549011, Y: 184.195007}))
-	path.CubeTo(aff.Transform(f32.Point{X: 462.549011, Y: 191.949997}), aff.Transform(f32.Point{X: 460.484009, Y: 191.949997}), aff.Transform(f32.Point{X: 459.705994, Y: 191.949997}))
-	path.LineTo(aff.Transform(f32.Point{X: 454.259003, Y: 191.949997}))
-	path.LineTo(aff.Transform(f32.Point{X: 454.260010, Y: 196.932999}))
-	path.LineTo(aff.Transform(f32.Point{X: 454.260010, Y: 196.932999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 463.931000, Y: 171.419006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 463.931000, Y: 175.087997}), aff.Transform(f32.Point{X: 466.906006, Y: 178.063995}), aff.Transform(f32.Point{X: 470.575012, Y: 178.063995}))
-	path.LineTo(aff.Transform(f32.Point{X: 483.032013, Y: 178.063995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 486.701996, Y: 178.063995}), aff.Transform(f32.Point{X: 489.677002, Y: 175.087997}), aff.Transform(f32.Point{X: 489.677002, Y: 171.419006}))
-	path.LineTo(aff.Transform(f32.Point{X: 489.677002, Y: 90.030998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 489.677002, Y: 86.361000}), aff.Transform(f32.Point{X: 486.701996, Y: 83.386002}), aff.Transform(f32.Point{X: 483.032013, Y: 83.386002}))
-	path.LineTo(aff.Transform(f32.Point{X: 470.575012, Y: 83.386002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 466.906006, Y: 83.386002}), aff.Transform(f32.Point{X: 463.931000, Y: 86.361000}), aff.Transform(f32.Point{X: 463.931000, Y: 90.030998}))
-	path.LineTo(aff.Transform(f32.Point{X: 463.931000, Y: 171.419006}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 474.873993, Y: 172.994003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 481.453003, Y: 172.994003}), aff.Transform(f32.Point{X: 484.789001, Y: 167.634003}), aff.Transform(f32.Point{X: 484.789001, Y: 157.324997}))
-	path.CubeTo(aff.Transform(f32.Point{X: 484.789001, Y: 148.748001}), aff.Transform(f32.Point{X: 484.789001, Y: 99.835999}), aff.Transform(f32.Point{X: 484.789001, Y: 83.651001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 487.600006, Y: 84.426003}), aff.Transform(f32.Point{X: 489.675995, Y: 86.973000}), aff.Transform(f32.Point{X: 489.675995, Y: 90.030998}))
-	path.LineTo(aff.Transform(f32.Point{X: 489.675995, Y: 171.419006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 489.675995, Y: 175.087997}), aff.Transform(f32.Point{X: 486.700989, Y: 178.063995}), aff.Transform(f32.Point{X: 483.031006, Y: 178.063995}))
-	path.LineTo(aff.Transform(f32.Point{X: 470.574005, Y: 178.063995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.450012, Y: 178.063995}), aff.Transform(f32.Point{X: 464.847992, Y: 175.899994}), aff.Transform(f32.Point{X: 464.138000, Y: 172.994995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.194000, Y: 172.994003}), aff.Transform(f32.Point{X: 471.320007, Y: 172.994003}), aff.Transform(f32.Point{X: 474.873993, Y: 172.994003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 476.907013, Y: 89.261002}))
-	path.LineTo(aff.Transform(f32.Point{X: 470.329010, Y: 121.442001}))
-	path.LineTo(aff.Transform(f32.Point{X: 470.329010, Y: 89.261002}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 477.957001, Y: 328.462006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 490.174988, Y: 328.462006}), aff.Transform(f32.Point{X: 490.174988, Y: 313.473999}), aff.Transform(f32.Point{X: 490.174988, Y: 292.730011}))
-	path.LineTo(aff.Transform(f32.Point{X: 485.191986, Y: 292.730011}))
-	path.CubeTo(aff.Transform(f32.Point{X: 485.191986, Y: 312.936005}), aff.Transform(f32.Point{X: 484.869995, Y: 323.479004}), aff.Transform(f32.Point{X: 477.958008, Y: 323.479004}))
-	path.CubeTo(aff.Transform(f32.Point{X: 472.684998, Y: 323.479004}), aff.Transform(f32.Point{X: 469.020996, Y: 322.337006}), aff.Transform(f32.Point{X: 468.984985, Y: 322.325989}))
-	path.LineTo(aff.Transform(f32.Point{X: 467.479004, Y: 327.075989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.657013, Y: 327.131989}), aff.Transform(f32.Point{X: 471.920990, Y: 328.462006}), aff.Transform(f32.Point{X: 477.957001, Y: 328.462006}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 475.524994, Y: 259.341003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 480.528992, Y: 259.341003}), aff.Transform(f32.Point{X: 487.683990, Y: 259.341003}), aff.Transform(f32.Point{X: 487.683990, Y: 259.341003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 487.683990, Y: 259.341003}), aff.Transform(f32.Point{X: 494.838013, Y: 259.341003}), aff.Transform(f32.Point{X: 499.842010, Y: 259.341003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 504.846008, Y: 259.341003}), aff.Transform(f32.Point{X: 513.101013, Y: 264.878998}), aff.Transform(f32.Point{X: 508.143005, Y: 276.226990}))
-	path.CubeTo(aff.Transform(f32.Point{X: 503.186005, Y: 287.579010}), aff.Transform(f32.Point{X: 499.311005, Y: 296.158997}), aff.Transform(f32.Point{X: 487.683990, Y: 296.436005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 476.056000, Y: 296.158997}), aff.Transform(f32.Point{X: 472.182007, Y: 287.579010}), aff.Transform(f32.Point{X: 467.223999, Y: 276.226990}))
-	path.CubeTo(aff.Transform(f32.Point{X: 462.265991, Y: 264.878998}), aff.Transform(f32.Point{X: 470.519989, Y: 259.341003}), aff.Transform(f32.Point{X: 475.524994, Y: 259.341003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 487.683014, Y: 289.131989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 499.101013, Y: 289.131989}), aff.Transform(f32.Point{X: 505.747986, Y: 273.804993}), aff.Transform(f32.Point{X: 508.368011, Y: 264.529999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 509.933990, Y: 267.300995}), aff.Transform(f32.Point{X: 510.343994, Y: 271.187988}), aff.Transform(f32.Point{X: 508.141998, Y: 276.226013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 503.184998, Y: 287.578003}), aff.Transform(f32.Point{X: 499.309998, Y: 296.157990}), aff.Transform(f32.Point{X: 487.683014, Y: 296.434998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 476.054993, Y: 296.157990}), aff.Transform(f32.Point{X: 472.181000, Y: 287.578003}), aff.Transform(f32.Point{X: 467.222992, Y: 276.226013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 465.080994, Y: 271.325012}), aff.Transform(f32.Point{X: 465.411011, Y: 267.511993}), aff.Transform(f32.Point{X: 466.872009, Y: 264.757996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 469.398010, Y: 273.645996}), aff.Transform(f32.Point{X: 476.071991, Y: 289.131989}), aff.Transform(f32.Point{X: 487.683014, Y: 289.131989}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 500.118011, Y: 264.529999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 500.118011, Y: 264.529999}), aff.Transform(f32.Point{X: 494.074005, Y: 272.119995}), aff.Transform(f32.Point{X: 487.683990, Y: 272.804993}))
-	path.CubeTo(aff.Transform(f32.Point{X: 481.292999, Y: 273.492004}), aff.Transform(f32.Point{X: 471.881012, Y: 264.529999}), aff.Transform(f32.Point{X: 471.881012, Y: 264.529999}))
-	path.LineTo(aff.Transform(f32.Point{X: 500.118011, Y: 264.529999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 474.875000, Y: 430.614014}))
-	path.CubeTo(aff.Transform(f32.Point{X: 474.875000, Y: 434.282990}), aff.Transform(f32.Point{X: 471.899994, Y: 437.257996}), aff.Transform(f32.Point{X: 468.230988, Y: 437.257996}))
-	path.LineTo(aff.Transform(f32.Point{X: 43.769001, Y: 437.257996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 40.099998, Y: 437.257996}), aff.Transform(f32.Point{X: 37.125000, Y: 434.282990}), aff.Transform(f32.Point{X: 37.125000, Y: 430.614014}))
-	path.LineTo(aff.Transform(f32.Point{X: 37.125000, Y: 385.036011}))
-	path.CubeTo(aff.Transform(f32.Point{X: 37.125000, Y: 381.365997}), aff.Transform(f32.Point{X: 40.099998, Y: 378.390991}), aff.Transform(f32.Point{X: 43.769001, Y: 378.390991}))
-	path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.390991}))
-	path.CubeTo(aff.Transform(f32.Point{X: 471.898987, Y: 378.390991}), aff.Transform(f32.Point{X: 474.873993, Y: 381.365997}), aff.Transform(f32.Point{X: 474.873993, Y: 385.036011}))
-	path.LineTo(aff.Transform(f32.Point{X: 474.875000, Y: 430.614014}))
-	path.LineTo(aff.Transform(f32.Point{X: 474.875000, Y: 430.614014}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 43.771000, Y: 378.391998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 40.102001, Y: 378.391998}), aff.Transform(f32.Point{X: 37.126999, Y: 381.367004}), aff.Transform(f32.Point{X: 37.126999, Y: 385.036987}))
-	path.LineTo(aff.Transform(f32.Point{X: 37.126999, Y: 430.614990}))
-	path.CubeTo(aff.Transform(f32.Point{X: 37.126999, Y: 434.283997}), aff.Transform(f32.Point{X: 40.102001, Y: 437.259003}), aff.Transform(f32.Point{X: 43.771000, Y: 437.259003}))
-	path.LineTo(aff.Transform(f32.Point{X: 52.025002, Y: 437.259003}))
-	path.LineTo(aff.Transform(f32.Point{X: 52.025002, Y: 378.393005}))
-	path.LineTo(aff.Transform(f32.Point{X: 43.771000, Y: 378.393005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.771000, Y: 378.393005}), aff.Transform(f32.Point{X: 43.771000, Y: 378.391998}), aff.Transform(f32.Point{X: 43.771000, Y: 378.391998}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 128}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.391998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 471.898987, Y: 378.391998}), aff.Transform(f32.Point{X: 474.873993, Y: 381.367004}), aff.Transform(f32.Point{X: 474.873993, Y: 385.036987}))
-	path.LineTo(aff.Transform(f32.Point{X: 474.873993, Y: 430.614990}))
-	path.CubeTo(aff.Transform(f32.Point{X: 474.873993, Y: 434.283997}), aff.Transform(f32.Point{X: 471.898987, Y: 437.259003}), aff.Transform(f32.Point{X: 468.230011, Y: 437.259003}))
-	path.LineTo(aff.Transform(f32.Point{X: 459.976013, Y: 437.259003}))
-	path.LineTo(aff.Transform(f32.Point{X: 459.976013, Y: 378.393005}))
-	path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.393005}))
-	path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.391998}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 128}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 43.705002, Y: 378.391998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 41.933998, Y: 378.391998}), aff.Transform(f32.Point{X: 40.332001, Y: 379.113007}), aff.Transform(f32.Point{X: 39.152000, Y: 380.270996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 116.897003, Y: 390.516998}), aff.Transform(f32.Point{X: 255.996994, Y: 392.529999}), aff.Transform(f32.Point{X: 255.996994, Y: 392.529999}))
-	path.LineTo(aff.Transform(f32.Point{X: 255.929993, Y: 378.391998}))
-	path.LineTo(aff.Transform(f32.Point{X: 43.705002, Y: 378.391998}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 468.290009, Y: 378.391998}))
-	path.LineTo(aff.Transform(f32.Point{X: 256.066010, Y: 378.391998}))
-	path.LineTo(aff.Transform(f32.Point{X: 255.998993, Y: 392.529999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 255.998993, Y: 392.529999}), aff.Transform(f32.Point{X: 395.097992, Y: 390.516998}), aff.Transform(f32.Point{X: 472.842987, Y: 380.270996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 471.662994, Y: 379.112000}), aff.Transform(f32.Point{X: 470.062012, Y: 378.391998}), aff.Transform(f32.Point{X: 468.290009, Y: 378.391998}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 474.854004, Y: 355.830994}))
-	path.CubeTo(aff.Transform(f32.Point{X: 474.304993, Y: 329.808014}), aff.Transform(f32.Point{X: 472.660004, Y: 306.001007}), aff.Transform(f32.Point{X: 456.765991, Y: 287.730011}))
-	path.LineTo(aff.Transform(f32.Point{X: 451.283997, Y: 83.428001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 451.283997, Y: 83.428001}), aff.Transform(f32.Point{X: 453.477997, Y: 52.423000}), aff.Transform(f32.Point{X: 431.552002, Y: 36.921001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 409.627014, Y: 21.420000}), aff.Transform(f32.Point{X: 363.181000, Y: 5.155000}), aff.Transform(f32.Point{X: 255.677002, Y: 0.000000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 148.171997, Y: 5.155000}), aff.Transform(f32.Point{X: 101.724998, Y: 21.420000}), aff.Transform(f32.Point{X: 79.801003, Y: 36.922001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 57.876999, Y: 52.424000}), aff.Transform(f32.Point{X: 60.068001, Y: 83.429001}), aff.Transform(f32.Point{X: 60.068001, Y: 83.429001}))
-	path.LineTo(aff.Transform(f32.Point{X: 54.585999, Y: 287.730988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 38.689999, Y: 306.002014}), aff.Transform(f32.Point{X: 37.044998, Y: 329.808014}), aff.Transform(f32.Point{X: 36.499001, Y: 355.832001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 35.950001, Y: 381.854004}), aff.Transform(f32.Point{X: 46.216999, Y: 378.802002}), aff.Transform(f32.Point{X: 46.216999, Y: 378.802002}))
-	path.LineTo(aff.Transform(f32.Point{X: 255.677002, Y: 383.891998}))
-	path.LineTo(aff.Transform(f32.Point{X: 465.136993, Y: 378.802002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 465.135010, Y: 378.800995}), aff.Transform(f32.Point{X: 475.402008, Y: 381.854004}), aff.Transform(f32.Point{X: 474.854004, Y: 355.830994}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 252.016006, Y: 73.188004}))
-	path.LineTo(aff.Transform(f32.Point{X: 69.613998, Y: 73.188004}))
-	path.LineTo(aff.Transform(f32.Point{X: 62.797001, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 252.287003, Y: 198.867996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 69.613998, Y: 73.188004}))
-	path.LineTo(aff.Transform(f32.Point{X: 62.797001, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 252.287003, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 252.279999, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 71.367996, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 75.135002, Y: 73.047997}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 259.270996, Y: 73.188004}))
-	path.LineTo(aff.Transform(f32.Point{X: 441.670013, Y: 73.188004}))
-	path.LineTo(aff.Transform(f32.Point{X: 448.485992, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 258.997009, Y: 198.867996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 69.704002, Y: 47.160000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 72.315002, Y: 43.396000}), aff.Transform(f32.Point{X: 75.619003, Y: 39.876999}), aff.Transform(f32.Point{X: 79.800003, Y: 36.922001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 93.789001, Y: 27.030001}), aff.Transform(f32.Point{X: 117.762001, Y: 16.827000}), aff.Transform(f32.Point{X: 161.209000, Y: 9.401000}))
-	path.LineTo(aff.Transform(f32.Point{X: 161.209000, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 69.719002, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 69.704002, Y: 47.160000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 69.719002, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 161.207993, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 162.179001, Y: 78.445999}))
-	path.LineTo(aff.Transform(f32.Point{X: 72.486000, Y: 78.445999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 164.322998, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 347.031006, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 345.369995, Y: 78.445999}))
-	path.LineTo(aff.Transform(f32.Point{X: 165.707001, Y: 78.445999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 441.647003, Y: 47.160000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 439.036987, Y: 43.396000}), aff.Transform(f32.Point{X: 435.733002, Y: 39.876999}), aff.Transform(f32.Point{X: 431.550995, Y: 36.922001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 417.562988, Y: 27.030001}), aff.Transform(f32.Point{X: 393.588989, Y: 16.827000}), aff.Transform(f32.Point{X: 350.144012, Y: 9.401000}))
-	path.LineTo(aff.Transform(f32.Point{X: 350.144012, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 441.634003, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 441.647003, Y: 47.160000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 390.131012, Y: 50.187000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 390.131012, Y: 48.423088}), aff.Transform(f32.Point{X: 389.804108, Y: 46.674351}), aff.Transform(f32.Point{X: 389.166901, Y: 45.029552}))
-	path.CubeTo(aff.Transform(f32.Point{X: 388.529694, Y: 43.384754}), aff.Transform(f32.Point{X: 387.593170, Y: 41.872196}), aff.Transform(f32.Point{X: 386.404816, Y: 40.568645}))
-	path.CubeTo(aff.Transform(f32.Point{X: 385.216492, Y: 39.265099}), aff.Transform(f32.Point{X: 383.796783, Y: 38.192997}), aff.Transform(f32.Point{X: 382.217804, Y: 37.406754}))
-	path.CubeTo(aff.Transform(f32.Point{X: 380.638824, Y: 36.620510}), aff.Transform(f32.Point{X: 378.927704, Y: 36.133656}), aff.Transform(f32.Point{X: 377.171326, Y: 35.970901}))
-	path.CubeTo(aff.Transform(f32.Point{X: 375.414917, Y: 35.808151}), aff.Transform(f32.Point{X: 373.643494, Y: 35.972298}), aff.Transform(f32.Point{X: 371.946899, Y: 36.455017}))
-	path.CubeTo(aff.Transform(f32.Point{X: 370.250336, Y: 36.937733}), aff.Transform(f32.Point{X: 368.657806, Y: 37.730713}), aff.Transform(f32.Point{X: 367.250183, Y: 38.793709}))
-	path.CubeTo(aff.Transform(f32.Point{X: 365.842560, Y: 39.856705}), aff.Transform(f32.Point{X: 364.644043, Y: 41.171417}), aff.Transform(f32.Point{X: 363.715454, Y: 42.671127}))
-	path.CubeTo(aff.Transform(f32.Point{X: 362.786865, Y: 44.170837}), aff.Transform(f32.Point{X: 362.144226, Y: 45.829731}), aff.Transform(f32.Point{X: 361.820099, Y: 47.563606}))
-	path.CubeTo(aff.Transform(f32.Point{X: 361.495972, Y: 49.297485}), aff.Transform(f32.Point{X: 361.495972, Y: 51.076511}), aff.Transform(f32.Point{X: 361.820099, Y: 52.810390}))
-	path.CubeTo(aff.Transform(f32.Point{X: 362.144226, Y: 54.544270}), aff.Transform(f32.Point{X: 362.786865, Y: 56.203163}), aff.Transform(f32.Point{X: 363.715454, Y: 57.702873}))
-	path.CubeTo(aff.Transform(f32.Point{X: 364.644043, Y: 59.202579}), aff.Transform(f32.Point{X: 365.842560, Y: 60.517296}), aff.Transform(f32.Point{X: 367.250183, Y: 61.580292}))
-	path.CubeTo(aff.Transform(f32.Point{X: 368.657806, Y: 62.643288}), aff.Transform(f32.Point{X: 370.250336, Y: 63.436268}), aff.Transform(f32.Point{X: 371.946899, Y: 63.918983}))
-	path.CubeTo(aff.Transform(f32.Point{X: 373.643494, Y: 64.401703}), aff.Transform(f32.Point{X: 375.414917, Y: 64.565849}), aff.Transform(f32.Point{X: 377.171326, Y: 64.403099}))
-	path.CubeTo(aff.Transform(f32.Point{X: 378.927704, Y: 64.240341}), aff.Transform(f32.Point{X: 380.638824, Y: 63.753490}), aff.Transform(f32.Point{X: 382.217804, Y: 62.967247}))
-	path.CubeTo(aff.Transform(f32.Point{X: 383.796783, Y: 62.181004}), aff.Transform(f32.Point{X: 385.216492, Y: 61.108898}), aff.Transform(f32.Point{X: 386.404816, Y: 59.805351}))
-	path.CubeTo(aff.Transform(f32.Point{X: 387.593170, Y: 58.501804}), aff.Transform(f32.Point{X: 388.529694, Y: 56.989246}), aff.Transform(f32.Point{X: 389.166901, Y: 55.344448}))
-	path.CubeTo(aff.Transform(f32.Point{X: 389.804108, Y: 53.699646}), aff.Transform(f32.Point{X: 390.131012, Y: 51.950912}), aff.Transform(f32.Point{X: 390.131012, Y: 50.187000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 185, G: 72, B: 62, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 386.365997, Y: 50.187000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 386.365997, Y: 48.888126}), aff.Transform(f32.Point{X: 386.125275, Y: 47.600430}), aff.Transform(f32.Point{X: 385.656067, Y: 46.389267}))
-	path.CubeTo(aff.Transform(f32.Point{X: 385.186859, Y: 45.178104}), aff.Transform(f32.Point{X: 384.497253, Y: 44.064316}), aff.Transform(f32.Point{X: 383.622192, Y: 43.104439}))
-	path.CubeTo(aff.Transform(f32.Point{X: 382.747162, Y: 42.144558}), aff.Transform(f32.Point{X: 381.701752, Y: 41.355106}), aff.Transform(f32.Point{X: 380.539062, Y: 40.776150}))
-	path.CubeTo(aff.Transform(f32.Point{X: 379.376343, Y: 40.197189}), aff.Transform(f32.Point{X: 378.116364, Y: 39.838692}), aff.Transform(f32.Point{X: 376.823029, Y: 39.718845}))
-	path.CubeTo(aff.Transform(f32.Point{X: 375.529694, Y: 39.599003}), aff.Transform(f32.Point{X: 374.225281, Y: 39.719875}), aff.Transform(f32.Point{X: 372.975983, Y: 40.075329}))
-	path.CubeTo(aff.Transform(f32.Point{X: 371.726685, Y: 40.430782}), aff.Transform(f32.Point{X: 370.554016, Y: 41.014698}), aff.Transform(f32.Point{X: 369.517517, Y: 41.797443}))
-	path.CubeTo(aff.Transform(f32.Point{X: 368.480988, Y: 42.580193}), aff.Transform(f32.Point{X: 367.598450, Y: 43.548294}), aff.Transform(f32.Point{X: 366.914673, Y: 44.652618}))
-	path.CubeTo(aff.Transform(f32.Point{X: 366.230896, Y: 45.756943}), aff.Transform(f32.Point{X: 365.757660, Y: 46.978485}), aff.Transform(f32.Point{X: 365.519012, Y: 48.255241}))
-	path.CubeTo(aff.Transform(f32.Point{X: 365.280334, Y: 49.531998}), aff.Transform(f32.Point{X: 365.280334, Y: 50.842003}), aff.Transform(f32.Point{X: 365.519012, Y: 52.118759}))
-	path.CubeTo(aff.Transform(f32.Point{X: 365.757660, Y: 53.395515}), aff.Transform(f32.Point{X: 366.230896, Y: 54.617058}), aff.Transform(f32.Point{X: 366.914673, Y: 55.721382}))
-	path.CubeTo(aff.Transform(f32.Point{X: 367.598450, Y: 56.825706}), aff.Transform(f32.Point{X: 368.480988, Y: 57.793808}), aff.Transform(f32.Point{X: 369.517517, Y: 58.576553}))
-	path.CubeTo(aff.Transform(f32.Point{X: 370.554016, Y: 59.359303}), aff.Transform(f32.Point{X: 371.726685, Y: 59.943218}), aff.Transform(f32.Point{X: 372.975983, Y: 60.298672}))
-	path.CubeTo(aff.Transform(f32.Point{X: 374.225281, Y: 60.654125}), aff.Transform(f32.Point{X: 375.529694, Y: 60.774998}), aff.Transform(f32.Point{X: 376.823029, Y: 60.655155}))
-	path.CubeTo(aff.Transform(f32.Point{X: 378.116364, Y: 60.535309}), aff.Transform(f32.Point{X: 379.376343, Y: 60.176811}), aff.Transform(f32.Point{X: 380.539062, Y: 59.597851}))
-	path.CubeTo(aff.Transform(f32.Point{X: 381.701752, Y: 59.018894}), aff.Transform(f32.Point{X: 382.747162, Y: 58.229443}), aff.Transform(f32.Point{X: 383.622192, Y: 57.269562}))
-	path.CubeTo(aff.Transform(f32.Point{X: 384.497253, Y: 56.309685}), aff.Transform(f32.Point{X: 385.186859, Y: 55.195896}), aff.Transform(f32.Point{X: 385.656067, Y: 53.984734}))
-	path.CubeTo(aff.Transform(f32.Point{X: 386.125275, Y: 52.773571}), aff.Transform(f32.Point{X: 386.365997, Y: 51.485874}), aff.Transform(f32.Point{X: 386.365997, Y: 50.187000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 223, G: 88, B: 76, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 420.397003, Y: 52.213001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 420.397003, Y: 50.699398}), aff.Transform(f32.Point{X: 420.116486, Y: 49.198822}), aff.Transform(f32.Point{X: 419.569702, Y: 47.787430}))
-	path.CubeTo(aff.Transform(f32.Point{X: 419.022949, Y: 46.376038}), aff.Transform(f32.Point{X: 418.219299, Y: 45.078121}), aff.Transform(f32.Point{X: 417.199585, Y: 43.959553}))
-	path.CubeTo(aff.Transform(f32.Point{X: 416.179901, Y: 42.840988}), aff.Transform(f32.Point{X: 414.961670, Y: 41.921024}), aff.Transform(f32.Point{X: 413.606750, Y: 41.246353}))
-	path.CubeTo(aff.Transform(f32.Point{X: 412.251831, Y: 40.571686}), aff.Transform(f32.Point{X: 410.783539, Y: 40.153919}), aff.Transform(f32.Point{X: 409.276367, Y: 40.014259}))
-	path.CubeTo(aff.Transform(f32.Point{X: 407.769226, Y: 39.874603}), aff.Transform(f32.Point{X: 406.249176, Y: 40.015457}), aff.Transform(f32.Point{X: 404.793365, Y: 40.429672}))
-	path.CubeTo(aff.Transform(f32.Point{X: 403.337524, Y: 40.843891}), aff.Transform(f32.Point{X: 401.971008, Y: 41.524342}), aff.Transform(f32.Point{X: 400.763123, Y: 42.436489}))
-	path.CubeTo(aff.Transform(f32.Point{X: 399.555237, Y: 43.348640}), aff.Transform(f32.Point{X: 398.526794, Y: 44.476788}), aff.Transform(f32.Point{X: 397.729980, Y: 45.763680}))
-	path.CubeTo(aff.Transform(f32.Point{X: 396.933167, Y: 47.050571}), aff.Transform(f32.Point{X: 396.381714, Y: 48.474056}), aff.Transform(f32.Point{X: 396.103607, Y: 49.961884}))
-	path.CubeTo(aff.Transform(f32.Point{X: 395.825470, Y: 51.449715}), aff.Transform(f32.Point{X: 395.825470, Y: 52.976284}), aff.Transform(f32.Point{X: 396.103607, Y: 54.464115}))
-	path.CubeTo(aff.Transform(f32.Point{X: 396.381714, Y: 55.951946}), aff.Transform(f32.Point{X: 396.933167, Y: 57.375431}), aff.Transform(f32.Point{X: 397.729980, Y: 58.662319}))
-	path.CubeTo(aff.Transform(f32.Point{X: 398.526794, Y: 59.949211}), aff.Transform(f32.Point{X: 399.555237, Y: 61.077358}), aff.Transform(f32.Point{X: 400.763123, Y: 61.989510}))
-	path.CubeTo(aff.Transform(f32.Point{X: 401.971008, Y: 62.901657}), aff.Transform(f32.Point{X: 403.337524, Y: 63.582108}), aff.Transform(f32.Point{X: 404.793365, Y: 63.996326}))
-	path.CubeTo(aff.Transform(f32.Point{X: 406.249176, Y: 64.410545}), aff.Transform(f32.Point{X: 407.769226, Y: 64.551399}), aff.Transform(f32.Point{X: 409.276367, Y: 64.411743}))
-	path.CubeTo(aff.Transform(f32.Point{X: 410.783539, Y: 64.272079}), aff.Transform(f32.Point{X: 412.251831, Y: 63.854317}), aff.Transform(f32.Point{X: 413.606750, Y: 63.179646}))
-	path.CubeTo(aff.Transform(f32.Point{X: 414.961670, Y: 62.504974}), aff.Transform(f32.Point{X: 416.179901, Y: 61.585011}), aff.Transform(f32.Point{X: 417.199585, Y: 60.466446}))
-	path.CubeTo(aff.Transform(f32.Point{X: 418.219299, Y: 59.347881}), aff.Transform(f32.Point{X: 419.022949, Y: 58.049965}), aff.Transform(f32.Point{X: 419.569702, Y: 56.638573}))
-	path.CubeTo(aff.Transform(f32.Point{X: 420.116486, Y: 55.227180}), aff.Transform(f32.Point{X: 420.397003, Y: 53.726601}), aff.Transform(f32.Point{X: 420.397003, Y: 52.213001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 185, G: 72, B: 62, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 417.166992, Y: 52.213001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 417.166992, Y: 51.098461}), aff.Transform(f32.Point{X: 416.960449, Y: 49.993515}), aff.Transform(f32.Point{X: 416.557831, Y: 48.954239}))
-	path.CubeTo(aff.Transform(f32.Point{X: 416.155212, Y: 47.914963}), aff.Transform(f32.Point{X: 415.563446, Y: 46.959244}), aff.Transform(f32.Point{X: 414.812592, Y: 46.135593}))
-	path.CubeTo(aff.Transform(f32.Point{X: 414.061737, Y: 45.311939}), aff.Transform(f32.Point{X: 413.164703, Y: 44.634525}), aff.Transform(f32.Point{X: 412.166992, Y: 44.137733}))
-	path.CubeTo(aff.Transform(f32.Point{X: 411.169312, Y: 43.640938}), aff.Transform(f32.Point{X: 410.088135, Y: 43.333317}), aff.Transform(f32.Point{X: 408.978363, Y: 43.230480}))
-	path.CubeTo(aff.Transform(f32.Point{X: 407.868561, Y: 43.127644}), aff.Transform(f32.Point{X: 406.749268, Y: 43.231361}), aff.Transform(f32.Point{X: 405.677277, Y: 43.536369}))
-	path.CubeTo(aff.Transform(f32.Point{X: 404.605286, Y: 43.841377}), aff.Transform(f32.Point{X: 403.599060, Y: 44.342426}), aff.Transform(f32.Point{X: 402.709625, Y: 45.014088}))
-	path.CubeTo(aff.Transform(f32.Point{X: 401.820221, Y: 45.685745}), aff.Transform(f32.Point{X: 401.062927, Y: 46.516457}), aff.Transform(f32.Point{X: 400.476196, Y: 47.464054}))
-	path.CubeTo(aff.Transform(f32.Point{X: 399.889465, Y: 48.411655}), aff.Transform(f32.Point{X: 399.483398, Y: 49.459835}), aff.Transform(f32.Point{X: 399.278595, Y: 50.555397}))
-	path.CubeTo(aff.Transform(f32.Point{X: 399.073792, Y: 51.650955}), aff.Transform(f32.Point{X: 399.073792, Y: 52.775043}), aff.Transform(f32.Point{X: 399.278595, Y: 53.870605}))
-	path.CubeTo(aff.Transform(f32.Point{X: 399.483398, Y: 54.966164}), aff.Transform(f32.Point{X: 399.889465, Y: 56.014347}), aff.Transform(f32.Point{X: 400.476196, Y: 56.961945}))
-	path.CubeTo(aff.Transform(f32.Point{X: 401.062927, Y: 57.909542}), aff.Transform(f32.Point{X: 401.820221, Y: 58.740253}), aff.Transform(f32.Point{X: 402.709625, Y: 59.411915}))
-	path.CubeTo(aff.Transform(f32.Point{X: 403.599060, Y: 60.083572}), aff.Transform(f32.Point{X: 404.605286, Y: 60.584621}), aff.Transform(f32.Point{X: 405.677277, Y: 60.889629}))
-	path.CubeTo(aff.Transform(f32.Point{X: 406.749268, Y: 61.194637}), aff.Transform(f32.Point{X: 407.868561, Y: 61.298355}), aff.Transform(f32.Point{X: 408.978363, Y: 61.195518}))
-	path.CubeTo(aff.Transform(f32.Point{X: 410.088135, Y: 61.092682}), aff.Transform(f32.Point{X: 411.169312, Y: 60.785061}), aff.Transform(f32.Point{X: 412.166992, Y: 60.288269}))
-	path.CubeTo(aff.Transform(f32.Point{X: 413.164703, Y: 59.791477}), aff.Transform(f32.Point{X: 414.061737, Y: 59.114063}), aff.Transform(f32.Point{X: 414.812592, Y: 58.290409}))
-	path.CubeTo(aff.Transform(f32.Point{X: 415.563446, Y: 57.466755}), aff.Transform(f32.Point{X: 416.155212, Y: 56.511036}), aff.Transform(f32.Point{X: 416.557831, Y: 55.471760}))
-	path.CubeTo(aff.Transform(f32.Point{X: 416.960449, Y: 54.432484}), aff.Transform(f32.Point{X: 417.166992, Y: 53.327538}), aff.Transform(f32.Point{X: 417.166992, Y: 52.213001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 223, G: 88, B: 76, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 149.778000, Y: 50.187000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 149.778000, Y: 48.423088}), aff.Transform(f32.Point{X: 149.451111, Y: 46.674351}), aff.Transform(f32.Point{X: 148.813904, Y: 45.029552}))
-	path.CubeTo(aff.Transform(f32.Point{X: 148.176712, Y: 43.384754}), aff.Transform(f32.Point{X: 147.240173, Y: 41.872196}), aff.Transform(f32.Point{X: 146.051834, Y: 40.568645}))
-	path.CubeTo(aff.Transform(f32.Point{X: 144.863495, Y: 39.265099}), aff.Transform(f32.Point{X: 143.443802, Y: 38.192997}), aff.Transform(f32.Point{X: 141.864807, Y: 37.406754}))
-	path.CubeTo(aff.Transform(f32.Point{X: 140.285812, Y: 36.620510}), aff.Transform(f32.Point{X: 138.574707, Y: 36.133656}), aff.Transform(f32.Point{X: 136.818314, Y: 35.970901}))
-	path.CubeTo(aff.Transform(f32.Point{X: 135.061920, Y: 35.808151}), aff.Transform(f32.Point{X: 133.290497, Y: 35.972298}), aff.Transform(f32.Point{X: 131.593918, Y: 36.455017}))
-	path.CubeTo(aff.Transform(f32.Point{X: 129.897339, Y: 36.937733}), aff.Transform(f32.Point{X: 128.304825, Y: 37.730713}), aff.Transform(f32.Point{X: 126.897186, Y: 38.793709}))
-	path.CubeTo(aff.Transform(f32.Point{X: 125.489555, Y: 39.856705}), aff.Transform(f32.Point{X: 124.291031, Y: 41.171417}), aff.Transform(f32.Point{X: 123.362450, Y: 42.671127}))
-	path.CubeTo(aff.Transform(f32.Point{X: 122.433868, Y: 44.170837}), aff.Transform(f32.Point{X: 121.791214, Y: 45.829731}), aff.Transform(f32.Point{X: 121.467094, Y: 47.563606}))
-	path.CubeTo(aff.Transform(f32.Point{X: 121.142975, Y: 49.297485}), aff.Transform(f32.Point{X: 121.142975, Y: 51.076511}), aff.Transform(f32.Point{X: 121.467094, Y: 52.810390}))
-	path.CubeTo(aff.Transform(f32.Point{X: 121.791214, Y: 54.544270}), aff.Transform(f32.Point{X: 122.433868, Y: 56.203163}), aff.Transform(f32.Point{X: 123.362450, Y: 57.702873}))
-	path.CubeTo(aff.Transform(f32.Point{X: 124.291031, Y: 59.202579}), aff.Transform(f32.Point{X: 125.489555, Y: 60.517296}), aff.Transform(f32.Point{X: 126.897186, Y: 61.580292}))
-	path.CubeTo(aff.Transform(f32.Point{X: 128.304825, Y: 62.643288}), aff.Transform(f32.Point{X: 129.897339, Y: 63.436268}), aff.Transform(f32.Point{X: 131.593918, Y: 63.918983}))
-	path.CubeTo(aff.Transform(f32.Point{X: 133.290497, Y: 64.401703}), aff.Transform(f32.Point{X: 135.061920, Y: 64.565849}), aff.Transform(f32.Point{X: 136.818314, Y: 64.403099}))
-	path.CubeTo(aff.Transform(f32.Point{X: 138.574707, Y: 64.240341}), aff.Transform(f32.Point{X: 140.285812, Y: 63.753490}), aff.Transform(f32.Point{X: 141.864807, Y: 62.967247}))
-	path.CubeTo(aff.Transform(f32.Point{X: 143.443802, Y: 62.181004}), aff.Transform(f32.Point{X: 144.863495, Y: 61.108898}), aff.Transform(f32.Point{X: 146.051834, Y: 59.805351}))
-	path.CubeTo(aff.Transform(f32.Point{X: 147.240173, Y: 58.501804}), aff.Transform(f32.Point{X: 148.176712, Y: 56.989246}), aff.Transform(f32.Point{X: 148.813904, Y: 55.344448}))
-	path.CubeTo(aff.Transform(f32.Point{X: 149.451111, Y: 53.699646}), aff.Transform(f32.Point{X: 149.778000, Y: 51.950912}), aff.Transform(f32.Point{X: 149.778000, Y: 50.187000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 185, G: 72, B: 62, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 146.014008, Y: 50.187000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 146.014008, Y: 48.888126}), aff.Transform(f32.Point{X: 145.773285, Y: 47.600430}), aff.Transform(f32.Point{X: 145.304077, Y: 46.389267}))
-	path.CubeTo(aff.Transform(f32.Point{X: 144.834869, Y: 45.178104}), aff.Transform(f32.Point{X: 144.145248, Y: 44.064316}), aff.Transform(f32.Point{X: 143.270203, Y: 43.104439}))
-	path.CubeTo(aff.Transform(f32.Point{X: 142.395157, Y: 42.144558}), aff.Transform(f32.Point{X: 141.349747, Y: 41.355106}), aff.Transform(f32.Point{X: 140.187042, Y: 40.776150}))
-	path.CubeTo(aff.Transform(f32.Point{X: 139.024338, Y: 40.197189}), aff.Transform(f32.Point{X: 137.764343, Y: 39.838692}), aff.Transform(f32.Point{X: 136.471024, Y: 39.718845}))
-	path.CubeTo(aff.Transform(f32.Point{X: 135.177689, Y: 39.599003}), aff.Transform(f32.Point{X: 133.873276, Y: 39.719875}), aff.Transform(f32.Point{X: 132.623978, Y: 40.075329}))
-	path.CubeTo(aff.Transform(f32.Point{X: 131.374695, Y: 40.430782}), aff.Transform(f32.Point{X: 130.202026, Y: 41.014698}), aff.Transform(f32.Point{X: 129.165497, Y: 41.797443}))
-	path.CubeTo(aff.Transform(f32.Point{X: 128.128983, Y: 42.580193}), aff.Transform(f32.Point{X: 127.246437, Y: 43.548294}), aff.Transform(f32.Point{X: 126.562668, Y: 44.652618}))
-	path.CubeTo(aff.Transform(f32.Point{X: 125.878899, Y: 45.756943}), aff.Transform(f32.Point{X: 125.405670, Y: 46.978485}), aff.Transform(f32.Point{X: 125.167007, Y: 48.255241}))
-	path.CubeTo(aff.Transform(f32.Point{X: 124.928337, Y: 49.531998}), aff.Transform(f32.Point{X: 124.928337, Y: 50.842003}), aff.Transform(f32.Point{X: 125.167007, Y: 52.118759}))
-	path.CubeTo(aff.Transform(f32.Point{X: 125.405670, Y: 53.395515}), aff.Transform(f32.Point{X: 125.878899, Y: 54.617058}), aff.Transform(f32.Point{X: 126.562668, Y: 55.721382}))
-	path.CubeTo(aff.Transform(f32.Point{X: 127.246437, Y: 56.825706}), aff.Transform(f32.Point{X: 128.128983, Y: 57.793808}), aff.Transform(f32.Point{X: 129.165497, Y: 58.576553}))
-	path.CubeTo(aff.Transform(f32.Point{X: 130.202026, Y: 59.359303}), aff.Transform(f32.Point{X: 131.374695, Y: 59.943218}), aff.Transform(f32.Point{X: 132.623978, Y: 60.298672}))
-	path.CubeTo(aff.Transform(f32.Point{X: 133.873276, Y: 60.654125}), aff.Transform(f32.Point{X: 135.177689, Y: 60.774998}), aff.Transform(f32.Point{X: 136.471024, Y: 60.655155}))
-	path.CubeTo(aff.Transform(f32.Point{X: 137.764343, Y: 60.535309}), aff.Transform(f32.Point{X: 139.024338, Y: 60.176811}), aff.Transform(f32.Point{X: 140.187042, Y: 59.597851}))
-	path.CubeTo(aff.Transform(f32.Point{X: 141.349747, Y: 59.018894}), aff.Transform(f32.Point{X: 142.395157, Y: 58.229443}), aff.Transform(f32.Point{X: 143.270203, Y: 57.269562}))
-	path.CubeTo(aff.Transform(f32.Point{X: 144.145248, Y: 56.309685}), aff.Transform(f32.Point{X: 144.834869, Y: 55.195896}), aff.Transform(f32.Point{X: 145.304077, Y: 53.984734}))
-	path.CubeTo(aff.Transform(f32.Point{X: 145.773285, Y: 52.773571}), aff.Transform(f32.Point{X: 146.014008, Y: 51.485874}), aff.Transform(f32.Point{X: 146.014008, Y: 50.187000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 223, G: 88, B: 76, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 115.459000, Y: 52.213001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 115.459000, Y: 50.699398}), aff.Transform(f32.Point{X: 115.178490, Y: 49.198822}), aff.Transform(f32.Point{X: 114.631714, Y: 47.787430}))
-	path.CubeTo(aff.Transform(f32.Point{X: 114.084938, Y: 46.376038}), aff.Transform(f32.Point{X: 113.281303, Y: 45.078121}), aff.Transform(f32.Point{X: 112.261597, Y: 43.959553}))
-	path.CubeTo(aff.Transform(f32.Point{X: 111.241890, Y: 42.840988}), aff.Transform(f32.Point{X: 110.023659, Y: 41.921024}), aff.Transform(f32.Point{X: 108.668739, Y: 41.246353}))
-	path.CubeTo(aff.Transform(f32.Point{X: 107.313820, Y: 40.571686}), aff.Transform(f32.Point{X: 105.845528, Y: 40.153919}), aff.Transform(f32.Point{X: 104.338379, Y: 40.014259}))
-	path.CubeTo(aff.Transform(f32.Point{X: 102.831238, Y: 39.874603}), aff.Transform(f32.Point{X: 101.311172, Y: 40.015457}), aff.Transform(f32.Point{X: 99.855354, Y: 40.429672}))
-	path.CubeTo(aff.Transform(f32.Point{X: 98.399536, Y: 40.843891}), aff.Transform(f32.Point{X: 97.033005, Y: 41.524342}), aff.Transform(f32.Point{X: 95.825127, Y: 42.436489}))
-	path.CubeTo(aff.Transform(f32.Point{X: 94.617241, Y: 43.348640}), aff.Transform(f32.Point{X: 93.588799, Y: 44.476788}), aff.Transform(f32.Point{X: 92.791992, Y: 45.763680}))
-	path.CubeTo(aff.Transform(f32.Point{X: 91.995178, Y: 47.050571}), aff.Transform(f32.Point{X: 91.443718, Y: 48.474056}), aff.Transform(f32.Point{X: 91.165596, Y: 49.961884}))
-	path.CubeTo(aff.Transform(f32.Point{X: 90.887474, Y: 51.449715}), aff.Transform(f32.Point{X: 90.887474, Y: 52.976284}), aff.Transform(f32.Point{X: 91.165596, Y: 54.464115}))
-	path.CubeTo(aff.Transform(f32.Point{X: 91.443718, Y: 55.951946}), aff.Transform(f32.Point{X: 91.995178, Y: 57.375431}), aff.Transform(f32.Point{X: 92.791992, Y: 58.662319}))
-	path.CubeTo(aff.Transform(f32.Point{X: 93.588799, Y: 59.949211}), aff.Transform(f32.Point{X: 94.617241, Y: 61.077358}), aff.Transform(f32.Point{X: 95.825127, Y: 61.989510}))
-	path.CubeTo(aff.Transform(f32.Point{X: 97.033005, Y: 62.901657}), aff.Transform(f32.Point{X: 98.399536, Y: 63.582108}), aff.Transform(f32.Point{X: 99.855354, Y: 63.996326}))
-	path.CubeTo(aff.Transform(f32.Point{X: 101.311172, Y: 64.410545}), aff.Transform(f32.Point{X: 102.831238, Y: 64.551399}), aff.Transform(f32.Point{X: 104.338379, Y: 64.411743}))
-	path.CubeTo(aff.Transform(f32.Point{X: 105.845528, Y: 64.272079}), aff.Transform(f32.Point{X: 107.313820, Y: 63.854317}), aff.Transform(f32.Point{X: 108.668739, Y: 63.179646}))
-	path.CubeTo(aff.Transform(f32.Point{X: 110.023659, Y: 62.504974}), aff.Transform(f32.Point{X: 111.241890, Y: 61.585011}), aff.Transform(f32.Point{X: 112.261597, Y: 60.466446}))
-	path.CubeTo(aff.Transform(f32.Point{X: 113.281303, Y: 59.347881}), aff.Transform(f32.Point{X: 114.084938, Y: 58.049965}), aff.Transform(f32.Point{X: 114.631714, Y: 56.638573}))
-	path.CubeTo(aff.Transform(f32.Point{X: 115.178490, Y: 55.227180}), aff.Transform(f32.Point{X: 115.459000, Y: 53.726601}), aff.Transform(f32.Point{X: 115.459000, Y: 52.213001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 185, G: 72, B: 62, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 112.228996, Y: 52.213001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 112.228996, Y: 51.098461}), aff.Transform(f32.Point{X: 112.022453, Y: 49.993515}), aff.Transform(f32.Point{X: 111.619835, Y: 48.954239}))
-	path.CubeTo(aff.Transform(f32.Point{X: 111.217216, Y: 47.914963}), aff.Transform(f32.Point{X: 110.625458, Y: 46.959244}), aff.Transform(f32.Point{X: 109.874596, Y: 46.135593}))
-	path.CubeTo(aff.Transform(f32.Point{X: 109.123741, Y: 45.311939}), aff.Transform(f32.Point{X: 108.226700, Y: 44.634525}), aff.Transform(f32.Point{X: 107.229004, Y: 44.137733}))
-	path.CubeTo(aff.Transform(f32.Point{X: 106.231316, Y: 43.640938}), aff.Transform(f32.Point{X: 105.150139, Y: 43.333317}), aff.Transform(f32.Point{X: 104.040352, Y: 43.230480}))
-	path.CubeTo(aff.Transform(f32.Point{X: 102.930573, Y: 43.127644}), aff.Transform(f32.Point{X: 101.811279, Y: 43.231361}), aff.Transform(f32.Point{X: 100.739288, Y: 43.536369}))
-	path.CubeTo(aff.Transform(f32.Point{X: 99.667297, Y: 43.841377}), aff.Transform(f32.Point{X: 98.661057, Y: 44.342426}), aff.Transform(f32.Point{X: 97.771629, Y: 45.014088}))
-	path.CubeTo(aff.Transform(f32.Point{X: 96.882210, Y: 45.685745}), aff.Transform(f32.Point{X: 96.124916, Y: 46.516457}), aff.Transform(f32.Point{X: 95.538193, Y: 47.464054}))
-	path.CubeTo(aff.Transform(f32.Point{X: 94.951462, Y: 48.411655}), aff.Transform(f32.Point{X: 94.545395, Y: 49.459835}), aff.Transform(f32.Point{X: 94.340599, Y: 50.555397}))
-	path.CubeTo(aff.Transform(f32.Point{X: 94.135803, Y: 51.650955}), aff.Transform(f32.Point{X: 94.135803, Y: 52.775043}), aff.Transform(f32.Point{X: 94.340599, Y: 53.870605}))
-	path.CubeTo(aff.Transform(f32.Point{X: 94.545395, Y: 54.966164}), aff.Transform(f32.Point{X: 94.951462, Y: 56.014347}), aff.Transform(f32.Point{X: 95.538193, Y: 56.961945}))
-	path.CubeTo(aff.Transform(f32.Point{X: 96.124916, Y: 57.909542}), aff.Transform(f32.Point{X: 96.882210, Y: 58.740253}), aff.Transform(f32.Point{X: 97.771629, Y: 59.411915}))
-	path.CubeTo(aff.Transform(f32.Point{X: 98.661057, Y: 60.083572}), aff.Transform(f32.Point{X: 99.667297, Y: 60.584621}), aff.Transform(f32.Point{X: 100.739288, Y: 60.889629}))
-	path.CubeTo(aff.Transform(f32.Point{X: 101.811279, Y: 61.194637}), aff.Transform(f32.Point{X: 102.930573, Y: 61.298355}), aff.Transform(f32.Point{X: 104.040352, Y: 61.195518}))
-	path.CubeTo(aff.Transform(f32.Point{X: 105.150139, Y: 61.092682}), aff.Transform(f32.Point{X: 106.231316, Y: 60.785061}), aff.Transform(f32.Point{X: 107.229004, Y: 60.288269}))
-	path.CubeTo(aff.Transform(f32.Point{X: 108.226700, Y: 59.791477}), aff.Transform(f32.Point{X: 109.123741, Y: 59.114063}), aff.Transform(f32.Point{X: 109.874596, Y: 58.290409}))
-	path.CubeTo(aff.Transform(f32.Point{X: 110.625458, Y: 57.466755}), aff.Transform(f32.Point{X: 111.217216, Y: 56.511036}), aff.Transform(f32.Point{X: 111.619835, Y: 55.471760}))
-	path.CubeTo(aff.Transform(f32.Point{X: 112.022453, Y: 54.432484}), aff.Transform(f32.Point{X: 112.228996, Y: 53.327538}), aff.Transform(f32.Point{X: 112.228996, Y: 52.213001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 223, G: 88, B: 76, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 441.635010, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 350.144989, Y: 73.047997}))
-	path.LineTo(aff.Transform(f32.Point{X: 349.171997, Y: 78.445999}))
-	path.LineTo(aff.Transform(f32.Point{X: 438.864990, Y: 78.445999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 165.153000, Y: 22.802999}))
-	path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 22.802999}))
-	path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 64.328003}))
-	path.LineTo(aff.Transform(f32.Point{X: 165.153000, Y: 64.328003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 168.552994, Y: 52.187000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 169.699005, Y: 52.825001}), aff.Transform(f32.Point{X: 171.653000, Y: 53.292000}), aff.Transform(f32.Point{X: 173.266006, Y: 53.292000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 175.899002, Y: 53.292000}), aff.Transform(f32.Point{X: 177.173996, Y: 51.931999}), aff.Transform(f32.Point{X: 177.173996, Y: 50.063000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 177.173996, Y: 47.981998}), aff.Transform(f32.Point{X: 175.899994, Y: 46.963001}), aff.Transform(f32.Point{X: 173.479004, Y: 45.390999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 169.572006, Y: 43.013000}), aff.Transform(f32.Point{X: 168.085007, Y: 39.995998}), aff.Transform(f32.Point{X: 168.085007, Y: 37.408001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 168.085007, Y: 32.819000}), aff.Transform(f32.Point{X: 171.143997, Y: 28.997999}), aff.Transform(f32.Point{X: 177.130997, Y: 28.997999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 179.000000, Y: 28.997999}), aff.Transform(f32.Point{X: 180.783997, Y: 29.509001}), aff.Transform(f32.Point{X: 181.634003, Y: 30.017000}))
-	path.LineTo(aff.Transform(f32.Point{X: 180.740997, Y: 34.817001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 179.934006, Y: 34.306999}), aff.Transform(f32.Point{X: 178.701996, Y: 33.840000}), aff.Transform(f32.Point{X: 177.089005, Y: 33.840000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 174.710007, Y: 33.840000}), aff.Transform(f32.Point{X: 173.563995, Y: 35.283001}), aff.Transform(f32.Point{X: 173.563995, Y: 36.813000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 173.563995, Y: 38.511002}), aff.Transform(f32.Point{X: 174.412994, Y: 39.403999}), aff.Transform(f32.Point{X: 177.514008, Y: 41.271999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 181.292999, Y: 43.566002}), aff.Transform(f32.Point{X: 182.695999, Y: 46.453999}), aff.Transform(f32.Point{X: 182.695999, Y: 49.469002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 182.695999, Y: 54.693001}), aff.Transform(f32.Point{X: 178.830994, Y: 58.132999}), aff.Transform(f32.Point{X: 173.225006, Y: 58.132999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 170.932007, Y: 58.132999}), aff.Transform(f32.Point{X: 168.681000, Y: 57.537998}), aff.Transform(f32.Point{X: 167.746002, Y: 56.987000}))
-	path.LineTo(aff.Transform(f32.Point{X: 168.552994, Y: 52.187000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 199.645996, Y: 57.452999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 198.839005, Y: 57.835999}), aff.Transform(f32.Point{X: 197.268005, Y: 58.132999}), aff.Transform(f32.Point{X: 195.313995, Y: 58.132999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 188.009003, Y: 58.132999}), aff.Transform(f32.Point{X: 184.526001, Y: 52.102001}), aff.Transform(f32.Point{X: 184.526001, Y: 43.990002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 184.526001, Y: 33.203999}), aff.Transform(f32.Point{X: 190.514999, Y: 28.997999}), aff.Transform(f32.Point{X: 196.076996, Y: 28.997999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 198.031998, Y: 28.997999}), aff.Transform(f32.Point{X: 199.389999, Y: 29.379000}), aff.Transform(f32.Point{X: 199.942993, Y: 29.761999}))
-	path.LineTo(aff.Transform(f32.Point{X: 199.009003, Y: 34.433998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 198.371994, Y: 34.134998}), aff.Transform(f32.Point{X: 197.649994, Y: 33.882000}), aff.Transform(f32.Point{X: 196.417007, Y: 33.882000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 193.274994, Y: 33.882000}), aff.Transform(f32.Point{X: 190.429993, Y: 36.601002}), aff.Transform(f32.Point{X: 190.429993, Y: 43.693001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 190.429993, Y: 50.530998}), aff.Transform(f32.Point{X: 193.020004, Y: 53.208000}), aff.Transform(f32.Point{X: 196.417007, Y: 53.208000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 197.352997, Y: 53.208000}), aff.Transform(f32.Point{X: 198.414001, Y: 52.997002}), aff.Transform(f32.Point{X: 199.093994, Y: 52.783001}))
-	path.LineTo(aff.Transform(f32.Point{X: 199.645996, Y: 57.452999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 208.270996, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 40.721001}))
-	path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 40.721001}))
-	path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 219.653000, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 219.653000, Y: 57.877998}))
-	path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 57.877998}))
-	path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 45.901001}))
-	path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 45.901001}))
-	path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 57.877998}))
-	path.LineTo(aff.Transform(f32.Point{X: 202.707001, Y: 57.877998}))
-	path.LineTo(aff.Transform(f32.Point{X: 202.707001, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 29.252001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 241.488998, Y: 43.056000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 241.488998, Y: 53.842999}), aff.Transform(f32.Point{X: 237.667007, Y: 58.219002}), aff.Transform(f32.Point{X: 232.229996, Y: 58.219002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 225.731003, Y: 58.219002}), aff.Transform(f32.Point{X: 223.182999, Y: 51.338001}), aff.Transform(f32.Point{X: 223.182999, Y: 43.481998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 223.182999, Y: 35.667000}), aff.Transform(f32.Point{X: 226.283997, Y: 28.913000}), aff.Transform(f32.Point{X: 232.610992, Y: 28.913000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 239.533005, Y: 28.912001}), aff.Transform(f32.Point{X: 241.488998, Y: 36.515999}), aff.Transform(f32.Point{X: 241.488998, Y: 43.056000}))
-	path.Close()
-	path.MoveTo(aff.Transform(f32.Point{X: 228.957993, Y: 43.564999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 228.957993, Y: 50.063000}), aff.Transform(f32.Point{X: 230.188995, Y: 53.375999}), aff.Transform(f32.Point{X: 232.440002, Y: 53.375999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 234.776001, Y: 53.375999}), aff.Transform(f32.Point{X: 235.667999, Y: 49.129002}), aff.Transform(f32.Point{X: 235.667999, Y: 43.311001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 235.667999, Y: 38.299000}), aff.Transform(f32.Point{X: 234.904007, Y: 33.755001}), aff.Transform(f32.Point{X: 232.397003, Y: 33.755001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 230.190994, Y: 33.755001}), aff.Transform(f32.Point{X: 228.957993, Y: 37.408001}), aff.Transform(f32.Point{X: 228.957993, Y: 43.564999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 261.920990, Y: 43.056000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 261.920990, Y: 53.842999}), aff.Transform(f32.Point{X: 258.097992, Y: 58.219002}), aff.Transform(f32.Point{X: 252.662994, Y: 58.219002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 246.164001, Y: 58.219002}), aff.Transform(f32.Point{X: 243.615997, Y: 51.338001}), aff.Transform(f32.Point{X: 243.615997, Y: 43.481998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 243.615997, Y: 35.667000}), aff.Transform(f32.Point{X: 246.716995, Y: 28.913000}), aff.Transform(f32.Point{X: 253.044006, Y: 28.913000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 259.967010, Y: 28.912001}), aff.Transform(f32.Point{X: 261.920990, Y: 36.515999}), aff.Transform(f32.Point{X: 261.920990, Y: 43.056000}))
-	path.Close()
-	path.MoveTo(aff.Transform(f32.Point{X: 249.391006, Y: 43.564999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 249.391006, Y: 50.063000}), aff.Transform(f32.Point{X: 250.621994, Y: 53.375999}), aff.Transform(f32.Point{X: 252.873993, Y: 53.375999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 255.210007, Y: 53.375999}), aff.Transform(f32.Point{X: 256.101990, Y: 49.129002}), aff.Transform(f32.Point{X: 256.101990, Y: 43.311001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 256.101990, Y: 38.299000}), aff.Transform(f32.Point{X: 255.337997, Y: 33.755001}), aff.Transform(f32.Point{X: 252.830994, Y: 33.755001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 250.621994, Y: 33.755001}), aff.Transform(f32.Point{X: 249.391006, Y: 37.408001}), aff.Transform(f32.Point{X: 249.391006, Y: 43.564999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 265.447998, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 271.013000, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 271.013000, Y: 53.162998}))
-	path.LineTo(aff.Transform(f32.Point{X: 278.359985, Y: 53.162998}))
-	path.LineTo(aff.Transform(f32.Point{X: 278.359985, Y: 57.877998}))
-	path.LineTo(aff.Transform(f32.Point{X: 265.447998, Y: 57.877998}))
-	path.LineTo(aff.Transform(f32.Point{X: 265.447998, Y: 29.252001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 288.345001, Y: 29.677999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 290.042999, Y: 29.295000}), aff.Transform(f32.Point{X: 292.252014, Y: 29.124001}), aff.Transform(f32.Point{X: 294.671997, Y: 29.124001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 297.348999, Y: 29.124001}), aff.Transform(f32.Point{X: 299.938995, Y: 29.462999}), aff.Transform(f32.Point{X: 301.936005, Y: 31.163000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 303.466003, Y: 32.438000}), aff.Transform(f32.Point{X: 304.101990, Y: 34.348999}), aff.Transform(f32.Point{X: 304.101990, Y: 36.346001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 304.101990, Y: 38.894001}), aff.Transform(f32.Point{X: 302.786987, Y: 41.356998}), aff.Transform(f32.Point{X: 299.940002, Y: 42.589001}))
-	path.LineTo(aff.Transform(f32.Point{X: 299.940002, Y: 42.757999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 303.252014, Y: 43.693001}), aff.Transform(f32.Point{X: 305.036011, Y: 46.452999}), aff.Transform(f32.Point{X: 305.036011, Y: 49.597000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 305.036011, Y: 51.974998}), aff.Transform(f32.Point{X: 304.272003, Y: 53.800999}), aff.Transform(f32.Point{X: 302.912994, Y: 55.202000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 301.214996, Y: 57.070000}), aff.Transform(f32.Point{X: 298.325989, Y: 58.091000}), aff.Transform(f32.Point{X: 293.526001, Y: 58.091000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 291.403015, Y: 58.091000}), aff.Transform(f32.Point{X: 289.619995, Y: 57.963001}), aff.Transform(f32.Point{X: 288.345001, Y: 57.793999}))
-	path.LineTo(aff.Transform(f32.Point{X: 288.345001, Y: 29.677999}))
-	path.LineTo(aff.Transform(f32.Point{X: 288.345001, Y: 29.677999}))
-	path.Close()
-	path.MoveTo(aff.Transform(f32.Point{X: 293.911011, Y: 41.018002}))
-	path.LineTo(aff.Transform(f32.Point{X: 295.097992, Y: 41.018002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 297.095001, Y: 41.018002}), aff.Transform(f32.Point{X: 298.622986, Y: 39.320000}), aff.Transform(f32.Point{X: 298.622986, Y: 36.984001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 298.622986, Y: 34.901001}), aff.Transform(f32.Point{X: 297.645996, Y: 33.289001}), aff.Transform(f32.Point{X: 295.351990, Y: 33.289001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 294.757996, Y: 33.289001}), aff.Transform(f32.Point{X: 294.247986, Y: 33.331001}), aff.Transform(f32.Point{X: 293.911011, Y: 33.458000}))
-	path.LineTo(aff.Transform(f32.Point{X: 293.911011, Y: 41.018002}))
-	path.Close()
-	path.MoveTo(aff.Transform(f32.Point{X: 293.911011, Y: 53.716999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 294.247986, Y: 53.800999}), aff.Transform(f32.Point{X: 294.631012, Y: 53.800999}), aff.Transform(f32.Point{X: 295.140991, Y: 53.800999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 297.390991, Y: 53.800999}), aff.Transform(f32.Point{X: 299.260986, Y: 52.442001}), aff.Transform(f32.Point{X: 299.260986, Y: 49.512001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 299.260986, Y: 46.665001}), aff.Transform(f32.Point{X: 297.307007, Y: 45.178001}), aff.Transform(f32.Point{X: 295.096985, Y: 45.137001}))
-	path.LineTo(aff.Transform(f32.Point{X: 293.910004, Y: 45.137001}))
-	path.LineTo(aff.Transform(f32.Point{X: 293.911011, Y: 53.716999}))
-	path.LineTo(aff.Transform(f32.Point{X: 293.911011, Y: 53.716999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 314.256989, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 314.256989, Y: 47.855000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 314.256989, Y: 52.061001}), aff.Transform(f32.Point{X: 315.529999, Y: 53.334000}), aff.Transform(f32.Point{X: 316.973999, Y: 53.334000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 318.588013, Y: 53.334000}), aff.Transform(f32.Point{X: 319.734985, Y: 52.187000}), aff.Transform(f32.Point{X: 319.734985, Y: 47.855000}))
-	path.LineTo(aff.Transform(f32.Point{X: 319.734985, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 325.299988, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 325.299988, Y: 46.877998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 325.299988, Y: 54.226002}), aff.Transform(f32.Point{X: 322.536987, Y: 58.219002}), aff.Transform(f32.Point{X: 317.015991, Y: 58.219002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 311.028015, Y: 58.219002}), aff.Transform(f32.Point{X: 308.691986, Y: 54.098999}), aff.Transform(f32.Point{X: 308.691986, Y: 46.919998}))
-	path.LineTo(aff.Transform(f32.Point{X: 308.691986, Y: 29.252001}))
-	path.LineTo(aff.Transform(f32.Point{X: 314.256989, Y: 29.252001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 329.467010, Y: 52.187000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 330.613007, Y: 52.825001}), aff.Transform(f32.Point{X: 332.566986, Y: 53.292000}), aff.Transform(f32.Point{X: 334.182007, Y: 53.292000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 336.815002, Y: 53.292000}), aff.Transform(f32.Point{X: 338.088013, Y: 51.931999}), aff.Transform(f32.Point{X: 338.088013, Y: 50.063000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 338.088013, Y: 47.981998}), aff.Transform(f32.Point{X: 336.815002, Y: 46.963001}), aff.Transform(f32.Point{X: 334.393005, Y: 45.390999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 330.484985, Y: 43.013000}), aff.Transform(f32.Point{X: 328.997986, Y: 39.995998}), aff.Transform(f32.Point{X: 328.997986, Y: 37.408001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 328.997986, Y: 32.819000}), aff.Transform(f32.Point{X: 332.057007, Y: 28.997999}), aff.Transform(f32.Point{X: 338.045013, Y: 28.997999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 339.914001, Y: 28.997999}), aff.Transform(f32.Point{X: 341.697998, Y: 29.509001}), aff.Transform(f32.Point{X: 342.548004, Y: 30.017000}))
-	path.LineTo(aff.Transform(f32.Point{X: 341.656006, Y: 34.817001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 340.848999, Y: 34.306999}), aff.Transform(f32.Point{X: 339.617004, Y: 33.840000}), aff.Transform(f32.Point{X: 338.002991, Y: 33.840000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 335.625000, Y: 33.840000}), aff.Transform(f32.Point{X: 334.479004, Y: 35.283001}), aff.Transform(f32.Point{X: 334.479004, Y: 36.813000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 334.479004, Y: 38.511002}), aff.Transform(f32.Point{X: 335.329010, Y: 39.403999}), aff.Transform(f32.Point{X: 338.428009, Y: 41.271999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 342.207001, Y: 43.566002}), aff.Transform(f32.Point{X: 343.609985, Y: 46.453999}), aff.Transform(f32.Point{X: 343.609985, Y: 49.469002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 343.609985, Y: 54.693001}), aff.Transform(f32.Point{X: 339.744995, Y: 58.132999}), aff.Transform(f32.Point{X: 334.138000, Y: 58.132999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 331.843994, Y: 58.132999}), aff.Transform(f32.Point{X: 329.592987, Y: 57.537998}), aff.Transform(f32.Point{X: 328.660004, Y: 56.987000}))
-	path.LineTo(aff.Transform(f32.Point{X: 329.467010, Y: 52.187000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 165.153000, Y: 62.251999}))
-	path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 62.251999}))
-	path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 64.328003}))
-	path.LineTo(aff.Transform(f32.Point{X: 165.153000, Y: 64.328003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 165.153000, Y: 22.802999}))
-	path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 22.802999}))
-	path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 24.879000}))
-	path.LineTo(aff.Transform(f32.Point{X: 165.153000, Y: 24.879000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 264.181000, Y: 10.265000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 264.181000, Y: 12.698000}), aff.Transform(f32.Point{X: 262.209015, Y: 14.670000}), aff.Transform(f32.Point{X: 259.774994, Y: 14.670000}))
-	path.LineTo(aff.Transform(f32.Point{X: 251.578995, Y: 14.670000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 249.145996, Y: 14.670000}), aff.Transform(f32.Point{X: 247.173996, Y: 12.697000}), aff.Transform(f32.Point{X: 247.173996, Y: 10.265000}))
-	path.LineTo(aff.Transform(f32.Point{X: 247.173996, Y: 10.265000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 247.173996, Y: 7.831000}), aff.Transform(f32.Point{X: 249.147003, Y: 5.860000}), aff.Transform(f32.Point{X: 251.578995, Y: 5.860000}))
-	path.LineTo(aff.Transform(f32.Point{X: 259.774994, Y: 5.860000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 262.208008, Y: 5.859000}), aff.Transform(f32.Point{X: 264.181000, Y: 7.831000}), aff.Transform(f32.Point{X: 264.181000, Y: 10.265000}))
-	path.LineTo(aff.Transform(f32.Point{X: 264.181000, Y: 10.265000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 404.574005, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 255.677002, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 106.780998, Y: 198.867996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 106.780998, Y: 198.867996}), aff.Transform(f32.Point{X: 76.663002, Y: 202.841995}), aff.Transform(f32.Point{X: 92.417000, Y: 224.154999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 108.169998, Y: 245.468994}), aff.Transform(f32.Point{X: 124.850998, Y: 267.709015}), aff.Transform(f32.Point{X: 148.018005, Y: 267.709015}))
-	path.CubeTo(aff.Transform(f32.Point{X: 171.184998, Y: 267.709015}), aff.Transform(f32.Point{X: 255.679001, Y: 267.709015}), aff.Transform(f32.Point{X: 255.679001, Y: 267.709015}))
-	path.CubeTo(aff.Transform(f32.Point{X: 255.679001, Y: 267.709015}), aff.Transform(f32.Point{X: 340.171997, Y: 267.709015}), aff.Transform(f32.Point{X: 363.338013, Y: 267.709015}))
-	path.CubeTo(aff.Transform(f32.Point{X: 386.506012, Y: 267.709015}), aff.Transform(f32.Point{X: 403.186005, Y: 245.470001}), aff.Transform(f32.Point{X: 418.940002, Y: 224.154999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 434.691010, Y: 202.841995}), aff.Transform(f32.Point{X: 404.574005, Y: 198.867996}), aff.Transform(f32.Point{X: 404.574005, Y: 198.867996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 365.951996, Y: 359.601013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 365.951996, Y: 366.938995}), aff.Transform(f32.Point{X: 360.002991, Y: 372.888000}), aff.Transform(f32.Point{X: 352.664001, Y: 372.888000}))
-	path.LineTo(aff.Transform(f32.Point{X: 158.688995, Y: 372.888000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 151.350998, Y: 372.888000}), aff.Transform(f32.Point{X: 145.401001, Y: 366.938995}), aff.Transform(f32.Point{X: 145.401001, Y: 359.601013}))
-	path.LineTo(aff.Transform(f32.Point{X: 145.401001, Y: 286.093994}))
-	path.CubeTo(aff.Transform(f32.Point{X: 145.401001, Y: 278.756012}), aff.Transform(f32.Point{X: 151.350998, Y: 272.806000}), aff.Transform(f32.Point{X: 158.688995, Y: 272.806000}))
-	path.LineTo(aff.Transform(f32.Point{X: 352.664001, Y: 272.806000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 360.002991, Y: 272.806000}), aff.Transform(f32.Point{X: 365.951996, Y: 278.756012}), aff.Transform(f32.Point{X: 365.951996, Y: 286.093994}))
-	path.LineTo(aff.Transform(f32.Point{X: 365.951996, Y: 359.601013}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 289.131989}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 289.131989}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 295.398010}))
-	path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 295.398010}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 304.423004}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 304.423004}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 310.688995}))
-	path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 310.688995}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 319.713989}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 319.713989}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 325.980011}))
-	path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 325.980011}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 335.005005}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 335.005005}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 341.272003}))
-	path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 341.272003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 350.295990}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 350.295990}))
-	path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 356.562988}))
-	path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 356.562988}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 289.131989}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 289.131989}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 295.398010}))
-	path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 295.398010}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 304.423004}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 304.423004}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 310.688995}))
-	path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 310.688995}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 319.713989}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 319.713989}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 325.980011}))
-	path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 325.980011}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 335.005005}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 335.005005}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 341.272003}))
-	path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 341.272003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 350.295990}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 350.295990}))
-	path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 356.562988}))
-	path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 356.562988}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 289.131989}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 289.131989}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 295.398010}))
-	path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 295.398010}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 304.423004}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 304.423004}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 310.688995}))
-	path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 310.688995}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 319.713989}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 319.713989}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 325.980011}))
-	path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 325.980011}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 335.005005}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 335.005005}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 341.272003}))
-	path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 341.272003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 350.295990}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 350.295990}))
-	path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 356.562988}))
-	path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 356.562988}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 242, G: 182, B: 67, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 81.759003, Y: 226.472000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 81.759003, Y: 226.472000}), aff.Transform(f32.Point{X: 89.635002, Y: 240.835007}), aff.Transform(f32.Point{X: 102.146004, Y: 251.029999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 114.656998, Y: 261.222992}), aff.Transform(f32.Point{X: 128.093002, Y: 269.097992}), aff.Transform(f32.Point{X: 128.093002, Y: 288.559998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 128.093002, Y: 308.020996}), aff.Transform(f32.Point{X: 128.093002, Y: 372.888000}), aff.Transform(f32.Point{X: 128.093002, Y: 372.888000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 128.093002, Y: 372.888000}), aff.Transform(f32.Point{X: 125.313004, Y: 344.623993}), aff.Transform(f32.Point{X: 116.045998, Y: 320.531006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 106.778999, Y: 296.436005}), aff.Transform(f32.Point{X: 98.440002, Y: 289.947998}), aff.Transform(f32.Point{X: 81.759003, Y: 284.389008}))
-	path.LineTo(aff.Transform(f32.Point{X: 81.759003, Y: 226.472000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}), aff.Transform(f32.Point{X: 421.714996, Y: 240.835007}), aff.Transform(f32.Point{X: 409.204010, Y: 251.029999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 396.691986, Y: 261.222992}), aff.Transform(f32.Point{X: 383.256989, Y: 269.097992}), aff.Transform(f32.Point{X: 383.256989, Y: 288.559998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 383.256989, Y: 308.020996}), aff.Transform(f32.Point{X: 383.256989, Y: 372.888000}), aff.Transform(f32.Point{X: 383.256989, Y: 372.888000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 383.256989, Y: 372.888000}), aff.Transform(f32.Point{X: 386.037994, Y: 344.623993}), aff.Transform(f32.Point{X: 395.304993, Y: 320.531006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 404.571991, Y: 296.436005}), aff.Transform(f32.Point{X: 412.910004, Y: 289.947998}), aff.Transform(f32.Point{X: 429.592010, Y: 284.389008}))
-	path.LineTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}))
-	path.LineTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 84.077003, Y: 218.595001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 84.077003, Y: 216.591400}), aff.Transform(f32.Point{X: 83.705688, Y: 214.605042}), aff.Transform(f32.Point{X: 82.981903, Y: 212.736740}))
-	path.CubeTo(aff.Transform(f32.Point{X: 82.258118, Y: 210.868439}), aff.Transform(f32.Point{X: 81.194321, Y: 209.150360}), aff.Transform(f32.Point{X: 79.844505, Y: 207.669678}))
-	path.CubeTo(aff.Transform(f32.Point{X: 78.494690, Y: 206.188995}), aff.Transform(f32.Point{X: 76.882088, Y: 204.971222}), aff.Transform(f32.Point{X: 75.088539, Y: 204.078140}))
-	path.CubeTo(aff.Transform(f32.Point{X: 73.294991, Y: 203.185059}), aff.Transform(f32.Point{X: 71.351364, Y: 202.632050}), aff.Transform(f32.Point{X: 69.356316, Y: 202.447174}))
-	path.CubeTo(aff.Transform(f32.Point{X: 67.361267, Y: 202.262314}), aff.Transform(f32.Point{X: 65.349121, Y: 202.448761}), aff.Transform(f32.Point{X: 63.422009, Y: 202.997070}))
-	path.CubeTo(aff.Transform(f32.Point{X: 61.494896, Y: 203.545380}), aff.Transform(f32.Point{X: 59.685982, Y: 204.446121}), aff.Transform(f32.Point{X: 58.087074, Y: 205.653549}))
-	path.CubeTo(aff.Transform(f32.Point{X: 56.488167, Y: 206.860992}), aff.Transform(f32.Point{X: 55.126789, Y: 208.354355}), aff.Transform(f32.Point{X: 54.072029, Y: 210.057846}))
-	path.CubeTo(aff.Transform(f32.Point{X: 53.017269, Y: 211.761337}), aff.Transform(f32.Point{X: 52.287285, Y: 213.645645}), aff.Transform(f32.Point{X: 51.919125, Y: 215.615128}))
-	path.CubeTo(aff.Transform(f32.Point{X: 51.550964, Y: 217.584610}), aff.Transform(f32.Point{X: 51.550964, Y: 219.605377}), aff.Transform(f32.Point{X: 51.919125, Y: 221.574860}))
-	path.CubeTo(aff.Transform(f32.Point{X: 52.287285, Y: 223.544342}), aff.Transform(f32.Point{X: 53.017269, Y: 225.428650}), aff.Transform(f32.Point{X: 54.072029, Y: 227.132156}))
-	path.CubeTo(aff.Transform(f32.Point{X: 55.126789, Y: 228.835648}), aff.Transform(f32.Point{X: 56.488167, Y: 230.329010}), aff.Transform(f32.Point{X: 58.087074, Y: 231.536438}))
-	path.CubeTo(aff.Transform(f32.Point{X: 59.685982, Y: 232.743881}), aff.Transform(f32.Point{X: 61.494896, Y: 233.644623}), aff.Transform(f32.Point{X: 63.422009, Y: 234.192932}))
-	path.CubeTo(aff.Transform(f32.Point{X: 65.349121, Y: 234.741241}), aff.Transform(f32.Point{X: 67.361267, Y: 234.927689}), aff.Transform(f32.Point{X: 69.356316, Y: 234.742828}))
-	path.CubeTo(aff.Transform(f32.Point{X: 71.351364, Y: 234.557953}), aff.Transform(f32.Point{X: 73.294991, Y: 234.004944}), aff.Transform(f32.Point{X: 75.088539, Y: 233.111862}))
-	path.CubeTo(aff.Transform(f32.Point{X: 76.882088, Y: 232.218781}), aff.Transform(f32.Point{X: 78.494690, Y: 231.000992}), aff.Transform(f32.Point{X: 79.844505, Y: 229.520325}))
-	path.CubeTo(aff.Transform(f32.Point{X: 81.194321, Y: 228.039642}), aff.Transform(f32.Point{X: 82.258118, Y: 226.321548}), aff.Transform(f32.Point{X: 82.981903, Y: 224.453262}))
-	path.CubeTo(aff.Transform(f32.Point{X: 83.705688, Y: 222.584961}), aff.Transform(f32.Point{X: 84.077003, Y: 220.598602}), aff.Transform(f32.Point{X: 84.077003, Y: 218.595001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 235, G: 137, B: 35, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 67.860001, Y: 234.811996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 76.816002, Y: 234.811996}), aff.Transform(f32.Point{X: 84.077003, Y: 227.550995}), aff.Transform(f32.Point{X: 84.077003, Y: 218.593994}))
-	path.CubeTo(aff.Transform(f32.Point{X: 84.077003, Y: 209.636993}), aff.Transform(f32.Point{X: 76.816002, Y: 202.376999}), aff.Transform(f32.Point{X: 67.860001, Y: 202.376999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 61.584999, Y: 202.376999}), aff.Transform(f32.Point{X: 56.141998, Y: 205.942001}), aff.Transform(f32.Point{X: 53.445000, Y: 211.154999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 53.445000, Y: 211.154999}), aff.Transform(f32.Point{X: 66.068001, Y: 198.617004}), aff.Transform(f32.Point{X: 76.639999, Y: 212.709000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 87.853996, Y: 227.658997}), aff.Transform(f32.Point{X: 67.860001, Y: 234.811996}), aff.Transform(f32.Point{X: 67.860001, Y: 234.811996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 210, G: 114, B: 40, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 459.708008, Y: 218.595001}))
-	path.CubeTo(aff.Transform(f32.Point{X: 459.708008, Y: 216.591400}), aff.Transform(f32.Point{X: 459.336700, Y: 214.605042}), aff.Transform(f32.Point{X: 458.612915, Y: 212.736740}))
-	path.CubeTo(aff.Transform(f32.Point{X: 457.889130, Y: 210.868439}), aff.Transform(f32.Point{X: 456.825317, Y: 209.150360}), aff.Transform(f32.Point{X: 455.475494, Y: 207.669678}))
-	path.CubeTo(aff.Transform(f32.Point{X: 454.125702, Y: 206.188995}), aff.Transform(f32.Point{X: 452.513092, Y: 204.971222}), aff.Transform(f32.Point{X: 450.719543, Y: 204.078140}))
-	path.CubeTo(aff.Transform(f32.Point{X: 448.925995, Y: 203.185059}), aff.Transform(f32.Point{X: 446.982361, Y: 202.632050}), aff.Transform(f32.Point{X: 444.987305, Y: 202.447174}))
-	path.CubeTo(aff.Transform(f32.Point{X: 442.992279, Y: 202.262314}), aff.Transform(f32.Point{X: 440.980133, Y: 202.448761}), aff.Transform(f32.Point{X: 439.053009, Y: 202.997070}))
-	path.CubeTo(aff.Transform(f32.Point{X: 437.125885, Y: 203.545380}), aff.Transform(f32.Point{X: 435.316986, Y: 204.446121}), aff.Transform(f32.Point{X: 433.718079, Y: 205.653549}))
-	path.CubeTo(aff.Transform(f32.Point{X: 432.119171, Y: 206.860992}), aff.Transform(f32.Point{X: 430.757782, Y: 208.354355}), aff.Transform(f32.Point{X: 429.703033, Y: 210.057846}))
-	path.CubeTo(aff.Transform(f32.Point{X: 428.648285, Y: 211.761337}), aff.Transform(f32.Point{X: 427.918274, Y: 213.645645}), aff.Transform(f32.Point{X: 427.550140, Y: 215.615128}))
-	path.CubeTo(aff.Transform(f32.Point{X: 427.181976, Y: 217.584610}), aff.Transform(f32.Point{X: 427.181976, Y: 219.605377}), aff.Transform(f32.Point{X: 427.550140, Y: 221.574860}))
-	path.CubeTo(aff.Transform(f32.Point{X: 427.918274, Y: 223.544342}), aff.Transform(f32.Point{X: 428.648285, Y: 225.428650}), aff.Transform(f32.Point{X: 429.703033, Y: 227.132156}))
-	path.CubeTo(aff.Transform(f32.Point{X: 430.757782, Y: 228.835648}), aff.Transform(f32.Point{X: 432.119171, Y: 230.329010}), aff.Transform(f32.Point{X: 433.718079, Y: 231.536438}))
-	path.CubeTo(aff.Transform(f32.Point{X: 435.316986, Y: 232.743881}), aff.Transform(f32.Point{X: 437.125885, Y: 233.644623}), aff.Transform(f32.Point{X: 439.053009, Y: 234.192932}))
-	path.CubeTo(aff.Transform(f32.Point{X: 440.980133, Y: 234.741241}), aff.Transform(f32.Point{X: 442.992279, Y: 234.927689}), aff.Transform(f32.Point{X: 444.987305, Y: 234.742828}))
-	path.CubeTo(aff.Transform(f32.Point{X: 446.982361, Y: 234.557953}), aff.Transform(f32.Point{X: 448.925995, Y: 234.004944}), aff.Transform(f32.Point{X: 450.719543, Y: 233.111862}))
-	path.CubeTo(aff.Transform(f32.Point{X: 452.513092, Y: 232.218781}), aff.Transform(f32.Point{X: 454.125702, Y: 231.000992}), aff.Transform(f32.Point{X: 455.475494, Y: 229.520325}))
-	path.CubeTo(aff.Transform(f32.Point{X: 456.825317, Y: 228.039642}), aff.Transform(f32.Point{X: 457.889130, Y: 226.321548}), aff.Transform(f32.Point{X: 458.612915, Y: 224.453262}))
-	path.CubeTo(aff.Transform(f32.Point{X: 459.336700, Y: 222.584961}), aff.Transform(f32.Point{X: 459.708008, Y: 220.598602}), aff.Transform(f32.Point{X: 459.708008, Y: 218.595001}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 235, G: 137, B: 35, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 443.493011, Y: 234.811996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 434.536011, Y: 234.811996}), aff.Transform(f32.Point{X: 427.274994, Y: 227.550995}), aff.Transform(f32.Point{X: 427.274994, Y: 218.593994}))
-	path.CubeTo(aff.Transform(f32.Point{X: 427.274994, Y: 209.636993}), aff.Transform(f32.Point{X: 434.536011, Y: 202.376999}), aff.Transform(f32.Point{X: 443.493011, Y: 202.376999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 449.768005, Y: 202.376999}), aff.Transform(f32.Point{X: 455.209991, Y: 205.942001}), aff.Transform(f32.Point{X: 457.907990, Y: 211.154999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 457.907990, Y: 211.154999}), aff.Transform(f32.Point{X: 445.285004, Y: 198.617004}), aff.Transform(f32.Point{X: 434.713013, Y: 212.709000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 423.496002, Y: 227.658997}), aff.Transform(f32.Point{X: 443.493011, Y: 234.811996}), aff.Transform(f32.Point{X: 443.493011, Y: 234.811996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 210, G: 114, B: 40, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 38.589001, Y: 372.894012}))
-	path.CubeTo(aff.Transform(f32.Point{X: 41.452999, Y: 380.217987}), aff.Transform(f32.Point{X: 46.216999, Y: 378.800995}), aff.Transform(f32.Point{X: 46.216999, Y: 378.800995}))
-	path.LineTo(aff.Transform(f32.Point{X: 255.677002, Y: 383.890991}))
-	path.LineTo(aff.Transform(f32.Point{X: 465.136993, Y: 378.800995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 465.136993, Y: 378.800995}), aff.Transform(f32.Point{X: 469.903015, Y: 380.217010}), aff.Transform(f32.Point{X: 472.765991, Y: 372.891998}))
-	path.LineTo(aff.Transform(f32.Point{X: 38.589001, Y: 372.894012}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 256.001007, Y: 437.257996}))
-	path.LineTo(aff.Transform(f32.Point{X: 120.427002, Y: 437.257996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 142.574005, Y: 459.404999}), aff.Transform(f32.Point{X: 163.613007, Y: 461.065002}), aff.Transform(f32.Point{X: 176.899994, Y: 461.065002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 190.188004, Y: 461.065002}), aff.Transform(f32.Point{X: 256.002014, Y: 461.065002}), aff.Transform(f32.Point{X: 256.002014, Y: 461.065002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 256.002014, Y: 461.065002}), aff.Transform(f32.Point{X: 321.813995, Y: 461.065002}), aff.Transform(f32.Point{X: 335.101990, Y: 461.065002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 348.389008, Y: 461.065002}), aff.Transform(f32.Point{X: 369.428986, Y: 459.406006}), aff.Transform(f32.Point{X: 391.575012, Y: 437.257996}))
-	path.LineTo(aff.Transform(f32.Point{X: 256.001007, Y: 437.257996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 441.739990, Y: 73.188004}))
-	path.LineTo(aff.Transform(f32.Point{X: 448.557007, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 259.066010, Y: 198.867996}))
-	path.LineTo(aff.Transform(f32.Point{X: 259.075012, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 439.988007, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 436.220001, Y: 73.047997}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 34.044998, Y: 328.462006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 21.827000, Y: 328.462006}), aff.Transform(f32.Point{X: 21.827000, Y: 313.473999}), aff.Transform(f32.Point{X: 21.827000, Y: 292.730011}))
-	path.LineTo(aff.Transform(f32.Point{X: 26.809999, Y: 292.730011}))
-	path.CubeTo(aff.Transform(f32.Point{X: 26.809999, Y: 312.936005}), aff.Transform(f32.Point{X: 27.132000, Y: 323.479004}), aff.Transform(f32.Point{X: 34.043999, Y: 323.479004}))
-	path.CubeTo(aff.Transform(f32.Point{X: 39.317001, Y: 323.479004}), aff.Transform(f32.Point{X: 42.980999, Y: 322.337006}), aff.Transform(f32.Point{X: 43.016998, Y: 322.325989}))
-	path.LineTo(aff.Transform(f32.Point{X: 44.522999, Y: 327.075989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 44.344002, Y: 327.131989}), aff.Transform(f32.Point{X: 40.080002, Y: 328.462006}), aff.Transform(f32.Point{X: 34.044998, Y: 328.462006}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 36.478001, Y: 259.341003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 31.474001, Y: 259.341003}), aff.Transform(f32.Point{X: 24.319000, Y: 259.341003}), aff.Transform(f32.Point{X: 24.319000, Y: 259.341003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 24.319000, Y: 259.341003}), aff.Transform(f32.Point{X: 17.164000, Y: 259.341003}), aff.Transform(f32.Point{X: 12.160000, Y: 259.341003}))
-	path.CubeTo(aff.Transform(f32.Point{X: 7.156000, Y: 259.341003}), aff.Transform(f32.Point{X: -1.099000, Y: 264.878998}), aff.Transform(f32.Point{X: 3.859000, Y: 276.226990}))
-	path.CubeTo(aff.Transform(f32.Point{X: 8.817000, Y: 287.579010}), aff.Transform(f32.Point{X: 12.692000, Y: 296.158997}), aff.Transform(f32.Point{X: 24.319000, Y: 296.436005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 35.945999, Y: 296.158997}), aff.Transform(f32.Point{X: 39.820999, Y: 287.579010}), aff.Transform(f32.Point{X: 44.778000, Y: 276.226990}))
-	path.CubeTo(aff.Transform(f32.Point{X: 49.736000, Y: 264.878998}), aff.Transform(f32.Point{X: 41.480999, Y: 259.341003}), aff.Transform(f32.Point{X: 36.478001, Y: 259.341003}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 24.318001, Y: 289.131989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 12.900000, Y: 289.131989}), aff.Transform(f32.Point{X: 6.252000, Y: 273.804993}), aff.Transform(f32.Point{X: 3.633000, Y: 264.529999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 2.067000, Y: 267.300995}), aff.Transform(f32.Point{X: 1.657000, Y: 271.187988}), aff.Transform(f32.Point{X: 3.858000, Y: 276.226013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 8.816000, Y: 287.578003}), aff.Transform(f32.Point{X: 12.691000, Y: 296.157990}), aff.Transform(f32.Point{X: 24.318001, Y: 296.434998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 35.945000, Y: 296.157990}), aff.Transform(f32.Point{X: 39.820000, Y: 287.578003}), aff.Transform(f32.Point{X: 44.777000, Y: 276.226013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 46.918999, Y: 271.325012}), aff.Transform(f32.Point{X: 46.589001, Y: 267.511993}), aff.Transform(f32.Point{X: 45.127998, Y: 264.757996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 42.604000, Y: 273.645996}), aff.Transform(f32.Point{X: 35.931000, Y: 289.131989}), aff.Transform(f32.Point{X: 24.318001, Y: 289.131989}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 11.884000, Y: 264.529999}))
-	path.CubeTo(aff.Transform(f32.Point{X: 11.884000, Y: 264.529999}), aff.Transform(f32.Point{X: 17.929001, Y: 272.119995}), aff.Transform(f32.Point{X: 24.319000, Y: 272.804993}))
-	path.CubeTo(aff.Transform(f32.Point{X: 30.709000, Y: 273.492004}), aff.Transform(f32.Point{X: 40.120998, Y: 264.529999}), aff.Transform(f32.Point{X: 40.120998, Y: 264.529999}))
-	path.LineTo(aff.Transform(f32.Point{X: 11.884000, Y: 264.529999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 57.584000, Y: 211.893005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 57.584000, Y: 211.893005}), aff.Transform(f32.Point{X: 52.563000, Y: 220.050003}), aff.Transform(f32.Point{X: 57.584000, Y: 225.701004}))
-	path.CubeTo(aff.Transform(f32.Point{X: 62.605000, Y: 231.350006}), aff.Transform(f32.Point{X: 70.607002, Y: 228.839996}), aff.Transform(f32.Point{X: 70.607002, Y: 228.839996}))
-	path.LineTo(aff.Transform(f32.Point{X: 57.584000, Y: 211.893005}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 209, G: 213, B: 213, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 453.770996, Y: 211.893005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 453.770996, Y: 211.893005}), aff.Transform(f32.Point{X: 458.791992, Y: 220.050003}), aff.Transform(f32.Point{X: 453.770996, Y: 225.701004}))
-	path.CubeTo(aff.Transform(f32.Point{X: 448.750000, Y: 231.350006}), aff.Transform(f32.Point{X: 440.747986, Y: 228.839996}), aff.Transform(f32.Point{X: 440.747986, Y: 228.839996}))
-	path.LineTo(aff.Transform(f32.Point{X: 453.770996, Y: 211.893005}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 209, G: 213, B: 213, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 74.959000, Y: 78.445999}))
-	path.LineTo(aff.Transform(f32.Point{X: 71.367996, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 87.855003, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 130.921005, Y: 78.445999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 259.259003, Y: 78.445999}))
-	path.LineTo(aff.Transform(f32.Point{X: 259.259003, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 280.825012, Y: 189.550995}))
-	path.LineTo(aff.Transform(f32.Point{X: 314.045013, Y: 78.445999}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.257996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.257996}), aff.Transform(f32.Point{X: 44.778999, Y: 466.602997}), aff.Transform(f32.Point{X: 44.778999, Y: 482.657013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 44.778999, Y: 498.713013}), aff.Transform(f32.Point{X: 47.869999, Y: 512.000977}), aff.Transform(f32.Point{X: 68.760002, Y: 512.000977}))
-	path.CubeTo(aff.Transform(f32.Point{X: 89.650002, Y: 512.000977}), aff.Transform(f32.Point{X: 99.615997, Y: 512.002014}), aff.Transform(f32.Point{X: 99.615997, Y: 482.657013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 99.615997, Y: 453.312012}), aff.Transform(f32.Point{X: 99.615997, Y: 437.257996}), aff.Transform(f32.Point{X: 99.615997, Y: 437.257996}))
-	path.LineTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.257996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 59.696999, Y: 488.885986}))
-	path.CubeTo(aff.Transform(f32.Point{X: 59.304001, Y: 478.100006}), aff.Transform(f32.Point{X: 60.306999, Y: 449.161987}), aff.Transform(f32.Point{X: 60.306999, Y: 449.161987}))
-	path.LineTo(aff.Transform(f32.Point{X: 99.616997, Y: 443.186005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 99.616997, Y: 439.300995}), aff.Transform(f32.Point{X: 99.616997, Y: 437.256989}), aff.Transform(f32.Point{X: 99.616997, Y: 437.256989}))
-	path.LineTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.256989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.256989}), aff.Transform(f32.Point{X: 44.778999, Y: 466.601990}), aff.Transform(f32.Point{X: 44.778999, Y: 482.656006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 44.778999, Y: 498.712006}), aff.Transform(f32.Point{X: 47.869999, Y: 512.000000}), aff.Transform(f32.Point{X: 68.760002, Y: 512.000000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 69.646004, Y: 512.000000}), aff.Transform(f32.Point{X: 70.504997, Y: 511.997986}), aff.Transform(f32.Point{X: 71.350998, Y: 511.996002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 60.250999, Y: 507.174988}), aff.Transform(f32.Point{X: 59.995998, Y: 497.125000}), aff.Transform(f32.Point{X: 59.696999, Y: 488.885986}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.257996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.257996}), aff.Transform(f32.Point{X: 467.221985, Y: 466.602997}), aff.Transform(f32.Point{X: 467.221985, Y: 482.657013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.221985, Y: 498.713013}), aff.Transform(f32.Point{X: 464.131012, Y: 512.000977}), aff.Transform(f32.Point{X: 443.240997, Y: 512.000977}))
-	path.CubeTo(aff.Transform(f32.Point{X: 422.351013, Y: 512.000977}), aff.Transform(f32.Point{X: 412.385010, Y: 512.002014}), aff.Transform(f32.Point{X: 412.385010, Y: 482.657013}))
-	path.CubeTo(aff.Transform(f32.Point{X: 412.385010, Y: 453.312012}), aff.Transform(f32.Point{X: 412.385010, Y: 437.257996}), aff.Transform(f32.Point{X: 412.385010, Y: 437.257996}))
-	path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.257996}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 452.303009, Y: 488.885986}))
-	path.CubeTo(aff.Transform(f32.Point{X: 452.696014, Y: 478.100006}), aff.Transform(f32.Point{X: 451.694000, Y: 449.161987}), aff.Transform(f32.Point{X: 451.694000, Y: 449.161987}))
-	path.LineTo(aff.Transform(f32.Point{X: 412.384003, Y: 443.186005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 412.384003, Y: 439.300995}), aff.Transform(f32.Point{X: 412.384003, Y: 437.256989}), aff.Transform(f32.Point{X: 412.384003, Y: 437.256989}))
-	path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.256989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.256989}), aff.Transform(f32.Point{X: 467.221985, Y: 466.601990}), aff.Transform(f32.Point{X: 467.221985, Y: 482.656006}))
-	path.CubeTo(aff.Transform(f32.Point{X: 467.221985, Y: 498.712006}), aff.Transform(f32.Point{X: 464.131012, Y: 512.000000}), aff.Transform(f32.Point{X: 443.240997, Y: 512.000000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 442.355011, Y: 512.000000}), aff.Transform(f32.Point{X: 441.497009, Y: 511.997986}), aff.Transform(f32.Point{X: 440.649994, Y: 511.996002}))
-	path.CubeTo(aff.Transform(f32.Point{X: 451.750000, Y: 507.174988}), aff.Transform(f32.Point{X: 452.005005, Y: 497.125000}), aff.Transform(f32.Point{X: 452.303009, Y: 488.885986}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 37, G: 55, B: 68, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 67.859001, Y: 310.596985}))
-	path.LineTo(aff.Transform(f32.Point{X: 56.865002, Y: 318.209015}))
-	path.LineTo(aff.Transform(f32.Point{X: 99.615997, Y: 347.980988}))
-	path.LineTo(aff.Transform(f32.Point{X: 104.926003, Y: 310.596985}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 99.615997, Y: 347.980988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 99.615997, Y: 350.734009}), aff.Transform(f32.Point{X: 97.385002, Y: 352.963989}), aff.Transform(f32.Point{X: 94.633003, Y: 352.963989}))
-	path.LineTo(aff.Transform(f32.Point{X: 60.306000, Y: 352.963989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 57.554001, Y: 352.963989}), aff.Transform(f32.Point{X: 55.323002, Y: 350.734009}), aff.Transform(f32.Point{X: 55.323002, Y: 347.980988}))
-	path.LineTo(aff.Transform(f32.Point{X: 55.323002, Y: 321.806000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 55.323002, Y: 319.053009}), aff.Transform(f32.Point{X: 57.554001, Y: 316.822998}), aff.Transform(f32.Point{X: 60.306000, Y: 316.822998}))
-	path.LineTo(aff.Transform(f32.Point{X: 94.633003, Y: 316.822998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 97.384003, Y: 316.822998}), aff.Transform(f32.Point{X: 99.615997, Y: 319.053009}), aff.Transform(f32.Point{X: 99.615997, Y: 321.806000}))
-	path.LineTo(aff.Transform(f32.Point{X: 99.615997, Y: 347.980988}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 60.306999, Y: 316.822998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 57.555000, Y: 316.822998}), aff.Transform(f32.Point{X: 55.324001, Y: 319.053009}), aff.Transform(f32.Point{X: 55.324001, Y: 321.806000}))
-	path.LineTo(aff.Transform(f32.Point{X: 55.324001, Y: 347.980988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 55.324001, Y: 350.734009}), aff.Transform(f32.Point{X: 57.555000, Y: 352.963989}), aff.Transform(f32.Point{X: 60.306999, Y: 352.963989}))
-	path.LineTo(aff.Transform(f32.Point{X: 94.634003, Y: 316.821991}))
-	path.LineTo(aff.Transform(f32.Point{X: 60.306999, Y: 316.821991}))
-	path.LineTo(aff.Transform(f32.Point{X: 60.306999, Y: 316.822998}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 198, G: 203, B: 203, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 59.558998, Y: 347.127991}))
-	path.CubeTo(aff.Transform(f32.Point{X: 59.558998, Y: 343.654999}), aff.Transform(f32.Point{X: 59.558998, Y: 331.281006}), aff.Transform(f32.Point{X: 59.558998, Y: 324.187988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 59.558998, Y: 320.619995}), aff.Transform(f32.Point{X: 60.933998, Y: 318.273987}), aff.Transform(f32.Point{X: 62.300999, Y: 316.824005}))
-	path.LineTo(aff.Transform(f32.Point{X: 60.306000, Y: 316.824005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 57.554001, Y: 316.824005}), aff.Transform(f32.Point{X: 55.323002, Y: 319.053986}), aff.Transform(f32.Point{X: 55.323002, Y: 321.807007}))
-	path.LineTo(aff.Transform(f32.Point{X: 55.323002, Y: 347.981995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 55.323002, Y: 350.734985}), aff.Transform(f32.Point{X: 57.554001, Y: 352.964996}), aff.Transform(f32.Point{X: 60.306000, Y: 352.964996}))
-	path.LineTo(aff.Transform(f32.Point{X: 94.633003, Y: 352.964996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 96.544998, Y: 352.964996}), aff.Transform(f32.Point{X: 98.183998, Y: 351.876007}), aff.Transform(f32.Point{X: 99.021004, Y: 350.298004}))
-	path.CubeTo(aff.Transform(f32.Point{X: 70.194000, Y: 350.311005}), aff.Transform(f32.Point{X: 59.558998, Y: 350.493988}), aff.Transform(f32.Point{X: 59.558998, Y: 347.127991}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 444.141998, Y: 310.596985}))
-	path.LineTo(aff.Transform(f32.Point{X: 455.136993, Y: 318.209015}))
-	path.LineTo(aff.Transform(f32.Point{X: 412.385010, Y: 347.980988}))
-	path.LineTo(aff.Transform(f32.Point{X: 407.076996, Y: 310.596985}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 216, G: 153, B: 50, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 412.385010, Y: 347.980988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 412.385010, Y: 350.734009}), aff.Transform(f32.Point{X: 414.615997, Y: 352.963989}), aff.Transform(f32.Point{X: 417.368011, Y: 352.963989}))
-	path.LineTo(aff.Transform(f32.Point{X: 451.694000, Y: 352.963989}))
-	path.CubeTo(aff.Transform(f32.Point{X: 454.446014, Y: 352.963989}), aff.Transform(f32.Point{X: 456.675995, Y: 350.734009}), aff.Transform(f32.Point{X: 456.675995, Y: 347.980988}))
-	path.LineTo(aff.Transform(f32.Point{X: 456.675995, Y: 321.806000}))
-	path.CubeTo(aff.Transform(f32.Point{X: 456.675995, Y: 319.053009}), aff.Transform(f32.Point{X: 454.446014, Y: 316.822998}), aff.Transform(f32.Point{X: 451.694000, Y: 316.822998}))
-	path.LineTo(aff.Transform(f32.Point{X: 417.368011, Y: 316.822998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 414.615997, Y: 316.822998}), aff.Transform(f32.Point{X: 412.385010, Y: 319.053009}), aff.Transform(f32.Point{X: 412.385010, Y: 321.806000}))
-	path.LineTo(aff.Transform(f32.Point{X: 412.385010, Y: 347.980988}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 88, G: 106, B: 115, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 451.695007, Y: 316.822998}))
-	path.CubeTo(aff.Transform(f32.Point{X: 454.446991, Y: 316.822998}), aff.Transform(f32.Point{X: 456.677002, Y: 319.053009}), aff.Transform(f32.Point{X: 456.677002, Y: 321.806000}))
-	path.LineTo(aff.Transform(f32.Point{X: 456.677002, Y: 347.980988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 456.677002, Y: 350.734009}), aff.Transform(f32.Point{X: 454.446991, Y: 352.963989}), aff.Transform(f32.Point{X: 451.695007, Y: 352.963989}))
-	path.LineTo(aff.Transform(f32.Point{X: 417.368988, Y: 316.821991}))
-	path.LineTo(aff.Transform(f32.Point{X: 451.695007, Y: 316.821991}))
-	path.LineTo(aff.Transform(f32.Point{X: 451.695007, Y: 316.822998}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 198, G: 203, B: 203, A: 77}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-
-	path = clip.Path{}
-	path.Begin(ops)
-	path.MoveTo(aff.Transform(f32.Point{X: 452.442993, Y: 347.127991}))
-	path.CubeTo(aff.Transform(f32.Point{X: 452.442993, Y: 343.654999}), aff.Transform(f32.Point{X: 452.442993, Y: 331.281006}), aff.Transform(f32.Point{X: 452.442993, Y: 324.187988}))
-	path.CubeTo(aff.Transform(f32.Point{X: 452.442993, Y: 320.619995}), aff.Transform(f32.Point{X: 451.067993, Y: 318.273987}), aff.Transform(f32.Point{X: 449.700989, Y: 316.824005}))
-	path.LineTo(aff.Transform(f32.Point{X: 451.696014, Y: 316.824005}))
-	path.CubeTo(aff.Transform(f32.Point{X: 454.447998, Y: 316.824005}), aff.Transform(f32.Point{X: 456.678009, Y: 319.053986}), aff.Transform(f32.Point{X: 456.678009, Y: 321.807007}))
-	path.LineTo(aff.Transform(f32.Point{X: 456.678009, Y: 347.981995}))
-	path.CubeTo(aff.Transform(f32.Point{X: 456.678009, Y: 350.734985}), aff.Transform(f32.Point{X: 454.447998, Y: 352.964996}), aff.Transform(f32.Point{X: 451.696014, Y: 352.964996}))
-	path.LineTo(aff.Transform(f32.Point{X: 417.369995, Y: 352.964996}))
-	path.CubeTo(aff.Transform(f32.Point{X: 415.458008, Y: 352.964996}), aff.Transform(f32.Point{X: 413.817993, Y: 351.876007}), aff.Transform(f32.Point{X: 412.980988, Y: 350.298004}))
-	path.CubeTo(aff.Transform(f32.Point{X: 441.807007, Y: 350.311005}), aff.Transform(f32.Point{X: 452.442993, Y: 350.493988}), aff.Transform(f32.Point{X: 452.442993, Y: 347.127991}))
-	path.Close()
-	end = path.End()
-	outline = clip.Outline{Path: end}.Op().Push(ops)
-	paint.ColorOp{Color: color.NRGBA{R: 45, G: 64, B: 78, A: 255}}.Add(ops)
-	paint.PaintOp{}.Add(ops)
-	outline.Pop()
-	return layout.Dimensions{Size: image.Point{X: int(w), Y: int(h)}}
+		var (
+			size    = f32.Point{X: w / 512.001000, Y: h / 512.001000}
+			avg     = (size.X + size.Y) / 2
+			affBase = f32.Affine2D{}.Scale(f32.Point{X: float32(0 - 0.000000), Y: float32(0 - 0.000000)}, size)
+			aff     = affBase
+
+			end             clip.PathSpec
+			path            clip.Path
+			stroke, outline clip.Stack
+			c               color.NRGBA
+			ok              bool
+		)
+		_, _, _, _, _, _, _, _, _ = avg, aff, end, path, stroke, outline, c, ok, theme
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 57.089001, Y: 196.932999}))
+		path.LineTo(aff.Transform(f32.Point{X: 51.643002, Y: 196.932999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.817001, Y: 196.932999}), aff.Transform(f32.Point{X: 43.817001, Y: 187.345001}), aff.Transform(f32.Point{X: 43.817001, Y: 184.195007}))
+		path.LineTo(aff.Transform(f32.Point{X: 43.817001, Y: 79.553001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.817001, Y: 78.065002}), aff.Transform(f32.Point{X: 43.779999, Y: 76.612000}), aff.Transform(f32.Point{X: 43.743999, Y: 75.253998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.594002, Y: 69.420998}), aff.Transform(f32.Point{X: 43.504002, Y: 65.900002}), aff.Transform(f32.Point{X: 46.250999, Y: 64.764000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 48.740002, Y: 63.737000}), aff.Transform(f32.Point{X: 50.935001, Y: 66.051003}), aff.Transform(f32.Point{X: 51.873001, Y: 67.043999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 56.721001, Y: 72.162003}), aff.Transform(f32.Point{X: 61.618999, Y: 76.543999}), aff.Transform(f32.Point{X: 61.667000, Y: 76.586998}))
+		path.LineTo(aff.Transform(f32.Point{X: 58.348000, Y: 80.305000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 58.148998, Y: 80.126999}), aff.Transform(f32.Point{X: 53.525002, Y: 75.990997}), aff.Transform(f32.Point{X: 48.678001, Y: 70.913002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 48.651001, Y: 72.203003}), aff.Transform(f32.Point{X: 48.691002, Y: 73.801003}), aff.Transform(f32.Point{X: 48.724998, Y: 75.125999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 48.762001, Y: 76.524002}), aff.Transform(f32.Point{X: 48.799999, Y: 78.022003}), aff.Transform(f32.Point{X: 48.799999, Y: 79.553001}))
+		path.LineTo(aff.Transform(f32.Point{X: 48.799999, Y: 184.195007}))
+		path.CubeTo(aff.Transform(f32.Point{X: 48.799999, Y: 191.949997}), aff.Transform(f32.Point{X: 50.863998, Y: 191.949997}), aff.Transform(f32.Point{X: 51.643002, Y: 191.949997}))
+		path.LineTo(aff.Transform(f32.Point{X: 57.089001, Y: 191.949997}))
+		path.LineTo(aff.Transform(f32.Point{X: 57.089001, Y: 196.932999}))
+		path.LineTo(aff.Transform(f32.Point{X: 57.089001, Y: 196.932999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 47.421001, Y: 171.419006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 47.421001, Y: 175.087997}), aff.Transform(f32.Point{X: 44.445999, Y: 178.063995}), aff.Transform(f32.Point{X: 40.777000, Y: 178.063995}))
+		path.LineTo(aff.Transform(f32.Point{X: 28.318001, Y: 178.063995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 24.649000, Y: 178.063995}), aff.Transform(f32.Point{X: 21.674000, Y: 175.087997}), aff.Transform(f32.Point{X: 21.674000, Y: 171.419006}))
+		path.LineTo(aff.Transform(f32.Point{X: 21.674000, Y: 90.030998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 21.674000, Y: 86.361000}), aff.Transform(f32.Point{X: 24.649000, Y: 83.386002}), aff.Transform(f32.Point{X: 28.318001, Y: 83.386002}))
+		path.LineTo(aff.Transform(f32.Point{X: 40.776001, Y: 83.386002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 44.445000, Y: 83.386002}), aff.Transform(f32.Point{X: 47.419998, Y: 86.361000}), aff.Transform(f32.Point{X: 47.419998, Y: 90.030998}))
+		path.LineTo(aff.Transform(f32.Point{X: 47.421001, Y: 171.419006}))
+		path.LineTo(aff.Transform(f32.Point{X: 47.421001, Y: 171.419006}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 36.478001, Y: 172.994003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 29.898001, Y: 172.994003}), aff.Transform(f32.Point{X: 26.563000, Y: 167.634003}), aff.Transform(f32.Point{X: 26.563000, Y: 157.324997}))
+		path.CubeTo(aff.Transform(f32.Point{X: 26.563000, Y: 148.748001}), aff.Transform(f32.Point{X: 26.563000, Y: 99.835999}), aff.Transform(f32.Point{X: 26.563000, Y: 83.651001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 23.752001, Y: 84.426003}), aff.Transform(f32.Point{X: 21.676001, Y: 86.973000}), aff.Transform(f32.Point{X: 21.676001, Y: 90.030998}))
+		path.LineTo(aff.Transform(f32.Point{X: 21.676001, Y: 171.419006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 21.676001, Y: 175.087997}), aff.Transform(f32.Point{X: 24.650999, Y: 178.063995}), aff.Transform(f32.Point{X: 28.320000, Y: 178.063995}))
+		path.LineTo(aff.Transform(f32.Point{X: 40.778000, Y: 178.063995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.903000, Y: 178.063995}), aff.Transform(f32.Point{X: 46.504002, Y: 175.899994}), aff.Transform(f32.Point{X: 47.214001, Y: 172.994995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 44.157001, Y: 172.994003}), aff.Transform(f32.Point{X: 40.030998, Y: 172.994003}), aff.Transform(f32.Point{X: 36.478001, Y: 172.994003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 34.444000, Y: 89.261002}))
+		path.LineTo(aff.Transform(f32.Point{X: 41.021000, Y: 121.442001}))
+		path.LineTo(aff.Transform(f32.Point{X: 41.021000, Y: 89.261002}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 454.260010, Y: 196.932999}))
+		path.LineTo(aff.Transform(f32.Point{X: 459.707001, Y: 196.932999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.532990, Y: 196.932999}), aff.Transform(f32.Point{X: 467.532990, Y: 187.345001}), aff.Transform(f32.Point{X: 467.532990, Y: 184.195007}))
+		path.LineTo(aff.Transform(f32.Point{X: 467.532990, Y: 79.553001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.532990, Y: 78.065002}), aff.Transform(f32.Point{X: 467.570007, Y: 76.612000}), aff.Transform(f32.Point{X: 467.605011, Y: 75.253998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.755005, Y: 69.420998}), aff.Transform(f32.Point{X: 467.845001, Y: 65.900002}), aff.Transform(f32.Point{X: 465.097992, Y: 64.764000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 462.609009, Y: 63.737000}), aff.Transform(f32.Point{X: 460.414001, Y: 66.051003}), aff.Transform(f32.Point{X: 459.476013, Y: 67.043999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 454.627991, Y: 72.162003}), aff.Transform(f32.Point{X: 449.730988, Y: 76.543999}), aff.Transform(f32.Point{X: 449.682007, Y: 76.586998}))
+		path.LineTo(aff.Transform(f32.Point{X: 453.001007, Y: 80.305000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 453.200012, Y: 80.126999}), aff.Transform(f32.Point{X: 457.822998, Y: 75.990997}), aff.Transform(f32.Point{X: 462.670990, Y: 70.913002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 462.697998, Y: 72.203003}), aff.Transform(f32.Point{X: 462.657013, Y: 73.801003}), aff.Transform(f32.Point{X: 462.623993, Y: 75.125999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 462.587006, Y: 76.524002}), aff.Transform(f32.Point{X: 462.549011, Y: 78.022003}), aff.Transform(f32.Point{X: 462.549011, Y: 79.553001}))
+		path.LineTo(aff.Transform(f32.Point{X: 462.549011, Y: 184.195007}))
+		path.CubeTo(aff.Transform(f32.Point{X: 462.549011, Y: 191.949997}), aff.Transform(f32.Point{X: 460.484009, Y: 191.949997}), aff.Transform(f32.Point{X: 459.705994, Y: 191.949997}))
+		path.LineTo(aff.Transform(f32.Point{X: 454.259003, Y: 191.949997}))
+		path.LineTo(aff.Transform(f32.Point{X: 454.260010, Y: 196.932999}))
+		path.LineTo(aff.Transform(f32.Point{X: 454.260010, Y: 196.932999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 463.931000, Y: 171.419006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 463.931000, Y: 175.087997}), aff.Transform(f32.Point{X: 466.906006, Y: 178.063995}), aff.Transform(f32.Point{X: 470.575012, Y: 178.063995}))
+		path.LineTo(aff.Transform(f32.Point{X: 483.032013, Y: 178.063995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 486.701996, Y: 178.063995}), aff.Transform(f32.Point{X: 489.677002, Y: 175.087997}), aff.Transform(f32.Point{X: 489.677002, Y: 171.419006}))
+		path.LineTo(aff.Transform(f32.Point{X: 489.677002, Y: 90.030998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 489.677002, Y: 86.361000}), aff.Transform(f32.Point{X: 486.701996, Y: 83.386002}), aff.Transform(f32.Point{X: 483.032013, Y: 83.386002}))
+		path.LineTo(aff.Transform(f32.Point{X: 470.575012, Y: 83.386002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 466.906006, Y: 83.386002}), aff.Transform(f32.Point{X: 463.931000, Y: 86.361000}), aff.Transform(f32.Point{X: 463.931000, Y: 90.030998}))
+		path.LineTo(aff.Transform(f32.Point{X: 463.931000, Y: 171.419006}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 474.873993, Y: 172.994003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 481.453003, Y: 172.994003}), aff.Transform(f32.Point{X: 484.789001, Y: 167.634003}), aff.Transform(f32.Point{X: 484.789001, Y: 157.324997}))
+		path.CubeTo(aff.Transform(f32.Point{X: 484.789001, Y: 148.748001}), aff.Transform(f32.Point{X: 484.789001, Y: 99.835999}), aff.Transform(f32.Point{X: 484.789001, Y: 83.651001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 487.600006, Y: 84.426003}), aff.Transform(f32.Point{X: 489.675995, Y: 86.973000}), aff.Transform(f32.Point{X: 489.675995, Y: 90.030998}))
+		path.LineTo(aff.Transform(f32.Point{X: 489.675995, Y: 171.419006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 489.675995, Y: 175.087997}), aff.Transform(f32.Point{X: 486.700989, Y: 178.063995}), aff.Transform(f32.Point{X: 483.031006, Y: 178.063995}))
+		path.LineTo(aff.Transform(f32.Point{X: 470.574005, Y: 178.063995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.450012, Y: 178.063995}), aff.Transform(f32.Point{X: 464.847992, Y: 175.899994}), aff.Transform(f32.Point{X: 464.138000, Y: 172.994995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.194000, Y: 172.994003}), aff.Transform(f32.Point{X: 471.320007, Y: 172.994003}), aff.Transform(f32.Point{X: 474.873993, Y: 172.994003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 476.907013, Y: 89.261002}))
+		path.LineTo(aff.Transform(f32.Point{X: 470.329010, Y: 121.442001}))
+		path.LineTo(aff.Transform(f32.Point{X: 470.329010, Y: 89.261002}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 477.957001, Y: 328.462006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 490.174988, Y: 328.462006}), aff.Transform(f32.Point{X: 490.174988, Y: 313.473999}), aff.Transform(f32.Point{X: 490.174988, Y: 292.730011}))
+		path.LineTo(aff.Transform(f32.Point{X: 485.191986, Y: 292.730011}))
+		path.CubeTo(aff.Transform(f32.Point{X: 485.191986, Y: 312.936005}), aff.Transform(f32.Point{X: 484.869995, Y: 323.479004}), aff.Transform(f32.Point{X: 477.958008, Y: 323.479004}))
+		path.CubeTo(aff.Transform(f32.Point{X: 472.684998, Y: 323.479004}), aff.Transform(f32.Point{X: 469.020996, Y: 322.337006}), aff.Transform(f32.Point{X: 468.984985, Y: 322.325989}))
+		path.LineTo(aff.Transform(f32.Point{X: 467.479004, Y: 327.075989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.657013, Y: 327.131989}), aff.Transform(f32.Point{X: 471.920990, Y: 328.462006}), aff.Transform(f32.Point{X: 477.957001, Y: 328.462006}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 475.524994, Y: 259.341003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 480.528992, Y: 259.341003}), aff.Transform(f32.Point{X: 487.683990, Y: 259.341003}), aff.Transform(f32.Point{X: 487.683990, Y: 259.341003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 487.683990, Y: 259.341003}), aff.Transform(f32.Point{X: 494.838013, Y: 259.341003}), aff.Transform(f32.Point{X: 499.842010, Y: 259.341003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 504.846008, Y: 259.341003}), aff.Transform(f32.Point{X: 513.101013, Y: 264.878998}), aff.Transform(f32.Point{X: 508.143005, Y: 276.226990}))
+		path.CubeTo(aff.Transform(f32.Point{X: 503.186005, Y: 287.579010}), aff.Transform(f32.Point{X: 499.311005, Y: 296.158997}), aff.Transform(f32.Point{X: 487.683990, Y: 296.436005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 476.056000, Y: 296.158997}), aff.Transform(f32.Point{X: 472.182007, Y: 287.579010}), aff.Transform(f32.Point{X: 467.223999, Y: 276.226990}))
+		path.CubeTo(aff.Transform(f32.Point{X: 462.265991, Y: 264.878998}), aff.Transform(f32.Point{X: 470.519989, Y: 259.341003}), aff.Transform(f32.Point{X: 475.524994, Y: 259.341003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 487.683014, Y: 289.131989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 499.101013, Y: 289.131989}), aff.Transform(f32.Point{X: 505.747986, Y: 273.804993}), aff.Transform(f32.Point{X: 508.368011, Y: 264.529999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 509.933990, Y: 267.300995}), aff.Transform(f32.Point{X: 510.343994, Y: 271.187988}), aff.Transform(f32.Point{X: 508.141998, Y: 276.226013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 503.184998, Y: 287.578003}), aff.Transform(f32.Point{X: 499.309998, Y: 296.157990}), aff.Transform(f32.Point{X: 487.683014, Y: 296.434998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 476.054993, Y: 296.157990}), aff.Transform(f32.Point{X: 472.181000, Y: 287.578003}), aff.Transform(f32.Point{X: 467.222992, Y: 276.226013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 465.080994, Y: 271.325012}), aff.Transform(f32.Point{X: 465.411011, Y: 267.511993}), aff.Transform(f32.Point{X: 466.872009, Y: 264.757996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 469.398010, Y: 273.645996}), aff.Transform(f32.Point{X: 476.071991, Y: 289.131989}), aff.Transform(f32.Point{X: 487.683014, Y: 289.131989}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 500.118011, Y: 264.529999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 500.118011, Y: 264.529999}), aff.Transform(f32.Point{X: 494.074005, Y: 272.119995}), aff.Transform(f32.Point{X: 487.683990, Y: 272.804993}))
+		path.CubeTo(aff.Transform(f32.Point{X: 481.292999, Y: 273.492004}), aff.Transform(f32.Point{X: 471.881012, Y: 264.529999}), aff.Transform(f32.Point{X: 471.881012, Y: 264.529999}))
+		path.LineTo(aff.Transform(f32.Point{X: 500.118011, Y: 264.529999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 474.875000, Y: 430.614014}))
+		path.CubeTo(aff.Transform(f32.Point{X: 474.875000, Y: 434.282990}), aff.Transform(f32.Point{X: 471.899994, Y: 437.257996}), aff.Transform(f32.Point{X: 468.230988, Y: 437.257996}))
+		path.LineTo(aff.Transform(f32.Point{X: 43.769001, Y: 437.257996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 40.099998, Y: 437.257996}), aff.Transform(f32.Point{X: 37.125000, Y: 434.282990}), aff.Transform(f32.Point{X: 37.125000, Y: 430.614014}))
+		path.LineTo(aff.Transform(f32.Point{X: 37.125000, Y: 385.036011}))
+		path.CubeTo(aff.Transform(f32.Point{X: 37.125000, Y: 381.365997}), aff.Transform(f32.Point{X: 40.099998, Y: 378.390991}), aff.Transform(f32.Point{X: 43.769001, Y: 378.390991}))
+		path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.390991}))
+		path.CubeTo(aff.Transform(f32.Point{X: 471.898987, Y: 378.390991}), aff.Transform(f32.Point{X: 474.873993, Y: 381.365997}), aff.Transform(f32.Point{X: 474.873993, Y: 385.036011}))
+		path.LineTo(aff.Transform(f32.Point{X: 474.875000, Y: 430.614014}))
+		path.LineTo(aff.Transform(f32.Point{X: 474.875000, Y: 430.614014}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 43.771000, Y: 378.391998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 40.102001, Y: 378.391998}), aff.Transform(f32.Point{X: 37.126999, Y: 381.367004}), aff.Transform(f32.Point{X: 37.126999, Y: 385.036987}))
+		path.LineTo(aff.Transform(f32.Point{X: 37.126999, Y: 430.614990}))
+		path.CubeTo(aff.Transform(f32.Point{X: 37.126999, Y: 434.283997}), aff.Transform(f32.Point{X: 40.102001, Y: 437.259003}), aff.Transform(f32.Point{X: 43.771000, Y: 437.259003}))
+		path.LineTo(aff.Transform(f32.Point{X: 52.025002, Y: 437.259003}))
+		path.LineTo(aff.Transform(f32.Point{X: 52.025002, Y: 378.393005}))
+		path.LineTo(aff.Transform(f32.Point{X: 43.771000, Y: 378.393005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.771000, Y: 378.393005}), aff.Transform(f32.Point{X: 43.771000, Y: 378.391998}), aff.Transform(f32.Point{X: 43.771000, Y: 378.391998}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.500000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.391998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 471.898987, Y: 378.391998}), aff.Transform(f32.Point{X: 474.873993, Y: 381.367004}), aff.Transform(f32.Point{X: 474.873993, Y: 385.036987}))
+		path.LineTo(aff.Transform(f32.Point{X: 474.873993, Y: 430.614990}))
+		path.CubeTo(aff.Transform(f32.Point{X: 474.873993, Y: 434.283997}), aff.Transform(f32.Point{X: 471.898987, Y: 437.259003}), aff.Transform(f32.Point{X: 468.230011, Y: 437.259003}))
+		path.LineTo(aff.Transform(f32.Point{X: 459.976013, Y: 437.259003}))
+		path.LineTo(aff.Transform(f32.Point{X: 459.976013, Y: 378.393005}))
+		path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.393005}))
+		path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 378.391998}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.500000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 43.705002, Y: 378.391998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 41.933998, Y: 378.391998}), aff.Transform(f32.Point{X: 40.332001, Y: 379.113007}), aff.Transform(f32.Point{X: 39.152000, Y: 380.270996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 116.897003, Y: 390.516998}), aff.Transform(f32.Point{X: 255.996994, Y: 392.529999}), aff.Transform(f32.Point{X: 255.996994, Y: 392.529999}))
+		path.LineTo(aff.Transform(f32.Point{X: 255.929993, Y: 378.391998}))
+		path.LineTo(aff.Transform(f32.Point{X: 43.705002, Y: 378.391998}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 468.290009, Y: 378.391998}))
+		path.LineTo(aff.Transform(f32.Point{X: 256.066010, Y: 378.391998}))
+		path.LineTo(aff.Transform(f32.Point{X: 255.998993, Y: 392.529999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 255.998993, Y: 392.529999}), aff.Transform(f32.Point{X: 395.097992, Y: 390.516998}), aff.Transform(f32.Point{X: 472.842987, Y: 380.270996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 471.662994, Y: 379.112000}), aff.Transform(f32.Point{X: 470.062012, Y: 378.391998}), aff.Transform(f32.Point{X: 468.290009, Y: 378.391998}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 474.854004, Y: 355.830994}))
+		path.CubeTo(aff.Transform(f32.Point{X: 474.304993, Y: 329.808014}), aff.Transform(f32.Point{X: 472.660004, Y: 306.001007}), aff.Transform(f32.Point{X: 456.765991, Y: 287.730011}))
+		path.LineTo(aff.Transform(f32.Point{X: 451.283997, Y: 83.428001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 451.283997, Y: 83.428001}), aff.Transform(f32.Point{X: 453.477997, Y: 52.423000}), aff.Transform(f32.Point{X: 431.552002, Y: 36.921001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 409.627014, Y: 21.420000}), aff.Transform(f32.Point{X: 363.181000, Y: 5.155000}), aff.Transform(f32.Point{X: 255.677002, Y: 0.000000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 148.171997, Y: 5.155000}), aff.Transform(f32.Point{X: 101.724998, Y: 21.420000}), aff.Transform(f32.Point{X: 79.801003, Y: 36.922001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 57.876999, Y: 52.424000}), aff.Transform(f32.Point{X: 60.068001, Y: 83.429001}), aff.Transform(f32.Point{X: 60.068001, Y: 83.429001}))
+		path.LineTo(aff.Transform(f32.Point{X: 54.585999, Y: 287.730988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 38.689999, Y: 306.002014}), aff.Transform(f32.Point{X: 37.044998, Y: 329.808014}), aff.Transform(f32.Point{X: 36.499001, Y: 355.832001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 35.950001, Y: 381.854004}), aff.Transform(f32.Point{X: 46.216999, Y: 378.802002}), aff.Transform(f32.Point{X: 46.216999, Y: 378.802002}))
+		path.LineTo(aff.Transform(f32.Point{X: 255.677002, Y: 383.891998}))
+		path.LineTo(aff.Transform(f32.Point{X: 465.136993, Y: 378.802002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 465.135010, Y: 378.800995}), aff.Transform(f32.Point{X: 475.402008, Y: 381.854004}), aff.Transform(f32.Point{X: 474.854004, Y: 355.830994}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 252.016006, Y: 73.188004}))
+		path.LineTo(aff.Transform(f32.Point{X: 69.613998, Y: 73.188004}))
+		path.LineTo(aff.Transform(f32.Point{X: 62.797001, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 252.287003, Y: 198.867996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 69.613998, Y: 73.188004}))
+		path.LineTo(aff.Transform(f32.Point{X: 62.797001, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 252.287003, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 252.279999, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 71.367996, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 75.135002, Y: 73.047997}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 259.270996, Y: 73.188004}))
+		path.LineTo(aff.Transform(f32.Point{X: 441.670013, Y: 73.188004}))
+		path.LineTo(aff.Transform(f32.Point{X: 448.485992, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 258.997009, Y: 198.867996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 69.704002, Y: 47.160000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 72.315002, Y: 43.396000}), aff.Transform(f32.Point{X: 75.619003, Y: 39.876999}), aff.Transform(f32.Point{X: 79.800003, Y: 36.922001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 93.789001, Y: 27.030001}), aff.Transform(f32.Point{X: 117.762001, Y: 16.827000}), aff.Transform(f32.Point{X: 161.209000, Y: 9.401000}))
+		path.LineTo(aff.Transform(f32.Point{X: 161.209000, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 69.719002, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 69.704002, Y: 47.160000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 69.719002, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 161.207993, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 162.179001, Y: 78.445999}))
+		path.LineTo(aff.Transform(f32.Point{X: 72.486000, Y: 78.445999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 164.322998, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 347.031006, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 345.369995, Y: 78.445999}))
+		path.LineTo(aff.Transform(f32.Point{X: 165.707001, Y: 78.445999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 441.647003, Y: 47.160000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 439.036987, Y: 43.396000}), aff.Transform(f32.Point{X: 435.733002, Y: 39.876999}), aff.Transform(f32.Point{X: 431.550995, Y: 36.922001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 417.562988, Y: 27.030001}), aff.Transform(f32.Point{X: 393.588989, Y: 16.827000}), aff.Transform(f32.Point{X: 350.144012, Y: 9.401000}))
+		path.LineTo(aff.Transform(f32.Point{X: 350.144012, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 441.634003, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 441.647003, Y: 47.160000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 390.131012, Y: 50.187000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 390.131012, Y: 48.423088}), aff.Transform(f32.Point{X: 389.804108, Y: 46.674351}), aff.Transform(f32.Point{X: 389.166901, Y: 45.029552}))
+		path.CubeTo(aff.Transform(f32.Point{X: 388.529694, Y: 43.384754}), aff.Transform(f32.Point{X: 387.593170, Y: 41.872196}), aff.Transform(f32.Point{X: 386.404816, Y: 40.568645}))
+		path.CubeTo(aff.Transform(f32.Point{X: 385.216492, Y: 39.265099}), aff.Transform(f32.Point{X: 383.796783, Y: 38.192997}), aff.Transform(f32.Point{X: 382.217804, Y: 37.406754}))
+		path.CubeTo(aff.Transform(f32.Point{X: 380.638824, Y: 36.620510}), aff.Transform(f32.Point{X: 378.927704, Y: 36.133656}), aff.Transform(f32.Point{X: 377.171326, Y: 35.970901}))
+		path.CubeTo(aff.Transform(f32.Point{X: 375.414917, Y: 35.808151}), aff.Transform(f32.Point{X: 373.643494, Y: 35.972298}), aff.Transform(f32.Point{X: 371.946899, Y: 36.455017}))
+		path.CubeTo(aff.Transform(f32.Point{X: 370.250336, Y: 36.937733}), aff.Transform(f32.Point{X: 368.657806, Y: 37.730713}), aff.Transform(f32.Point{X: 367.250183, Y: 38.793709}))
+		path.CubeTo(aff.Transform(f32.Point{X: 365.842560, Y: 39.856705}), aff.Transform(f32.Point{X: 364.644043, Y: 41.171417}), aff.Transform(f32.Point{X: 363.715454, Y: 42.671127}))
+		path.CubeTo(aff.Transform(f32.Point{X: 362.786865, Y: 44.170837}), aff.Transform(f32.Point{X: 362.144226, Y: 45.829731}), aff.Transform(f32.Point{X: 361.820099, Y: 47.563606}))
+		path.CubeTo(aff.Transform(f32.Point{X: 361.495972, Y: 49.297485}), aff.Transform(f32.Point{X: 361.495972, Y: 51.076511}), aff.Transform(f32.Point{X: 361.820099, Y: 52.810390}))
+		path.CubeTo(aff.Transform(f32.Point{X: 362.144226, Y: 54.544270}), aff.Transform(f32.Point{X: 362.786865, Y: 56.203163}), aff.Transform(f32.Point{X: 363.715454, Y: 57.702873}))
+		path.CubeTo(aff.Transform(f32.Point{X: 364.644043, Y: 59.202579}), aff.Transform(f32.Point{X: 365.842560, Y: 60.517296}), aff.Transform(f32.Point{X: 367.250183, Y: 61.580292}))
+		path.CubeTo(aff.Transform(f32.Point{X: 368.657806, Y: 62.643288}), aff.Transform(f32.Point{X: 370.250336, Y: 63.436268}), aff.Transform(f32.Point{X: 371.946899, Y: 63.918983}))
+		path.CubeTo(aff.Transform(f32.Point{X: 373.643494, Y: 64.401703}), aff.Transform(f32.Point{X: 375.414917, Y: 64.565849}), aff.Transform(f32.Point{X: 377.171326, Y: 64.403099}))
+		path.CubeTo(aff.Transform(f32.Point{X: 378.927704, Y: 64.240341}), aff.Transform(f32.Point{X: 380.638824, Y: 63.753490}), aff.Transform(f32.Point{X: 382.217804, Y: 62.967247}))
+		path.CubeTo(aff.Transform(f32.Point{X: 383.796783, Y: 62.181004}), aff.Transform(f32.Point{X: 385.216492, Y: 61.108898}), aff.Transform(f32.Point{X: 386.404816, Y: 59.805351}))
+		path.CubeTo(aff.Transform(f32.Point{X: 387.593170, Y: 58.501804}), aff.Transform(f32.Point{X: 388.529694, Y: 56.989246}), aff.Transform(f32.Point{X: 389.166901, Y: 55.344448}))
+		path.CubeTo(aff.Transform(f32.Point{X: 389.804108, Y: 53.699646}), aff.Transform(f32.Point{X: 390.131012, Y: 51.950912}), aff.Transform(f32.Point{X: 390.131012, Y: 50.187000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 185, G: 72, B: 62, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 386.365997, Y: 50.187000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 386.365997, Y: 48.888126}), aff.Transform(f32.Point{X: 386.125275, Y: 47.600430}), aff.Transform(f32.Point{X: 385.656067, Y: 46.389267}))
+		path.CubeTo(aff.Transform(f32.Point{X: 385.186859, Y: 45.178104}), aff.Transform(f32.Point{X: 384.497253, Y: 44.064316}), aff.Transform(f32.Point{X: 383.622192, Y: 43.104439}))
+		path.CubeTo(aff.Transform(f32.Point{X: 382.747162, Y: 42.144558}), aff.Transform(f32.Point{X: 381.701752, Y: 41.355106}), aff.Transform(f32.Point{X: 380.539062, Y: 40.776150}))
+		path.CubeTo(aff.Transform(f32.Point{X: 379.376343, Y: 40.197189}), aff.Transform(f32.Point{X: 378.116364, Y: 39.838692}), aff.Transform(f32.Point{X: 376.823029, Y: 39.718845}))
+		path.CubeTo(aff.Transform(f32.Point{X: 375.529694, Y: 39.599003}), aff.Transform(f32.Point{X: 374.225281, Y: 39.719875}), aff.Transform(f32.Point{X: 372.975983, Y: 40.075329}))
+		path.CubeTo(aff.Transform(f32.Point{X: 371.726685, Y: 40.430782}), aff.Transform(f32.Point{X: 370.554016, Y: 41.014698}), aff.Transform(f32.Point{X: 369.517517, Y: 41.797443}))
+		path.CubeTo(aff.Transform(f32.Point{X: 368.480988, Y: 42.580193}), aff.Transform(f32.Point{X: 367.598450, Y: 43.548294}), aff.Transform(f32.Point{X: 366.914673, Y: 44.652618}))
+		path.CubeTo(aff.Transform(f32.Point{X: 366.230896, Y: 45.756943}), aff.Transform(f32.Point{X: 365.757660, Y: 46.978485}), aff.Transform(f32.Point{X: 365.519012, Y: 48.255241}))
+		path.CubeTo(aff.Transform(f32.Point{X: 365.280334, Y: 49.531998}), aff.Transform(f32.Point{X: 365.280334, Y: 50.842003}), aff.Transform(f32.Point{X: 365.519012, Y: 52.118759}))
+		path.CubeTo(aff.Transform(f32.Point{X: 365.757660, Y: 53.395515}), aff.Transform(f32.Point{X: 366.230896, Y: 54.617058}), aff.Transform(f32.Point{X: 366.914673, Y: 55.721382}))
+		path.CubeTo(aff.Transform(f32.Point{X: 367.598450, Y: 56.825706}), aff.Transform(f32.Point{X: 368.480988, Y: 57.793808}), aff.Transform(f32.Point{X: 369.517517, Y: 58.576553}))
+		path.CubeTo(aff.Transform(f32.Point{X: 370.554016, Y: 59.359303}), aff.Transform(f32.Point{X: 371.726685, Y: 59.943218}), aff.Transform(f32.Point{X: 372.975983, Y: 60.298672}))
+		path.CubeTo(aff.Transform(f32.Point{X: 374.225281, Y: 60.654125}), aff.Transform(f32.Point{X: 375.529694, Y: 60.774998}), aff.Transform(f32.Point{X: 376.823029, Y: 60.655155}))
+		path.CubeTo(aff.Transform(f32.Point{X: 378.116364, Y: 60.535309}), aff.Transform(f32.Point{X: 379.376343, Y: 60.176811}), aff.Transform(f32.Point{X: 380.539062, Y: 59.597851}))
+		path.CubeTo(aff.Transform(f32.Point{X: 381.701752, Y: 59.018894}), aff.Transform(f32.Point{X: 382.747162, Y: 58.229443}), aff.Transform(f32.Point{X: 383.622192, Y: 57.269562}))
+		path.CubeTo(aff.Transform(f32.Point{X: 384.497253, Y: 56.309685}), aff.Transform(f32.Point{X: 385.186859, Y: 55.195896}), aff.Transform(f32.Point{X: 385.656067, Y: 53.984734}))
+		path.CubeTo(aff.Transform(f32.Point{X: 386.125275, Y: 52.773571}), aff.Transform(f32.Point{X: 386.365997, Y: 51.485874}), aff.Transform(f32.Point{X: 386.365997, Y: 50.187000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 223, G: 88, B: 76, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 420.397003, Y: 52.213001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 420.397003, Y: 50.699398}), aff.Transform(f32.Point{X: 420.116486, Y: 49.198822}), aff.Transform(f32.Point{X: 419.569702, Y: 47.787430}))
+		path.CubeTo(aff.Transform(f32.Point{X: 419.022949, Y: 46.376038}), aff.Transform(f32.Point{X: 418.219299, Y: 45.078121}), aff.Transform(f32.Point{X: 417.199585, Y: 43.959553}))
+		path.CubeTo(aff.Transform(f32.Point{X: 416.179901, Y: 42.840988}), aff.Transform(f32.Point{X: 414.961670, Y: 41.921024}), aff.Transform(f32.Point{X: 413.606750, Y: 41.246353}))
+		path.CubeTo(aff.Transform(f32.Point{X: 412.251831, Y: 40.571686}), aff.Transform(f32.Point{X: 410.783539, Y: 40.153919}), aff.Transform(f32.Point{X: 409.276367, Y: 40.014259}))
+		path.CubeTo(aff.Transform(f32.Point{X: 407.769226, Y: 39.874603}), aff.Transform(f32.Point{X: 406.249176, Y: 40.015457}), aff.Transform(f32.Point{X: 404.793365, Y: 40.429672}))
+		path.CubeTo(aff.Transform(f32.Point{X: 403.337524, Y: 40.843891}), aff.Transform(f32.Point{X: 401.971008, Y: 41.524342}), aff.Transform(f32.Point{X: 400.763123, Y: 42.436489}))
+		path.CubeTo(aff.Transform(f32.Point{X: 399.555237, Y: 43.348640}), aff.Transform(f32.Point{X: 398.526794, Y: 44.476788}), aff.Transform(f32.Point{X: 397.729980, Y: 45.763680}))
+		path.CubeTo(aff.Transform(f32.Point{X: 396.933167, Y: 47.050571}), aff.Transform(f32.Point{X: 396.381714, Y: 48.474056}), aff.Transform(f32.Point{X: 396.103607, Y: 49.961884}))
+		path.CubeTo(aff.Transform(f32.Point{X: 395.825470, Y: 51.449715}), aff.Transform(f32.Point{X: 395.825470, Y: 52.976284}), aff.Transform(f32.Point{X: 396.103607, Y: 54.464115}))
+		path.CubeTo(aff.Transform(f32.Point{X: 396.381714, Y: 55.951946}), aff.Transform(f32.Point{X: 396.933167, Y: 57.375431}), aff.Transform(f32.Point{X: 397.729980, Y: 58.662319}))
+		path.CubeTo(aff.Transform(f32.Point{X: 398.526794, Y: 59.949211}), aff.Transform(f32.Point{X: 399.555237, Y: 61.077358}), aff.Transform(f32.Point{X: 400.763123, Y: 61.989510}))
+		path.CubeTo(aff.Transform(f32.Point{X: 401.971008, Y: 62.901657}), aff.Transform(f32.Point{X: 403.337524, Y: 63.582108}), aff.Transform(f32.Point{X: 404.793365, Y: 63.996326}))
+		path.CubeTo(aff.Transform(f32.Point{X: 406.249176, Y: 64.410545}), aff.Transform(f32.Point{X: 407.769226, Y: 64.551399}), aff.Transform(f32.Point{X: 409.276367, Y: 64.411743}))
+		path.CubeTo(aff.Transform(f32.Point{X: 410.783539, Y: 64.272079}), aff.Transform(f32.Point{X: 412.251831, Y: 63.854317}), aff.Transform(f32.Point{X: 413.606750, Y: 63.179646}))
+		path.CubeTo(aff.Transform(f32.Point{X: 414.961670, Y: 62.504974}), aff.Transform(f32.Point{X: 416.179901, Y: 61.585011}), aff.Transform(f32.Point{X: 417.199585, Y: 60.466446}))
+		path.CubeTo(aff.Transform(f32.Point{X: 418.219299, Y: 59.347881}), aff.Transform(f32.Point{X: 419.022949, Y: 58.049965}), aff.Transform(f32.Point{X: 419.569702, Y: 56.638573}))
+		path.CubeTo(aff.Transform(f32.Point{X: 420.116486, Y: 55.227180}), aff.Transform(f32.Point{X: 420.397003, Y: 53.726601}), aff.Transform(f32.Point{X: 420.397003, Y: 52.213001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 185, G: 72, B: 62, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 417.166992, Y: 52.213001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 417.166992, Y: 51.098461}), aff.Transform(f32.Point{X: 416.960449, Y: 49.993515}), aff.Transform(f32.Point{X: 416.557831, Y: 48.954239}))
+		path.CubeTo(aff.Transform(f32.Point{X: 416.155212, Y: 47.914963}), aff.Transform(f32.Point{X: 415.563446, Y: 46.959244}), aff.Transform(f32.Point{X: 414.812592, Y: 46.135593}))
+		path.CubeTo(aff.Transform(f32.Point{X: 414.061737, Y: 45.311939}), aff.Transform(f32.Point{X: 413.164703, Y: 44.634525}), aff.Transform(f32.Point{X: 412.166992, Y: 44.137733}))
+		path.CubeTo(aff.Transform(f32.Point{X: 411.169312, Y: 43.640938}), aff.Transform(f32.Point{X: 410.088135, Y: 43.333317}), aff.Transform(f32.Point{X: 408.978363, Y: 43.230480}))
+		path.CubeTo(aff.Transform(f32.Point{X: 407.868561, Y: 43.127644}), aff.Transform(f32.Point{X: 406.749268, Y: 43.231361}), aff.Transform(f32.Point{X: 405.677277, Y: 43.536369}))
+		path.CubeTo(aff.Transform(f32.Point{X: 404.605286, Y: 43.841377}), aff.Transform(f32.Point{X: 403.599060, Y: 44.342426}), aff.Transform(f32.Point{X: 402.709625, Y: 45.014088}))
+		path.CubeTo(aff.Transform(f32.Point{X: 401.820221, Y: 45.685745}), aff.Transform(f32.Point{X: 401.062927, Y: 46.516457}), aff.Transform(f32.Point{X: 400.476196, Y: 47.464054}))
+		path.CubeTo(aff.Transform(f32.Point{X: 399.889465, Y: 48.411655}), aff.Transform(f32.Point{X: 399.483398, Y: 49.459835}), aff.Transform(f32.Point{X: 399.278595, Y: 50.555397}))
+		path.CubeTo(aff.Transform(f32.Point{X: 399.073792, Y: 51.650955}), aff.Transform(f32.Point{X: 399.073792, Y: 52.775043}), aff.Transform(f32.Point{X: 399.278595, Y: 53.870605}))
+		path.CubeTo(aff.Transform(f32.Point{X: 399.483398, Y: 54.966164}), aff.Transform(f32.Point{X: 399.889465, Y: 56.014347}), aff.Transform(f32.Point{X: 400.476196, Y: 56.961945}))
+		path.CubeTo(aff.Transform(f32.Point{X: 401.062927, Y: 57.909542}), aff.Transform(f32.Point{X: 401.820221, Y: 58.740253}), aff.Transform(f32.Point{X: 402.709625, Y: 59.411915}))
+		path.CubeTo(aff.Transform(f32.Point{X: 403.599060, Y: 60.083572}), aff.Transform(f32.Point{X: 404.605286, Y: 60.584621}), aff.Transform(f32.Point{X: 405.677277, Y: 60.889629}))
+		path.CubeTo(aff.Transform(f32.Point{X: 406.749268, Y: 61.194637}), aff.Transform(f32.Point{X: 407.868561, Y: 61.298355}), aff.Transform(f32.Point{X: 408.978363, Y: 61.195518}))
+		path.CubeTo(aff.Transform(f32.Point{X: 410.088135, Y: 61.092682}), aff.Transform(f32.Point{X: 411.169312, Y: 60.785061}), aff.Transform(f32.Point{X: 412.166992, Y: 60.288269}))
+		path.CubeTo(aff.Transform(f32.Point{X: 413.164703, Y: 59.791477}), aff.Transform(f32.Point{X: 414.061737, Y: 59.114063}), aff.Transform(f32.Point{X: 414.812592, Y: 58.290409}))
+		path.CubeTo(aff.Transform(f32.Point{X: 415.563446, Y: 57.466755}), aff.Transform(f32.Point{X: 416.155212, Y: 56.511036}), aff.Transform(f32.Point{X: 416.557831, Y: 55.471760}))
+		path.CubeTo(aff.Transform(f32.Point{X: 416.960449, Y: 54.432484}), aff.Transform(f32.Point{X: 417.166992, Y: 53.327538}), aff.Transform(f32.Point{X: 417.166992, Y: 52.213001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 223, G: 88, B: 76, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 149.778000, Y: 50.187000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 149.778000, Y: 48.423088}), aff.Transform(f32.Point{X: 149.451111, Y: 46.674351}), aff.Transform(f32.Point{X: 148.813904, Y: 45.029552}))
+		path.CubeTo(aff.Transform(f32.Point{X: 148.176712, Y: 43.384754}), aff.Transform(f32.Point{X: 147.240173, Y: 41.872196}), aff.Transform(f32.Point{X: 146.051834, Y: 40.568645}))
+		path.CubeTo(aff.Transform(f32.Point{X: 144.863495, Y: 39.265099}), aff.Transform(f32.Point{X: 143.443802, Y: 38.192997}), aff.Transform(f32.Point{X: 141.864807, Y: 37.406754}))
+		path.CubeTo(aff.Transform(f32.Point{X: 140.285812, Y: 36.620510}), aff.Transform(f32.Point{X: 138.574707, Y: 36.133656}), aff.Transform(f32.Point{X: 136.818314, Y: 35.970901}))
+		path.CubeTo(aff.Transform(f32.Point{X: 135.061920, Y: 35.808151}), aff.Transform(f32.Point{X: 133.290497, Y: 35.972298}), aff.Transform(f32.Point{X: 131.593918, Y: 36.455017}))
+		path.CubeTo(aff.Transform(f32.Point{X: 129.897339, Y: 36.937733}), aff.Transform(f32.Point{X: 128.304825, Y: 37.730713}), aff.Transform(f32.Point{X: 126.897186, Y: 38.793709}))
+		path.CubeTo(aff.Transform(f32.Point{X: 125.489555, Y: 39.856705}), aff.Transform(f32.Point{X: 124.291031, Y: 41.171417}), aff.Transform(f32.Point{X: 123.362450, Y: 42.671127}))
+		path.CubeTo(aff.Transform(f32.Point{X: 122.433868, Y: 44.170837}), aff.Transform(f32.Point{X: 121.791214, Y: 45.829731}), aff.Transform(f32.Point{X: 121.467094, Y: 47.563606}))
+		path.CubeTo(aff.Transform(f32.Point{X: 121.142975, Y: 49.297485}), aff.Transform(f32.Point{X: 121.142975, Y: 51.076511}), aff.Transform(f32.Point{X: 121.467094, Y: 52.810390}))
+		path.CubeTo(aff.Transform(f32.Point{X: 121.791214, Y: 54.544270}), aff.Transform(f32.Point{X: 122.433868, Y: 56.203163}), aff.Transform(f32.Point{X: 123.362450, Y: 57.702873}))
+		path.CubeTo(aff.Transform(f32.Point{X: 124.291031, Y: 59.202579}), aff.Transform(f32.Point{X: 125.489555, Y: 60.517296}), aff.Transform(f32.Point{X: 126.897186, Y: 61.580292}))
+		path.CubeTo(aff.Transform(f32.Point{X: 128.304825, Y: 62.643288}), aff.Transform(f32.Point{X: 129.897339, Y: 63.436268}), aff.Transform(f32.Point{X: 131.593918, Y: 63.918983}))
+		path.CubeTo(aff.Transform(f32.Point{X: 133.290497, Y: 64.401703}), aff.Transform(f32.Point{X: 135.061920, Y: 64.565849}), aff.Transform(f32.Point{X: 136.818314, Y: 64.403099}))
+		path.CubeTo(aff.Transform(f32.Point{X: 138.574707, Y: 64.240341}), aff.Transform(f32.Point{X: 140.285812, Y: 63.753490}), aff.Transform(f32.Point{X: 141.864807, Y: 62.967247}))
+		path.CubeTo(aff.Transform(f32.Point{X: 143.443802, Y: 62.181004}), aff.Transform(f32.Point{X: 144.863495, Y: 61.108898}), aff.Transform(f32.Point{X: 146.051834, Y: 59.805351}))
+		path.CubeTo(aff.Transform(f32.Point{X: 147.240173, Y: 58.501804}), aff.Transform(f32.Point{X: 148.176712, Y: 56.989246}), aff.Transform(f32.Point{X: 148.813904, Y: 55.344448}))
+		path.CubeTo(aff.Transform(f32.Point{X: 149.451111, Y: 53.699646}), aff.Transform(f32.Point{X: 149.778000, Y: 51.950912}), aff.Transform(f32.Point{X: 149.778000, Y: 50.187000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 185, G: 72, B: 62, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 146.014008, Y: 50.187000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 146.014008, Y: 48.888126}), aff.Transform(f32.Point{X: 145.773285, Y: 47.600430}), aff.Transform(f32.Point{X: 145.304077, Y: 46.389267}))
+		path.CubeTo(aff.Transform(f32.Point{X: 144.834869, Y: 45.178104}), aff.Transform(f32.Point{X: 144.145248, Y: 44.064316}), aff.Transform(f32.Point{X: 143.270203, Y: 43.104439}))
+		path.CubeTo(aff.Transform(f32.Point{X: 142.395157, Y: 42.144558}), aff.Transform(f32.Point{X: 141.349747, Y: 41.355106}), aff.Transform(f32.Point{X: 140.187042, Y: 40.776150}))
+		path.CubeTo(aff.Transform(f32.Point{X: 139.024338, Y: 40.197189}), aff.Transform(f32.Point{X: 137.764343, Y: 39.838692}), aff.Transform(f32.Point{X: 136.471024, Y: 39.718845}))
+		path.CubeTo(aff.Transform(f32.Point{X: 135.177689, Y: 39.599003}), aff.Transform(f32.Point{X: 133.873276, Y: 39.719875}), aff.Transform(f32.Point{X: 132.623978, Y: 40.075329}))
+		path.CubeTo(aff.Transform(f32.Point{X: 131.374695, Y: 40.430782}), aff.Transform(f32.Point{X: 130.202026, Y: 41.014698}), aff.Transform(f32.Point{X: 129.165497, Y: 41.797443}))
+		path.CubeTo(aff.Transform(f32.Point{X: 128.128983, Y: 42.580193}), aff.Transform(f32.Point{X: 127.246437, Y: 43.548294}), aff.Transform(f32.Point{X: 126.562668, Y: 44.652618}))
+		path.CubeTo(aff.Transform(f32.Point{X: 125.878899, Y: 45.756943}), aff.Transform(f32.Point{X: 125.405670, Y: 46.978485}), aff.Transform(f32.Point{X: 125.167007, Y: 48.255241}))
+		path.CubeTo(aff.Transform(f32.Point{X: 124.928337, Y: 49.531998}), aff.Transform(f32.Point{X: 124.928337, Y: 50.842003}), aff.Transform(f32.Point{X: 125.167007, Y: 52.118759}))
+		path.CubeTo(aff.Transform(f32.Point{X: 125.405670, Y: 53.395515}), aff.Transform(f32.Point{X: 125.878899, Y: 54.617058}), aff.Transform(f32.Point{X: 126.562668, Y: 55.721382}))
+		path.CubeTo(aff.Transform(f32.Point{X: 127.246437, Y: 56.825706}), aff.Transform(f32.Point{X: 128.128983, Y: 57.793808}), aff.Transform(f32.Point{X: 129.165497, Y: 58.576553}))
+		path.CubeTo(aff.Transform(f32.Point{X: 130.202026, Y: 59.359303}), aff.Transform(f32.Point{X: 131.374695, Y: 59.943218}), aff.Transform(f32.Point{X: 132.623978, Y: 60.298672}))
+		path.CubeTo(aff.Transform(f32.Point{X: 133.873276, Y: 60.654125}), aff.Transform(f32.Point{X: 135.177689, Y: 60.774998}), aff.Transform(f32.Point{X: 136.471024, Y: 60.655155}))
+		path.CubeTo(aff.Transform(f32.Point{X: 137.764343, Y: 60.535309}), aff.Transform(f32.Point{X: 139.024338, Y: 60.176811}), aff.Transform(f32.Point{X: 140.187042, Y: 59.597851}))
+		path.CubeTo(aff.Transform(f32.Point{X: 141.349747, Y: 59.018894}), aff.Transform(f32.Point{X: 142.395157, Y: 58.229443}), aff.Transform(f32.Point{X: 143.270203, Y: 57.269562}))
+		path.CubeTo(aff.Transform(f32.Point{X: 144.145248, Y: 56.309685}), aff.Transform(f32.Point{X: 144.834869, Y: 55.195896}), aff.Transform(f32.Point{X: 145.304077, Y: 53.984734}))
+		path.CubeTo(aff.Transform(f32.Point{X: 145.773285, Y: 52.773571}), aff.Transform(f32.Point{X: 146.014008, Y: 51.485874}), aff.Transform(f32.Point{X: 146.014008, Y: 50.187000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 223, G: 88, B: 76, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 115.459000, Y: 52.213001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 115.459000, Y: 50.699398}), aff.Transform(f32.Point{X: 115.178490, Y: 49.198822}), aff.Transform(f32.Point{X: 114.631714, Y: 47.787430}))
+		path.CubeTo(aff.Transform(f32.Point{X: 114.084938, Y: 46.376038}), aff.Transform(f32.Point{X: 113.281303, Y: 45.078121}), aff.Transform(f32.Point{X: 112.261597, Y: 43.959553}))
+		path.CubeTo(aff.Transform(f32.Point{X: 111.241890, Y: 42.840988}), aff.Transform(f32.Point{X: 110.023659, Y: 41.921024}), aff.Transform(f32.Point{X: 108.668739, Y: 41.246353}))
+		path.CubeTo(aff.Transform(f32.Point{X: 107.313820, Y: 40.571686}), aff.Transform(f32.Point{X: 105.845528, Y: 40.153919}), aff.Transform(f32.Point{X: 104.338379, Y: 40.014259}))
+		path.CubeTo(aff.Transform(f32.Point{X: 102.831238, Y: 39.874603}), aff.Transform(f32.Point{X: 101.311172, Y: 40.015457}), aff.Transform(f32.Point{X: 99.855354, Y: 40.429672}))
+		path.CubeTo(aff.Transform(f32.Point{X: 98.399536, Y: 40.843891}), aff.Transform(f32.Point{X: 97.033005, Y: 41.524342}), aff.Transform(f32.Point{X: 95.825127, Y: 42.436489}))
+		path.CubeTo(aff.Transform(f32.Point{X: 94.617241, Y: 43.348640}), aff.Transform(f32.Point{X: 93.588799, Y: 44.476788}), aff.Transform(f32.Point{X: 92.791992, Y: 45.763680}))
+		path.CubeTo(aff.Transform(f32.Point{X: 91.995178, Y: 47.050571}), aff.Transform(f32.Point{X: 91.443718, Y: 48.474056}), aff.Transform(f32.Point{X: 91.165596, Y: 49.961884}))
+		path.CubeTo(aff.Transform(f32.Point{X: 90.887474, Y: 51.449715}), aff.Transform(f32.Point{X: 90.887474, Y: 52.976284}), aff.Transform(f32.Point{X: 91.165596, Y: 54.464115}))
+		path.CubeTo(aff.Transform(f32.Point{X: 91.443718, Y: 55.951946}), aff.Transform(f32.Point{X: 91.995178, Y: 57.375431}), aff.Transform(f32.Point{X: 92.791992, Y: 58.662319}))
+		path.CubeTo(aff.Transform(f32.Point{X: 93.588799, Y: 59.949211}), aff.Transform(f32.Point{X: 94.617241, Y: 61.077358}), aff.Transform(f32.Point{X: 95.825127, Y: 61.989510}))
+		path.CubeTo(aff.Transform(f32.Point{X: 97.033005, Y: 62.901657}), aff.Transform(f32.Point{X: 98.399536, Y: 63.582108}), aff.Transform(f32.Point{X: 99.855354, Y: 63.996326}))
+		path.CubeTo(aff.Transform(f32.Point{X: 101.311172, Y: 64.410545}), aff.Transform(f32.Point{X: 102.831238, Y: 64.551399}), aff.Transform(f32.Point{X: 104.338379, Y: 64.411743}))
+		path.CubeTo(aff.Transform(f32.Point{X: 105.845528, Y: 64.272079}), aff.Transform(f32.Point{X: 107.313820, Y: 63.854317}), aff.Transform(f32.Point{X: 108.668739, Y: 63.179646}))
+		path.CubeTo(aff.Transform(f32.Point{X: 110.023659, Y: 62.504974}), aff.Transform(f32.Point{X: 111.241890, Y: 61.585011}), aff.Transform(f32.Point{X: 112.261597, Y: 60.466446}))
+		path.CubeTo(aff.Transform(f32.Point{X: 113.281303, Y: 59.347881}), aff.Transform(f32.Point{X: 114.084938, Y: 58.049965}), aff.Transform(f32.Point{X: 114.631714, Y: 56.638573}))
+		path.CubeTo(aff.Transform(f32.Point{X: 115.178490, Y: 55.227180}), aff.Transform(f32.Point{X: 115.459000, Y: 53.726601}), aff.Transform(f32.Point{X: 115.459000, Y: 52.213001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 185, G: 72, B: 62, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 112.228996, Y: 52.213001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 112.228996, Y: 51.098461}), aff.Transform(f32.Point{X: 112.022453, Y: 49.993515}), aff.Transform(f32.Point{X: 111.619835, Y: 48.954239}))
+		path.CubeTo(aff.Transform(f32.Point{X: 111.217216, Y: 47.914963}), aff.Transform(f32.Point{X: 110.625458, Y: 46.959244}), aff.Transform(f32.Point{X: 109.874596, Y: 46.135593}))
+		path.CubeTo(aff.Transform(f32.Point{X: 109.123741, Y: 45.311939}), aff.Transform(f32.Point{X: 108.226700, Y: 44.634525}), aff.Transform(f32.Point{X: 107.229004, Y: 44.137733}))
+		path.CubeTo(aff.Transform(f32.Point{X: 106.231316, Y: 43.640938}), aff.Transform(f32.Point{X: 105.150139, Y: 43.333317}), aff.Transform(f32.Point{X: 104.040352, Y: 43.230480}))
+		path.CubeTo(aff.Transform(f32.Point{X: 102.930573, Y: 43.127644}), aff.Transform(f32.Point{X: 101.811279, Y: 43.231361}), aff.Transform(f32.Point{X: 100.739288, Y: 43.536369}))
+		path.CubeTo(aff.Transform(f32.Point{X: 99.667297, Y: 43.841377}), aff.Transform(f32.Point{X: 98.661057, Y: 44.342426}), aff.Transform(f32.Point{X: 97.771629, Y: 45.014088}))
+		path.CubeTo(aff.Transform(f32.Point{X: 96.882210, Y: 45.685745}), aff.Transform(f32.Point{X: 96.124916, Y: 46.516457}), aff.Transform(f32.Point{X: 95.538193, Y: 47.464054}))
+		path.CubeTo(aff.Transform(f32.Point{X: 94.951462, Y: 48.411655}), aff.Transform(f32.Point{X: 94.545395, Y: 49.459835}), aff.Transform(f32.Point{X: 94.340599, Y: 50.555397}))
+		path.CubeTo(aff.Transform(f32.Point{X: 94.135803, Y: 51.650955}), aff.Transform(f32.Point{X: 94.135803, Y: 52.775043}), aff.Transform(f32.Point{X: 94.340599, Y: 53.870605}))
+		path.CubeTo(aff.Transform(f32.Point{X: 94.545395, Y: 54.966164}), aff.Transform(f32.Point{X: 94.951462, Y: 56.014347}), aff.Transform(f32.Point{X: 95.538193, Y: 56.961945}))
+		path.CubeTo(aff.Transform(f32.Point{X: 96.124916, Y: 57.909542}), aff.Transform(f32.Point{X: 96.882210, Y: 58.740253}), aff.Transform(f32.Point{X: 97.771629, Y: 59.411915}))
+		path.CubeTo(aff.Transform(f32.Point{X: 98.661057, Y: 60.083572}), aff.Transform(f32.Point{X: 99.667297, Y: 60.584621}), aff.Transform(f32.Point{X: 100.739288, Y: 60.889629}))
+		path.CubeTo(aff.Transform(f32.Point{X: 101.811279, Y: 61.194637}), aff.Transform(f32.Point{X: 102.930573, Y: 61.298355}), aff.Transform(f32.Point{X: 104.040352, Y: 61.195518}))
+		path.CubeTo(aff.Transform(f32.Point{X: 105.150139, Y: 61.092682}), aff.Transform(f32.Point{X: 106.231316, Y: 60.785061}), aff.Transform(f32.Point{X: 107.229004, Y: 60.288269}))
+		path.CubeTo(aff.Transform(f32.Point{X: 108.226700, Y: 59.791477}), aff.Transform(f32.Point{X: 109.123741, Y: 59.114063}), aff.Transform(f32.Point{X: 109.874596, Y: 58.290409}))
+		path.CubeTo(aff.Transform(f32.Point{X: 110.625458, Y: 57.466755}), aff.Transform(f32.Point{X: 111.217216, Y: 56.511036}), aff.Transform(f32.Point{X: 111.619835, Y: 55.471760}))
+		path.CubeTo(aff.Transform(f32.Point{X: 112.022453, Y: 54.432484}), aff.Transform(f32.Point{X: 112.228996, Y: 53.327538}), aff.Transform(f32.Point{X: 112.228996, Y: 52.213001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 223, G: 88, B: 76, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 441.635010, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 350.144989, Y: 73.047997}))
+		path.LineTo(aff.Transform(f32.Point{X: 349.171997, Y: 78.445999}))
+		path.LineTo(aff.Transform(f32.Point{X: 438.864990, Y: 78.445999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 165.153000, Y: 22.802999}))
+		path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 22.802999}))
+		path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 64.328003}))
+		path.LineTo(aff.Transform(f32.Point{X: 165.153000, Y: 64.328003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 168.552994, Y: 52.187000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 169.699005, Y: 52.825001}), aff.Transform(f32.Point{X: 171.653000, Y: 53.292000}), aff.Transform(f32.Point{X: 173.266006, Y: 53.292000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 175.899002, Y: 53.292000}), aff.Transform(f32.Point{X: 177.173996, Y: 51.931999}), aff.Transform(f32.Point{X: 177.173996, Y: 50.063000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 177.173996, Y: 47.981998}), aff.Transform(f32.Point{X: 175.899994, Y: 46.963001}), aff.Transform(f32.Point{X: 173.479004, Y: 45.390999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 169.572006, Y: 43.013000}), aff.Transform(f32.Point{X: 168.085007, Y: 39.995998}), aff.Transform(f32.Point{X: 168.085007, Y: 37.408001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 168.085007, Y: 32.819000}), aff.Transform(f32.Point{X: 171.143997, Y: 28.997999}), aff.Transform(f32.Point{X: 177.130997, Y: 28.997999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 179.000000, Y: 28.997999}), aff.Transform(f32.Point{X: 180.783997, Y: 29.509001}), aff.Transform(f32.Point{X: 181.634003, Y: 30.017000}))
+		path.LineTo(aff.Transform(f32.Point{X: 180.740997, Y: 34.817001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 179.934006, Y: 34.306999}), aff.Transform(f32.Point{X: 178.701996, Y: 33.840000}), aff.Transform(f32.Point{X: 177.089005, Y: 33.840000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 174.710007, Y: 33.840000}), aff.Transform(f32.Point{X: 173.563995, Y: 35.283001}), aff.Transform(f32.Point{X: 173.563995, Y: 36.813000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 173.563995, Y: 38.511002}), aff.Transform(f32.Point{X: 174.412994, Y: 39.403999}), aff.Transform(f32.Point{X: 177.514008, Y: 41.271999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 181.292999, Y: 43.566002}), aff.Transform(f32.Point{X: 182.695999, Y: 46.453999}), aff.Transform(f32.Point{X: 182.695999, Y: 49.469002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 182.695999, Y: 54.693001}), aff.Transform(f32.Point{X: 178.830994, Y: 58.132999}), aff.Transform(f32.Point{X: 173.225006, Y: 58.132999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 170.932007, Y: 58.132999}), aff.Transform(f32.Point{X: 168.681000, Y: 57.537998}), aff.Transform(f32.Point{X: 167.746002, Y: 56.987000}))
+		path.LineTo(aff.Transform(f32.Point{X: 168.552994, Y: 52.187000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 199.645996, Y: 57.452999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 198.839005, Y: 57.835999}), aff.Transform(f32.Point{X: 197.268005, Y: 58.132999}), aff.Transform(f32.Point{X: 195.313995, Y: 58.132999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 188.009003, Y: 58.132999}), aff.Transform(f32.Point{X: 184.526001, Y: 52.102001}), aff.Transform(f32.Point{X: 184.526001, Y: 43.990002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 184.526001, Y: 33.203999}), aff.Transform(f32.Point{X: 190.514999, Y: 28.997999}), aff.Transform(f32.Point{X: 196.076996, Y: 28.997999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 198.031998, Y: 28.997999}), aff.Transform(f32.Point{X: 199.389999, Y: 29.379000}), aff.Transform(f32.Point{X: 199.942993, Y: 29.761999}))
+		path.LineTo(aff.Transform(f32.Point{X: 199.009003, Y: 34.433998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 198.371994, Y: 34.134998}), aff.Transform(f32.Point{X: 197.649994, Y: 33.882000}), aff.Transform(f32.Point{X: 196.417007, Y: 33.882000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 193.274994, Y: 33.882000}), aff.Transform(f32.Point{X: 190.429993, Y: 36.601002}), aff.Transform(f32.Point{X: 190.429993, Y: 43.693001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 190.429993, Y: 50.530998}), aff.Transform(f32.Point{X: 193.020004, Y: 53.208000}), aff.Transform(f32.Point{X: 196.417007, Y: 53.208000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 197.352997, Y: 53.208000}), aff.Transform(f32.Point{X: 198.414001, Y: 52.997002}), aff.Transform(f32.Point{X: 199.093994, Y: 52.783001}))
+		path.LineTo(aff.Transform(f32.Point{X: 199.645996, Y: 57.452999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 208.270996, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 40.721001}))
+		path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 40.721001}))
+		path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 219.653000, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 219.653000, Y: 57.877998}))
+		path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 57.877998}))
+		path.LineTo(aff.Transform(f32.Point{X: 214.089005, Y: 45.901001}))
+		path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 45.901001}))
+		path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 57.877998}))
+		path.LineTo(aff.Transform(f32.Point{X: 202.707001, Y: 57.877998}))
+		path.LineTo(aff.Transform(f32.Point{X: 202.707001, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 208.270996, Y: 29.252001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 241.488998, Y: 43.056000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 241.488998, Y: 53.842999}), aff.Transform(f32.Point{X: 237.667007, Y: 58.219002}), aff.Transform(f32.Point{X: 232.229996, Y: 58.219002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 225.731003, Y: 58.219002}), aff.Transform(f32.Point{X: 223.182999, Y: 51.338001}), aff.Transform(f32.Point{X: 223.182999, Y: 43.481998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 223.182999, Y: 35.667000}), aff.Transform(f32.Point{X: 226.283997, Y: 28.913000}), aff.Transform(f32.Point{X: 232.610992, Y: 28.913000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 239.533005, Y: 28.912001}), aff.Transform(f32.Point{X: 241.488998, Y: 36.515999}), aff.Transform(f32.Point{X: 241.488998, Y: 43.056000}))
+		path.Close()
+		path.MoveTo(aff.Transform(f32.Point{X: 228.957993, Y: 43.564999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 228.957993, Y: 50.063000}), aff.Transform(f32.Point{X: 230.188995, Y: 53.375999}), aff.Transform(f32.Point{X: 232.440002, Y: 53.375999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 234.776001, Y: 53.375999}), aff.Transform(f32.Point{X: 235.667999, Y: 49.129002}), aff.Transform(f32.Point{X: 235.667999, Y: 43.311001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 235.667999, Y: 38.299000}), aff.Transform(f32.Point{X: 234.904007, Y: 33.755001}), aff.Transform(f32.Point{X: 232.397003, Y: 33.755001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 230.190994, Y: 33.755001}), aff.Transform(f32.Point{X: 228.957993, Y: 37.408001}), aff.Transform(f32.Point{X: 228.957993, Y: 43.564999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 261.920990, Y: 43.056000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 261.920990, Y: 53.842999}), aff.Transform(f32.Point{X: 258.097992, Y: 58.219002}), aff.Transform(f32.Point{X: 252.662994, Y: 58.219002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 246.164001, Y: 58.219002}), aff.Transform(f32.Point{X: 243.615997, Y: 51.338001}), aff.Transform(f32.Point{X: 243.615997, Y: 43.481998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 243.615997, Y: 35.667000}), aff.Transform(f32.Point{X: 246.716995, Y: 28.913000}), aff.Transform(f32.Point{X: 253.044006, Y: 28.913000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 259.967010, Y: 28.912001}), aff.Transform(f32.Point{X: 261.920990, Y: 36.515999}), aff.Transform(f32.Point{X: 261.920990, Y: 43.056000}))
+		path.Close()
+		path.MoveTo(aff.Transform(f32.Point{X: 249.391006, Y: 43.564999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 249.391006, Y: 50.063000}), aff.Transform(f32.Point{X: 250.621994, Y: 53.375999}), aff.Transform(f32.Point{X: 252.873993, Y: 53.375999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 255.210007, Y: 53.375999}), aff.Transform(f32.Point{X: 256.101990, Y: 49.129002}), aff.Transform(f32.Point{X: 256.101990, Y: 43.311001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 256.101990, Y: 38.299000}), aff.Transform(f32.Point{X: 255.337997, Y: 33.755001}), aff.Transform(f32.Point{X: 252.830994, Y: 33.755001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 250.621994, Y: 33.755001}), aff.Transform(f32.Point{X: 249.391006, Y: 37.408001}), aff.Transform(f32.Point{X: 249.391006, Y: 43.564999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 265.447998, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 271.013000, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 271.013000, Y: 53.162998}))
+		path.LineTo(aff.Transform(f32.Point{X: 278.359985, Y: 53.162998}))
+		path.LineTo(aff.Transform(f32.Point{X: 278.359985, Y: 57.877998}))
+		path.LineTo(aff.Transform(f32.Point{X: 265.447998, Y: 57.877998}))
+		path.LineTo(aff.Transform(f32.Point{X: 265.447998, Y: 29.252001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 288.345001, Y: 29.677999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 290.042999, Y: 29.295000}), aff.Transform(f32.Point{X: 292.252014, Y: 29.124001}), aff.Transform(f32.Point{X: 294.671997, Y: 29.124001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 297.348999, Y: 29.124001}), aff.Transform(f32.Point{X: 299.938995, Y: 29.462999}), aff.Transform(f32.Point{X: 301.936005, Y: 31.163000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 303.466003, Y: 32.438000}), aff.Transform(f32.Point{X: 304.101990, Y: 34.348999}), aff.Transform(f32.Point{X: 304.101990, Y: 36.346001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 304.101990, Y: 38.894001}), aff.Transform(f32.Point{X: 302.786987, Y: 41.356998}), aff.Transform(f32.Point{X: 299.940002, Y: 42.589001}))
+		path.LineTo(aff.Transform(f32.Point{X: 299.940002, Y: 42.757999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 303.252014, Y: 43.693001}), aff.Transform(f32.Point{X: 305.036011, Y: 46.452999}), aff.Transform(f32.Point{X: 305.036011, Y: 49.597000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 305.036011, Y: 51.974998}), aff.Transform(f32.Point{X: 304.272003, Y: 53.800999}), aff.Transform(f32.Point{X: 302.912994, Y: 55.202000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 301.214996, Y: 57.070000}), aff.Transform(f32.Point{X: 298.325989, Y: 58.091000}), aff.Transform(f32.Point{X: 293.526001, Y: 58.091000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 291.403015, Y: 58.091000}), aff.Transform(f32.Point{X: 289.619995, Y: 57.963001}), aff.Transform(f32.Point{X: 288.345001, Y: 57.793999}))
+		path.LineTo(aff.Transform(f32.Point{X: 288.345001, Y: 29.677999}))
+		path.LineTo(aff.Transform(f32.Point{X: 288.345001, Y: 29.677999}))
+		path.Close()
+		path.MoveTo(aff.Transform(f32.Point{X: 293.911011, Y: 41.018002}))
+		path.LineTo(aff.Transform(f32.Point{X: 295.097992, Y: 41.018002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 297.095001, Y: 41.018002}), aff.Transform(f32.Point{X: 298.622986, Y: 39.320000}), aff.Transform(f32.Point{X: 298.622986, Y: 36.984001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 298.622986, Y: 34.901001}), aff.Transform(f32.Point{X: 297.645996, Y: 33.289001}), aff.Transform(f32.Point{X: 295.351990, Y: 33.289001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 294.757996, Y: 33.289001}), aff.Transform(f32.Point{X: 294.247986, Y: 33.331001}), aff.Transform(f32.Point{X: 293.911011, Y: 33.458000}))
+		path.LineTo(aff.Transform(f32.Point{X: 293.911011, Y: 41.018002}))
+		path.Close()
+		path.MoveTo(aff.Transform(f32.Point{X: 293.911011, Y: 53.716999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 294.247986, Y: 53.800999}), aff.Transform(f32.Point{X: 294.631012, Y: 53.800999}), aff.Transform(f32.Point{X: 295.140991, Y: 53.800999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 297.390991, Y: 53.800999}), aff.Transform(f32.Point{X: 299.260986, Y: 52.442001}), aff.Transform(f32.Point{X: 299.260986, Y: 49.512001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 299.260986, Y: 46.665001}), aff.Transform(f32.Point{X: 297.307007, Y: 45.178001}), aff.Transform(f32.Point{X: 295.096985, Y: 45.137001}))
+		path.LineTo(aff.Transform(f32.Point{X: 293.910004, Y: 45.137001}))
+		path.LineTo(aff.Transform(f32.Point{X: 293.911011, Y: 53.716999}))
+		path.LineTo(aff.Transform(f32.Point{X: 293.911011, Y: 53.716999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 314.256989, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 314.256989, Y: 47.855000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 314.256989, Y: 52.061001}), aff.Transform(f32.Point{X: 315.529999, Y: 53.334000}), aff.Transform(f32.Point{X: 316.973999, Y: 53.334000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 318.588013, Y: 53.334000}), aff.Transform(f32.Point{X: 319.734985, Y: 52.187000}), aff.Transform(f32.Point{X: 319.734985, Y: 47.855000}))
+		path.LineTo(aff.Transform(f32.Point{X: 319.734985, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 325.299988, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 325.299988, Y: 46.877998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 325.299988, Y: 54.226002}), aff.Transform(f32.Point{X: 322.536987, Y: 58.219002}), aff.Transform(f32.Point{X: 317.015991, Y: 58.219002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 311.028015, Y: 58.219002}), aff.Transform(f32.Point{X: 308.691986, Y: 54.098999}), aff.Transform(f32.Point{X: 308.691986, Y: 46.919998}))
+		path.LineTo(aff.Transform(f32.Point{X: 308.691986, Y: 29.252001}))
+		path.LineTo(aff.Transform(f32.Point{X: 314.256989, Y: 29.252001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 329.467010, Y: 52.187000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 330.613007, Y: 52.825001}), aff.Transform(f32.Point{X: 332.566986, Y: 53.292000}), aff.Transform(f32.Point{X: 334.182007, Y: 53.292000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 336.815002, Y: 53.292000}), aff.Transform(f32.Point{X: 338.088013, Y: 51.931999}), aff.Transform(f32.Point{X: 338.088013, Y: 50.063000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 338.088013, Y: 47.981998}), aff.Transform(f32.Point{X: 336.815002, Y: 46.963001}), aff.Transform(f32.Point{X: 334.393005, Y: 45.390999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 330.484985, Y: 43.013000}), aff.Transform(f32.Point{X: 328.997986, Y: 39.995998}), aff.Transform(f32.Point{X: 328.997986, Y: 37.408001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 328.997986, Y: 32.819000}), aff.Transform(f32.Point{X: 332.057007, Y: 28.997999}), aff.Transform(f32.Point{X: 338.045013, Y: 28.997999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 339.914001, Y: 28.997999}), aff.Transform(f32.Point{X: 341.697998, Y: 29.509001}), aff.Transform(f32.Point{X: 342.548004, Y: 30.017000}))
+		path.LineTo(aff.Transform(f32.Point{X: 341.656006, Y: 34.817001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 340.848999, Y: 34.306999}), aff.Transform(f32.Point{X: 339.617004, Y: 33.840000}), aff.Transform(f32.Point{X: 338.002991, Y: 33.840000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 335.625000, Y: 33.840000}), aff.Transform(f32.Point{X: 334.479004, Y: 35.283001}), aff.Transform(f32.Point{X: 334.479004, Y: 36.813000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 334.479004, Y: 38.511002}), aff.Transform(f32.Point{X: 335.329010, Y: 39.403999}), aff.Transform(f32.Point{X: 338.428009, Y: 41.271999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 342.207001, Y: 43.566002}), aff.Transform(f32.Point{X: 343.609985, Y: 46.453999}), aff.Transform(f32.Point{X: 343.609985, Y: 49.469002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 343.609985, Y: 54.693001}), aff.Transform(f32.Point{X: 339.744995, Y: 58.132999}), aff.Transform(f32.Point{X: 334.138000, Y: 58.132999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 331.843994, Y: 58.132999}), aff.Transform(f32.Point{X: 329.592987, Y: 57.537998}), aff.Transform(f32.Point{X: 328.660004, Y: 56.987000}))
+		path.LineTo(aff.Transform(f32.Point{X: 329.467010, Y: 52.187000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 165.153000, Y: 62.251999}))
+		path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 62.251999}))
+		path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 64.328003}))
+		path.LineTo(aff.Transform(f32.Point{X: 165.153000, Y: 64.328003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 165.153000, Y: 22.802999}))
+		path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 22.802999}))
+		path.LineTo(aff.Transform(f32.Point{X: 346.200989, Y: 24.879000}))
+		path.LineTo(aff.Transform(f32.Point{X: 165.153000, Y: 24.879000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 264.181000, Y: 10.265000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 264.181000, Y: 12.698000}), aff.Transform(f32.Point{X: 262.209015, Y: 14.670000}), aff.Transform(f32.Point{X: 259.774994, Y: 14.670000}))
+		path.LineTo(aff.Transform(f32.Point{X: 251.578995, Y: 14.670000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 249.145996, Y: 14.670000}), aff.Transform(f32.Point{X: 247.173996, Y: 12.697000}), aff.Transform(f32.Point{X: 247.173996, Y: 10.265000}))
+		path.LineTo(aff.Transform(f32.Point{X: 247.173996, Y: 10.265000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 247.173996, Y: 7.831000}), aff.Transform(f32.Point{X: 249.147003, Y: 5.860000}), aff.Transform(f32.Point{X: 251.578995, Y: 5.860000}))
+		path.LineTo(aff.Transform(f32.Point{X: 259.774994, Y: 5.860000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 262.208008, Y: 5.859000}), aff.Transform(f32.Point{X: 264.181000, Y: 7.831000}), aff.Transform(f32.Point{X: 264.181000, Y: 10.265000}))
+		path.LineTo(aff.Transform(f32.Point{X: 264.181000, Y: 10.265000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 404.574005, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 255.677002, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 106.780998, Y: 198.867996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 106.780998, Y: 198.867996}), aff.Transform(f32.Point{X: 76.663002, Y: 202.841995}), aff.Transform(f32.Point{X: 92.417000, Y: 224.154999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 108.169998, Y: 245.468994}), aff.Transform(f32.Point{X: 124.850998, Y: 267.709015}), aff.Transform(f32.Point{X: 148.018005, Y: 267.709015}))
+		path.CubeTo(aff.Transform(f32.Point{X: 171.184998, Y: 267.709015}), aff.Transform(f32.Point{X: 255.679001, Y: 267.709015}), aff.Transform(f32.Point{X: 255.679001, Y: 267.709015}))
+		path.CubeTo(aff.Transform(f32.Point{X: 255.679001, Y: 267.709015}), aff.Transform(f32.Point{X: 340.171997, Y: 267.709015}), aff.Transform(f32.Point{X: 363.338013, Y: 267.709015}))
+		path.CubeTo(aff.Transform(f32.Point{X: 386.506012, Y: 267.709015}), aff.Transform(f32.Point{X: 403.186005, Y: 245.470001}), aff.Transform(f32.Point{X: 418.940002, Y: 224.154999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 434.691010, Y: 202.841995}), aff.Transform(f32.Point{X: 404.574005, Y: 198.867996}), aff.Transform(f32.Point{X: 404.574005, Y: 198.867996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 365.951996, Y: 359.601013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 365.951996, Y: 366.938995}), aff.Transform(f32.Point{X: 360.002991, Y: 372.888000}), aff.Transform(f32.Point{X: 352.664001, Y: 372.888000}))
+		path.LineTo(aff.Transform(f32.Point{X: 158.688995, Y: 372.888000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 151.350998, Y: 372.888000}), aff.Transform(f32.Point{X: 145.401001, Y: 366.938995}), aff.Transform(f32.Point{X: 145.401001, Y: 359.601013}))
+		path.LineTo(aff.Transform(f32.Point{X: 145.401001, Y: 286.093994}))
+		path.CubeTo(aff.Transform(f32.Point{X: 145.401001, Y: 278.756012}), aff.Transform(f32.Point{X: 151.350998, Y: 272.806000}), aff.Transform(f32.Point{X: 158.688995, Y: 272.806000}))
+		path.LineTo(aff.Transform(f32.Point{X: 352.664001, Y: 272.806000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 360.002991, Y: 272.806000}), aff.Transform(f32.Point{X: 365.951996, Y: 278.756012}), aff.Transform(f32.Point{X: 365.951996, Y: 286.093994}))
+		path.LineTo(aff.Transform(f32.Point{X: 365.951996, Y: 359.601013}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 289.131989}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 289.131989}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 295.398010}))
+		path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 295.398010}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 304.423004}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 304.423004}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 310.688995}))
+		path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 310.688995}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 319.713989}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 319.713989}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 325.980011}))
+		path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 325.980011}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 335.005005}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 335.005005}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 341.272003}))
+		path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 341.272003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 221.828003, Y: 350.295990}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 350.295990}))
+		path.LineTo(aff.Transform(f32.Point{X: 289.523010, Y: 356.562988}))
+		path.LineTo(aff.Transform(f32.Point{X: 221.828003, Y: 356.562988}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 289.131989}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 289.131989}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 295.398010}))
+		path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 295.398010}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 304.423004}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 304.423004}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 310.688995}))
+		path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 310.688995}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 319.713989}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 319.713989}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 325.980011}))
+		path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 325.980011}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 335.005005}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 335.005005}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 341.272003}))
+		path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 341.272003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 168.292007, Y: 350.295990}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 350.295990}))
+		path.LineTo(aff.Transform(f32.Point{X: 206.473007, Y: 356.562988}))
+		path.LineTo(aff.Transform(f32.Point{X: 168.292007, Y: 356.562988}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 289.131989}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 289.131989}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 295.398010}))
+		path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 295.398010}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 304.423004}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 304.423004}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 310.688995}))
+		path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 310.688995}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 319.713989}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 319.713989}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 325.980011}))
+		path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 325.980011}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 335.005005}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 335.005005}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 341.272003}))
+		path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 341.272003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 304.877014, Y: 350.295990}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 350.295990}))
+		path.LineTo(aff.Transform(f32.Point{X: 343.058014, Y: 356.562988}))
+		path.LineTo(aff.Transform(f32.Point{X: 304.877014, Y: 356.562988}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 242, G: 182, B: 67, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 81.759003, Y: 226.472000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 81.759003, Y: 226.472000}), aff.Transform(f32.Point{X: 89.635002, Y: 240.835007}), aff.Transform(f32.Point{X: 102.146004, Y: 251.029999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 114.656998, Y: 261.222992}), aff.Transform(f32.Point{X: 128.093002, Y: 269.097992}), aff.Transform(f32.Point{X: 128.093002, Y: 288.559998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 128.093002, Y: 308.020996}), aff.Transform(f32.Point{X: 128.093002, Y: 372.888000}), aff.Transform(f32.Point{X: 128.093002, Y: 372.888000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 128.093002, Y: 372.888000}), aff.Transform(f32.Point{X: 125.313004, Y: 344.623993}), aff.Transform(f32.Point{X: 116.045998, Y: 320.531006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 106.778999, Y: 296.436005}), aff.Transform(f32.Point{X: 98.440002, Y: 289.947998}), aff.Transform(f32.Point{X: 81.759003, Y: 284.389008}))
+		path.LineTo(aff.Transform(f32.Point{X: 81.759003, Y: 226.472000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}), aff.Transform(f32.Point{X: 421.714996, Y: 240.835007}), aff.Transform(f32.Point{X: 409.204010, Y: 251.029999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 396.691986, Y: 261.222992}), aff.Transform(f32.Point{X: 383.256989, Y: 269.097992}), aff.Transform(f32.Point{X: 383.256989, Y: 288.559998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 383.256989, Y: 308.020996}), aff.Transform(f32.Point{X: 383.256989, Y: 372.888000}), aff.Transform(f32.Point{X: 383.256989, Y: 372.888000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 383.256989, Y: 372.888000}), aff.Transform(f32.Point{X: 386.037994, Y: 344.623993}), aff.Transform(f32.Point{X: 395.304993, Y: 320.531006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 404.571991, Y: 296.436005}), aff.Transform(f32.Point{X: 412.910004, Y: 289.947998}), aff.Transform(f32.Point{X: 429.592010, Y: 284.389008}))
+		path.LineTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}))
+		path.LineTo(aff.Transform(f32.Point{X: 429.592010, Y: 226.472000}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 84.077003, Y: 218.595001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 84.077003, Y: 216.591400}), aff.Transform(f32.Point{X: 83.705688, Y: 214.605042}), aff.Transform(f32.Point{X: 82.981903, Y: 212.736740}))
+		path.CubeTo(aff.Transform(f32.Point{X: 82.258118, Y: 210.868439}), aff.Transform(f32.Point{X: 81.194321, Y: 209.150360}), aff.Transform(f32.Point{X: 79.844505, Y: 207.669678}))
+		path.CubeTo(aff.Transform(f32.Point{X: 78.494690, Y: 206.188995}), aff.Transform(f32.Point{X: 76.882088, Y: 204.971222}), aff.Transform(f32.Point{X: 75.088539, Y: 204.078140}))
+		path.CubeTo(aff.Transform(f32.Point{X: 73.294991, Y: 203.185059}), aff.Transform(f32.Point{X: 71.351364, Y: 202.632050}), aff.Transform(f32.Point{X: 69.356316, Y: 202.447174}))
+		path.CubeTo(aff.Transform(f32.Point{X: 67.361267, Y: 202.262314}), aff.Transform(f32.Point{X: 65.349121, Y: 202.448761}), aff.Transform(f32.Point{X: 63.422009, Y: 202.997070}))
+		path.CubeTo(aff.Transform(f32.Point{X: 61.494896, Y: 203.545380}), aff.Transform(f32.Point{X: 59.685982, Y: 204.446121}), aff.Transform(f32.Point{X: 58.087074, Y: 205.653549}))
+		path.CubeTo(aff.Transform(f32.Point{X: 56.488167, Y: 206.860992}), aff.Transform(f32.Point{X: 55.126789, Y: 208.354355}), aff.Transform(f32.Point{X: 54.072029, Y: 210.057846}))
+		path.CubeTo(aff.Transform(f32.Point{X: 53.017269, Y: 211.761337}), aff.Transform(f32.Point{X: 52.287285, Y: 213.645645}), aff.Transform(f32.Point{X: 51.919125, Y: 215.615128}))
+		path.CubeTo(aff.Transform(f32.Point{X: 51.550964, Y: 217.584610}), aff.Transform(f32.Point{X: 51.550964, Y: 219.605377}), aff.Transform(f32.Point{X: 51.919125, Y: 221.574860}))
+		path.CubeTo(aff.Transform(f32.Point{X: 52.287285, Y: 223.544342}), aff.Transform(f32.Point{X: 53.017269, Y: 225.428650}), aff.Transform(f32.Point{X: 54.072029, Y: 227.132156}))
+		path.CubeTo(aff.Transform(f32.Point{X: 55.126789, Y: 228.835648}), aff.Transform(f32.Point{X: 56.488167, Y: 230.329010}), aff.Transform(f32.Point{X: 58.087074, Y: 231.536438}))
+		path.CubeTo(aff.Transform(f32.Point{X: 59.685982, Y: 232.743881}), aff.Transform(f32.Point{X: 61.494896, Y: 233.644623}), aff.Transform(f32.Point{X: 63.422009, Y: 234.192932}))
+		path.CubeTo(aff.Transform(f32.Point{X: 65.349121, Y: 234.741241}), aff.Transform(f32.Point{X: 67.361267, Y: 234.927689}), aff.Transform(f32.Point{X: 69.356316, Y: 234.742828}))
+		path.CubeTo(aff.Transform(f32.Point{X: 71.351364, Y: 234.557953}), aff.Transform(f32.Point{X: 73.294991, Y: 234.004944}), aff.Transform(f32.Point{X: 75.088539, Y: 233.111862}))
+		path.CubeTo(aff.Transform(f32.Point{X: 76.882088, Y: 232.218781}), aff.Transform(f32.Point{X: 78.494690, Y: 231.000992}), aff.Transform(f32.Point{X: 79.844505, Y: 229.520325}))
+		path.CubeTo(aff.Transform(f32.Point{X: 81.194321, Y: 228.039642}), aff.Transform(f32.Point{X: 82.258118, Y: 226.321548}), aff.Transform(f32.Point{X: 82.981903, Y: 224.453262}))
+		path.CubeTo(aff.Transform(f32.Point{X: 83.705688, Y: 222.584961}), aff.Transform(f32.Point{X: 84.077003, Y: 220.598602}), aff.Transform(f32.Point{X: 84.077003, Y: 218.595001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 235, G: 137, B: 35, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 67.860001, Y: 234.811996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 76.816002, Y: 234.811996}), aff.Transform(f32.Point{X: 84.077003, Y: 227.550995}), aff.Transform(f32.Point{X: 84.077003, Y: 218.593994}))
+		path.CubeTo(aff.Transform(f32.Point{X: 84.077003, Y: 209.636993}), aff.Transform(f32.Point{X: 76.816002, Y: 202.376999}), aff.Transform(f32.Point{X: 67.860001, Y: 202.376999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 61.584999, Y: 202.376999}), aff.Transform(f32.Point{X: 56.141998, Y: 205.942001}), aff.Transform(f32.Point{X: 53.445000, Y: 211.154999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 53.445000, Y: 211.154999}), aff.Transform(f32.Point{X: 66.068001, Y: 198.617004}), aff.Transform(f32.Point{X: 76.639999, Y: 212.709000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 87.853996, Y: 227.658997}), aff.Transform(f32.Point{X: 67.860001, Y: 234.811996}), aff.Transform(f32.Point{X: 67.860001, Y: 234.811996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 210, G: 114, B: 40, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 459.708008, Y: 218.595001}))
+		path.CubeTo(aff.Transform(f32.Point{X: 459.708008, Y: 216.591400}), aff.Transform(f32.Point{X: 459.336700, Y: 214.605042}), aff.Transform(f32.Point{X: 458.612915, Y: 212.736740}))
+		path.CubeTo(aff.Transform(f32.Point{X: 457.889130, Y: 210.868439}), aff.Transform(f32.Point{X: 456.825317, Y: 209.150360}), aff.Transform(f32.Point{X: 455.475494, Y: 207.669678}))
+		path.CubeTo(aff.Transform(f32.Point{X: 454.125702, Y: 206.188995}), aff.Transform(f32.Point{X: 452.513092, Y: 204.971222}), aff.Transform(f32.Point{X: 450.719543, Y: 204.078140}))
+		path.CubeTo(aff.Transform(f32.Point{X: 448.925995, Y: 203.185059}), aff.Transform(f32.Point{X: 446.982361, Y: 202.632050}), aff.Transform(f32.Point{X: 444.987305, Y: 202.447174}))
+		path.CubeTo(aff.Transform(f32.Point{X: 442.992279, Y: 202.262314}), aff.Transform(f32.Point{X: 440.980133, Y: 202.448761}), aff.Transform(f32.Point{X: 439.053009, Y: 202.997070}))
+		path.CubeTo(aff.Transform(f32.Point{X: 437.125885, Y: 203.545380}), aff.Transform(f32.Point{X: 435.316986, Y: 204.446121}), aff.Transform(f32.Point{X: 433.718079, Y: 205.653549}))
+		path.CubeTo(aff.Transform(f32.Point{X: 432.119171, Y: 206.860992}), aff.Transform(f32.Point{X: 430.757782, Y: 208.354355}), aff.Transform(f32.Point{X: 429.703033, Y: 210.057846}))
+		path.CubeTo(aff.Transform(f32.Point{X: 428.648285, Y: 211.761337}), aff.Transform(f32.Point{X: 427.918274, Y: 213.645645}), aff.Transform(f32.Point{X: 427.550140, Y: 215.615128}))
+		path.CubeTo(aff.Transform(f32.Point{X: 427.181976, Y: 217.584610}), aff.Transform(f32.Point{X: 427.181976, Y: 219.605377}), aff.Transform(f32.Point{X: 427.550140, Y: 221.574860}))
+		path.CubeTo(aff.Transform(f32.Point{X: 427.918274, Y: 223.544342}), aff.Transform(f32.Point{X: 428.648285, Y: 225.428650}), aff.Transform(f32.Point{X: 429.703033, Y: 227.132156}))
+		path.CubeTo(aff.Transform(f32.Point{X: 430.757782, Y: 228.835648}), aff.Transform(f32.Point{X: 432.119171, Y: 230.329010}), aff.Transform(f32.Point{X: 433.718079, Y: 231.536438}))
+		path.CubeTo(aff.Transform(f32.Point{X: 435.316986, Y: 232.743881}), aff.Transform(f32.Point{X: 437.125885, Y: 233.644623}), aff.Transform(f32.Point{X: 439.053009, Y: 234.192932}))
+		path.CubeTo(aff.Transform(f32.Point{X: 440.980133, Y: 234.741241}), aff.Transform(f32.Point{X: 442.992279, Y: 234.927689}), aff.Transform(f32.Point{X: 444.987305, Y: 234.742828}))
+		path.CubeTo(aff.Transform(f32.Point{X: 446.982361, Y: 234.557953}), aff.Transform(f32.Point{X: 448.925995, Y: 234.004944}), aff.Transform(f32.Point{X: 450.719543, Y: 233.111862}))
+		path.CubeTo(aff.Transform(f32.Point{X: 452.513092, Y: 232.218781}), aff.Transform(f32.Point{X: 454.125702, Y: 231.000992}), aff.Transform(f32.Point{X: 455.475494, Y: 229.520325}))
+		path.CubeTo(aff.Transform(f32.Point{X: 456.825317, Y: 228.039642}), aff.Transform(f32.Point{X: 457.889130, Y: 226.321548}), aff.Transform(f32.Point{X: 458.612915, Y: 224.453262}))
+		path.CubeTo(aff.Transform(f32.Point{X: 459.336700, Y: 222.584961}), aff.Transform(f32.Point{X: 459.708008, Y: 220.598602}), aff.Transform(f32.Point{X: 459.708008, Y: 218.595001}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 235, G: 137, B: 35, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 443.493011, Y: 234.811996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 434.536011, Y: 234.811996}), aff.Transform(f32.Point{X: 427.274994, Y: 227.550995}), aff.Transform(f32.Point{X: 427.274994, Y: 218.593994}))
+		path.CubeTo(aff.Transform(f32.Point{X: 427.274994, Y: 209.636993}), aff.Transform(f32.Point{X: 434.536011, Y: 202.376999}), aff.Transform(f32.Point{X: 443.493011, Y: 202.376999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 449.768005, Y: 202.376999}), aff.Transform(f32.Point{X: 455.209991, Y: 205.942001}), aff.Transform(f32.Point{X: 457.907990, Y: 211.154999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 457.907990, Y: 211.154999}), aff.Transform(f32.Point{X: 445.285004, Y: 198.617004}), aff.Transform(f32.Point{X: 434.713013, Y: 212.709000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 423.496002, Y: 227.658997}), aff.Transform(f32.Point{X: 443.493011, Y: 234.811996}), aff.Transform(f32.Point{X: 443.493011, Y: 234.811996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 210, G: 114, B: 40, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 38.589001, Y: 372.894012}))
+		path.CubeTo(aff.Transform(f32.Point{X: 41.452999, Y: 380.217987}), aff.Transform(f32.Point{X: 46.216999, Y: 378.800995}), aff.Transform(f32.Point{X: 46.216999, Y: 378.800995}))
+		path.LineTo(aff.Transform(f32.Point{X: 255.677002, Y: 383.890991}))
+		path.LineTo(aff.Transform(f32.Point{X: 465.136993, Y: 378.800995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 465.136993, Y: 378.800995}), aff.Transform(f32.Point{X: 469.903015, Y: 380.217010}), aff.Transform(f32.Point{X: 472.765991, Y: 372.891998}))
+		path.LineTo(aff.Transform(f32.Point{X: 38.589001, Y: 372.894012}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 256.001007, Y: 437.257996}))
+		path.LineTo(aff.Transform(f32.Point{X: 120.427002, Y: 437.257996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 142.574005, Y: 459.404999}), aff.Transform(f32.Point{X: 163.613007, Y: 461.065002}), aff.Transform(f32.Point{X: 176.899994, Y: 461.065002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 190.188004, Y: 461.065002}), aff.Transform(f32.Point{X: 256.002014, Y: 461.065002}), aff.Transform(f32.Point{X: 256.002014, Y: 461.065002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 256.002014, Y: 461.065002}), aff.Transform(f32.Point{X: 321.813995, Y: 461.065002}), aff.Transform(f32.Point{X: 335.101990, Y: 461.065002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 348.389008, Y: 461.065002}), aff.Transform(f32.Point{X: 369.428986, Y: 459.406006}), aff.Transform(f32.Point{X: 391.575012, Y: 437.257996}))
+		path.LineTo(aff.Transform(f32.Point{X: 256.001007, Y: 437.257996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 441.739990, Y: 73.188004}))
+		path.LineTo(aff.Transform(f32.Point{X: 448.557007, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 259.066010, Y: 198.867996}))
+		path.LineTo(aff.Transform(f32.Point{X: 259.075012, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 439.988007, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 436.220001, Y: 73.047997}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 34.044998, Y: 328.462006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 21.827000, Y: 328.462006}), aff.Transform(f32.Point{X: 21.827000, Y: 313.473999}), aff.Transform(f32.Point{X: 21.827000, Y: 292.730011}))
+		path.LineTo(aff.Transform(f32.Point{X: 26.809999, Y: 292.730011}))
+		path.CubeTo(aff.Transform(f32.Point{X: 26.809999, Y: 312.936005}), aff.Transform(f32.Point{X: 27.132000, Y: 323.479004}), aff.Transform(f32.Point{X: 34.043999, Y: 323.479004}))
+		path.CubeTo(aff.Transform(f32.Point{X: 39.317001, Y: 323.479004}), aff.Transform(f32.Point{X: 42.980999, Y: 322.337006}), aff.Transform(f32.Point{X: 43.016998, Y: 322.325989}))
+		path.LineTo(aff.Transform(f32.Point{X: 44.522999, Y: 327.075989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 44.344002, Y: 327.131989}), aff.Transform(f32.Point{X: 40.080002, Y: 328.462006}), aff.Transform(f32.Point{X: 34.044998, Y: 328.462006}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 36.478001, Y: 259.341003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 31.474001, Y: 259.341003}), aff.Transform(f32.Point{X: 24.319000, Y: 259.341003}), aff.Transform(f32.Point{X: 24.319000, Y: 259.341003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 24.319000, Y: 259.341003}), aff.Transform(f32.Point{X: 17.164000, Y: 259.341003}), aff.Transform(f32.Point{X: 12.160000, Y: 259.341003}))
+		path.CubeTo(aff.Transform(f32.Point{X: 7.156000, Y: 259.341003}), aff.Transform(f32.Point{X: -1.099000, Y: 264.878998}), aff.Transform(f32.Point{X: 3.859000, Y: 276.226990}))
+		path.CubeTo(aff.Transform(f32.Point{X: 8.817000, Y: 287.579010}), aff.Transform(f32.Point{X: 12.692000, Y: 296.158997}), aff.Transform(f32.Point{X: 24.319000, Y: 296.436005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 35.945999, Y: 296.158997}), aff.Transform(f32.Point{X: 39.820999, Y: 287.579010}), aff.Transform(f32.Point{X: 44.778000, Y: 276.226990}))
+		path.CubeTo(aff.Transform(f32.Point{X: 49.736000, Y: 264.878998}), aff.Transform(f32.Point{X: 41.480999, Y: 259.341003}), aff.Transform(f32.Point{X: 36.478001, Y: 259.341003}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 24.318001, Y: 289.131989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 12.900000, Y: 289.131989}), aff.Transform(f32.Point{X: 6.252000, Y: 273.804993}), aff.Transform(f32.Point{X: 3.633000, Y: 264.529999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 2.067000, Y: 267.300995}), aff.Transform(f32.Point{X: 1.657000, Y: 271.187988}), aff.Transform(f32.Point{X: 3.858000, Y: 276.226013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 8.816000, Y: 287.578003}), aff.Transform(f32.Point{X: 12.691000, Y: 296.157990}), aff.Transform(f32.Point{X: 24.318001, Y: 296.434998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 35.945000, Y: 296.157990}), aff.Transform(f32.Point{X: 39.820000, Y: 287.578003}), aff.Transform(f32.Point{X: 44.777000, Y: 276.226013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 46.918999, Y: 271.325012}), aff.Transform(f32.Point{X: 46.589001, Y: 267.511993}), aff.Transform(f32.Point{X: 45.127998, Y: 264.757996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 42.604000, Y: 273.645996}), aff.Transform(f32.Point{X: 35.931000, Y: 289.131989}), aff.Transform(f32.Point{X: 24.318001, Y: 289.131989}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 11.884000, Y: 264.529999}))
+		path.CubeTo(aff.Transform(f32.Point{X: 11.884000, Y: 264.529999}), aff.Transform(f32.Point{X: 17.929001, Y: 272.119995}), aff.Transform(f32.Point{X: 24.319000, Y: 272.804993}))
+		path.CubeTo(aff.Transform(f32.Point{X: 30.709000, Y: 273.492004}), aff.Transform(f32.Point{X: 40.120998, Y: 264.529999}), aff.Transform(f32.Point{X: 40.120998, Y: 264.529999}))
+		path.LineTo(aff.Transform(f32.Point{X: 11.884000, Y: 264.529999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 57.584000, Y: 211.893005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 57.584000, Y: 211.893005}), aff.Transform(f32.Point{X: 52.563000, Y: 220.050003}), aff.Transform(f32.Point{X: 57.584000, Y: 225.701004}))
+		path.CubeTo(aff.Transform(f32.Point{X: 62.605000, Y: 231.350006}), aff.Transform(f32.Point{X: 70.607002, Y: 228.839996}), aff.Transform(f32.Point{X: 70.607002, Y: 228.839996}))
+		path.LineTo(aff.Transform(f32.Point{X: 57.584000, Y: 211.893005}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 209, G: 213, B: 213, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 453.770996, Y: 211.893005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 453.770996, Y: 211.893005}), aff.Transform(f32.Point{X: 458.791992, Y: 220.050003}), aff.Transform(f32.Point{X: 453.770996, Y: 225.701004}))
+		path.CubeTo(aff.Transform(f32.Point{X: 448.750000, Y: 231.350006}), aff.Transform(f32.Point{X: 440.747986, Y: 228.839996}), aff.Transform(f32.Point{X: 440.747986, Y: 228.839996}))
+		path.LineTo(aff.Transform(f32.Point{X: 453.770996, Y: 211.893005}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 209, G: 213, B: 213, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 74.959000, Y: 78.445999}))
+		path.LineTo(aff.Transform(f32.Point{X: 71.367996, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 87.855003, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 130.921005, Y: 78.445999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 259.259003, Y: 78.445999}))
+		path.LineTo(aff.Transform(f32.Point{X: 259.259003, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 280.825012, Y: 189.550995}))
+		path.LineTo(aff.Transform(f32.Point{X: 314.045013, Y: 78.445999}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.257996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.257996}), aff.Transform(f32.Point{X: 44.778999, Y: 466.602997}), aff.Transform(f32.Point{X: 44.778999, Y: 482.657013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 44.778999, Y: 498.713013}), aff.Transform(f32.Point{X: 47.869999, Y: 512.000977}), aff.Transform(f32.Point{X: 68.760002, Y: 512.000977}))
+		path.CubeTo(aff.Transform(f32.Point{X: 89.650002, Y: 512.000977}), aff.Transform(f32.Point{X: 99.615997, Y: 512.002014}), aff.Transform(f32.Point{X: 99.615997, Y: 482.657013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 99.615997, Y: 453.312012}), aff.Transform(f32.Point{X: 99.615997, Y: 437.257996}), aff.Transform(f32.Point{X: 99.615997, Y: 437.257996}))
+		path.LineTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.257996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 59.696999, Y: 488.885986}))
+		path.CubeTo(aff.Transform(f32.Point{X: 59.304001, Y: 478.100006}), aff.Transform(f32.Point{X: 60.306999, Y: 449.161987}), aff.Transform(f32.Point{X: 60.306999, Y: 449.161987}))
+		path.LineTo(aff.Transform(f32.Point{X: 99.616997, Y: 443.186005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 99.616997, Y: 439.300995}), aff.Transform(f32.Point{X: 99.616997, Y: 437.256989}), aff.Transform(f32.Point{X: 99.616997, Y: 437.256989}))
+		path.LineTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.256989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 43.771000, Y: 437.256989}), aff.Transform(f32.Point{X: 44.778999, Y: 466.601990}), aff.Transform(f32.Point{X: 44.778999, Y: 482.656006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 44.778999, Y: 498.712006}), aff.Transform(f32.Point{X: 47.869999, Y: 512.000000}), aff.Transform(f32.Point{X: 68.760002, Y: 512.000000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 69.646004, Y: 512.000000}), aff.Transform(f32.Point{X: 70.504997, Y: 511.997986}), aff.Transform(f32.Point{X: 71.350998, Y: 511.996002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 60.250999, Y: 507.174988}), aff.Transform(f32.Point{X: 59.995998, Y: 497.125000}), aff.Transform(f32.Point{X: 59.696999, Y: 488.885986}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.257996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.257996}), aff.Transform(f32.Point{X: 467.221985, Y: 466.602997}), aff.Transform(f32.Point{X: 467.221985, Y: 482.657013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.221985, Y: 498.713013}), aff.Transform(f32.Point{X: 464.131012, Y: 512.000977}), aff.Transform(f32.Point{X: 443.240997, Y: 512.000977}))
+		path.CubeTo(aff.Transform(f32.Point{X: 422.351013, Y: 512.000977}), aff.Transform(f32.Point{X: 412.385010, Y: 512.002014}), aff.Transform(f32.Point{X: 412.385010, Y: 482.657013}))
+		path.CubeTo(aff.Transform(f32.Point{X: 412.385010, Y: 453.312012}), aff.Transform(f32.Point{X: 412.385010, Y: 437.257996}), aff.Transform(f32.Point{X: 412.385010, Y: 437.257996}))
+		path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.257996}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 452.303009, Y: 488.885986}))
+		path.CubeTo(aff.Transform(f32.Point{X: 452.696014, Y: 478.100006}), aff.Transform(f32.Point{X: 451.694000, Y: 449.161987}), aff.Transform(f32.Point{X: 451.694000, Y: 449.161987}))
+		path.LineTo(aff.Transform(f32.Point{X: 412.384003, Y: 443.186005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 412.384003, Y: 439.300995}), aff.Transform(f32.Point{X: 412.384003, Y: 437.256989}), aff.Transform(f32.Point{X: 412.384003, Y: 437.256989}))
+		path.LineTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.256989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 468.230011, Y: 437.256989}), aff.Transform(f32.Point{X: 467.221985, Y: 466.601990}), aff.Transform(f32.Point{X: 467.221985, Y: 482.656006}))
+		path.CubeTo(aff.Transform(f32.Point{X: 467.221985, Y: 498.712006}), aff.Transform(f32.Point{X: 464.131012, Y: 512.000000}), aff.Transform(f32.Point{X: 443.240997, Y: 512.000000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 442.355011, Y: 512.000000}), aff.Transform(f32.Point{X: 441.497009, Y: 511.997986}), aff.Transform(f32.Point{X: 440.649994, Y: 511.996002}))
+		path.CubeTo(aff.Transform(f32.Point{X: 451.750000, Y: 507.174988}), aff.Transform(f32.Point{X: 452.005005, Y: 497.125000}), aff.Transform(f32.Point{X: 452.303009, Y: 488.885986}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 37, G: 55, B: 68, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 67.859001, Y: 310.596985}))
+		path.LineTo(aff.Transform(f32.Point{X: 56.865002, Y: 318.209015}))
+		path.LineTo(aff.Transform(f32.Point{X: 99.615997, Y: 347.980988}))
+		path.LineTo(aff.Transform(f32.Point{X: 104.926003, Y: 310.596985}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 99.615997, Y: 347.980988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 99.615997, Y: 350.734009}), aff.Transform(f32.Point{X: 97.385002, Y: 352.963989}), aff.Transform(f32.Point{X: 94.633003, Y: 352.963989}))
+		path.LineTo(aff.Transform(f32.Point{X: 60.306000, Y: 352.963989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 57.554001, Y: 352.963989}), aff.Transform(f32.Point{X: 55.323002, Y: 350.734009}), aff.Transform(f32.Point{X: 55.323002, Y: 347.980988}))
+		path.LineTo(aff.Transform(f32.Point{X: 55.323002, Y: 321.806000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 55.323002, Y: 319.053009}), aff.Transform(f32.Point{X: 57.554001, Y: 316.822998}), aff.Transform(f32.Point{X: 60.306000, Y: 316.822998}))
+		path.LineTo(aff.Transform(f32.Point{X: 94.633003, Y: 316.822998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 97.384003, Y: 316.822998}), aff.Transform(f32.Point{X: 99.615997, Y: 319.053009}), aff.Transform(f32.Point{X: 99.615997, Y: 321.806000}))
+		path.LineTo(aff.Transform(f32.Point{X: 99.615997, Y: 347.980988}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 60.306999, Y: 316.822998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 57.555000, Y: 316.822998}), aff.Transform(f32.Point{X: 55.324001, Y: 319.053009}), aff.Transform(f32.Point{X: 55.324001, Y: 321.806000}))
+		path.LineTo(aff.Transform(f32.Point{X: 55.324001, Y: 347.980988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 55.324001, Y: 350.734009}), aff.Transform(f32.Point{X: 57.555000, Y: 352.963989}), aff.Transform(f32.Point{X: 60.306999, Y: 352.963989}))
+		path.LineTo(aff.Transform(f32.Point{X: 94.634003, Y: 316.821991}))
+		path.LineTo(aff.Transform(f32.Point{X: 60.306999, Y: 316.821991}))
+		path.LineTo(aff.Transform(f32.Point{X: 60.306999, Y: 316.822998}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 198, G: 203, B: 203, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 59.558998, Y: 347.127991}))
+		path.CubeTo(aff.Transform(f32.Point{X: 59.558998, Y: 343.654999}), aff.Transform(f32.Point{X: 59.558998, Y: 331.281006}), aff.Transform(f32.Point{X: 59.558998, Y: 324.187988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 59.558998, Y: 320.619995}), aff.Transform(f32.Point{X: 60.933998, Y: 318.273987}), aff.Transform(f32.Point{X: 62.300999, Y: 316.824005}))
+		path.LineTo(aff.Transform(f32.Point{X: 60.306000, Y: 316.824005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 57.554001, Y: 316.824005}), aff.Transform(f32.Point{X: 55.323002, Y: 319.053986}), aff.Transform(f32.Point{X: 55.323002, Y: 321.807007}))
+		path.LineTo(aff.Transform(f32.Point{X: 55.323002, Y: 347.981995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 55.323002, Y: 350.734985}), aff.Transform(f32.Point{X: 57.554001, Y: 352.964996}), aff.Transform(f32.Point{X: 60.306000, Y: 352.964996}))
+		path.LineTo(aff.Transform(f32.Point{X: 94.633003, Y: 352.964996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 96.544998, Y: 352.964996}), aff.Transform(f32.Point{X: 98.183998, Y: 351.876007}), aff.Transform(f32.Point{X: 99.021004, Y: 350.298004}))
+		path.CubeTo(aff.Transform(f32.Point{X: 70.194000, Y: 350.311005}), aff.Transform(f32.Point{X: 59.558998, Y: 350.493988}), aff.Transform(f32.Point{X: 59.558998, Y: 347.127991}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 444.141998, Y: 310.596985}))
+		path.LineTo(aff.Transform(f32.Point{X: 455.136993, Y: 318.209015}))
+		path.LineTo(aff.Transform(f32.Point{X: 412.385010, Y: 347.980988}))
+		path.LineTo(aff.Transform(f32.Point{X: 407.076996, Y: 310.596985}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 216, G: 153, B: 50, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 412.385010, Y: 347.980988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 412.385010, Y: 350.734009}), aff.Transform(f32.Point{X: 414.615997, Y: 352.963989}), aff.Transform(f32.Point{X: 417.368011, Y: 352.963989}))
+		path.LineTo(aff.Transform(f32.Point{X: 451.694000, Y: 352.963989}))
+		path.CubeTo(aff.Transform(f32.Point{X: 454.446014, Y: 352.963989}), aff.Transform(f32.Point{X: 456.675995, Y: 350.734009}), aff.Transform(f32.Point{X: 456.675995, Y: 347.980988}))
+		path.LineTo(aff.Transform(f32.Point{X: 456.675995, Y: 321.806000}))
+		path.CubeTo(aff.Transform(f32.Point{X: 456.675995, Y: 319.053009}), aff.Transform(f32.Point{X: 454.446014, Y: 316.822998}), aff.Transform(f32.Point{X: 451.694000, Y: 316.822998}))
+		path.LineTo(aff.Transform(f32.Point{X: 417.368011, Y: 316.822998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 414.615997, Y: 316.822998}), aff.Transform(f32.Point{X: 412.385010, Y: 319.053009}), aff.Transform(f32.Point{X: 412.385010, Y: 321.806000}))
+		path.LineTo(aff.Transform(f32.Point{X: 412.385010, Y: 347.980988}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 88, G: 106, B: 115, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 451.695007, Y: 316.822998}))
+		path.CubeTo(aff.Transform(f32.Point{X: 454.446991, Y: 316.822998}), aff.Transform(f32.Point{X: 456.677002, Y: 319.053009}), aff.Transform(f32.Point{X: 456.677002, Y: 321.806000}))
+		path.LineTo(aff.Transform(f32.Point{X: 456.677002, Y: 347.980988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 456.677002, Y: 350.734009}), aff.Transform(f32.Point{X: 454.446991, Y: 352.963989}), aff.Transform(f32.Point{X: 451.695007, Y: 352.963989}))
+		path.LineTo(aff.Transform(f32.Point{X: 417.368988, Y: 316.821991}))
+		path.LineTo(aff.Transform(f32.Point{X: 451.695007, Y: 316.821991}))
+		path.LineTo(aff.Transform(f32.Point{X: 451.695007, Y: 316.822998}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 198, G: 203, B: 203, A: 255})
+		c.A = uint8(math.Round(float64(c.A) * 0.300000))
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		aff = affBase
+
+		path = clip.Path{}
+		path.Begin(ops)
+		path.MoveTo(aff.Transform(f32.Point{X: 452.442993, Y: 347.127991}))
+		path.CubeTo(aff.Transform(f32.Point{X: 452.442993, Y: 343.654999}), aff.Transform(f32.Point{X: 452.442993, Y: 331.281006}), aff.Transform(f32.Point{X: 452.442993, Y: 324.187988}))
+		path.CubeTo(aff.Transform(f32.Point{X: 452.442993, Y: 320.619995}), aff.Transform(f32.Point{X: 451.067993, Y: 318.273987}), aff.Transform(f32.Point{X: 449.700989, Y: 316.824005}))
+		path.LineTo(aff.Transform(f32.Point{X: 451.696014, Y: 316.824005}))
+		path.CubeTo(aff.Transform(f32.Point{X: 454.447998, Y: 316.824005}), aff.Transform(f32.Point{X: 456.678009, Y: 319.053986}), aff.Transform(f32.Point{X: 456.678009, Y: 321.807007}))
+		path.LineTo(aff.Transform(f32.Point{X: 456.678009, Y: 347.981995}))
+		path.CubeTo(aff.Transform(f32.Point{X: 456.678009, Y: 350.734985}), aff.Transform(f32.Point{X: 454.447998, Y: 352.964996}), aff.Transform(f32.Point{X: 451.696014, Y: 352.964996}))
+		path.LineTo(aff.Transform(f32.Point{X: 417.369995, Y: 352.964996}))
+		path.CubeTo(aff.Transform(f32.Point{X: 415.458008, Y: 352.964996}), aff.Transform(f32.Point{X: 413.817993, Y: 351.876007}), aff.Transform(f32.Point{X: 412.980988, Y: 350.298004}))
+		path.CubeTo(aff.Transform(f32.Point{X: 441.807007, Y: 350.311005}), aff.Transform(f32.Point{X: 452.442993, Y: 350.493988}), aff.Transform(f32.Point{X: 452.442993, Y: 347.127991}))
+		path.Close()
+		end = path.End()
+		outline = clip.Outline{Path: end}.Op().Push(ops)
+		c = theme.Color(color.NRGBA{R: 45, G: 64, B: 78, A: 255})
+		paint.ColorOp{Color: c}.Add(ops)
+		paint.PaintOp{}.Add(ops)
+		outline.Pop()
+		return layout.Dimensions{Size: image.Point{X: int(w), Y: int(h)}}
+	}
 }
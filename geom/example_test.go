@@ -0,0 +1,192 @@
+package geom_test
+
+import (
+	"fmt"
+	"math"
+
+	"gioui.org/f32"
+	"github.com/inkeliz/giosvg/geom"
+)
+
+func ExamplePath_Start() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.Line(f32.Pt(10, 0))
+	p.QuadBezier(f32.Pt(10, 10), f32.Pt(0, 10))
+	p.CubeBezier(f32.Pt(-5, 10), f32.Pt(-5, 0), f32.Pt(0, 0))
+	p.Stop(true)
+	fmt.Println(p.ToSVGPath())
+
+	// The same Path can be reused, after Clear.
+	p.Clear()
+	fmt.Println(len(p))
+	// Output:
+	// M0 0 L10 0 Q10 10 0 10 C-5 10 -5 0 0 0 Z
+	// 0
+}
+
+func ExamplePath_RoundRect() {
+	var p geom.Path
+	p.RoundRect(0, 0, 20, 10, 2, 2)
+	p.Ellipse(10, 5, 2, 2)
+	fmt.Println(p.Bounds(geom.Identity))
+	// Output:
+	// (0,0)-(20,10)
+}
+
+func ExamplePath_ArcTo() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.ArcTo(5, 5, 0, false, true, 10, 0)
+	b := p.Bounds(geom.Identity)
+	fmt.Printf("%.2f %.2f %.2f %.2f\n", b.Min.X, b.Min.Y, b.Max.X, b.Max.Y)
+	// Output:
+	// 0.00 -5.00 10.00 0.00
+}
+
+func ExamplePath_Bounds() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.QuadBezier(f32.Pt(50, 100), f32.Pt(100, 0))
+
+	// The bounds use the extrema of the curve, instead of the control point.
+	fmt.Println(p.Bounds(geom.Identity))
+	fmt.Println(p.Bounds(geom.Identity.Scale(0.5, 0.5)))
+	// Output:
+	// (0,0)-(100,50)
+	// (0,0)-(50,25)
+}
+
+func ExamplePath_Segments() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.Line(f32.Pt(10, 0))
+	p.Line(f32.Pt(10, 10))
+	p.Stop(true)
+	for _, segment := range p.Segments() {
+		fmt.Println(segment.Points, segment.Closing)
+	}
+	// Output:
+	// [(0,0) (10,0)] false
+	// [(10,0) (10,10)] false
+	// [(10,10) (0,0)] true
+}
+
+func ExamplePath_Flatten() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.QuadBezier(f32.Pt(5, 10), f32.Pt(10, 0))
+
+	// The curve is replaced by lines, which are at most 1 unit away.
+	fmt.Println(p.Flatten(1))
+	// Output:
+	// M0 0 L3.333 4.444 L6.667 4.444 L10 0
+}
+
+func ExamplePath_Polygons() {
+	var p geom.Path
+	p.RoundRect(0, 0, 10, 10, 0, 0)
+	fmt.Println(p.Polygons(0.1))
+	// Output:
+	// [[(0,0) (10,0) (10,10) (0,10)]]
+}
+
+func ExamplePath_Reverse() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.Line(f32.Pt(10, 0))
+	p.QuadBezier(f32.Pt(10, 10), f32.Pt(0, 10))
+	fmt.Println(p.Reverse())
+	// Output:
+	// M0 10 Q10 10 10 0 L0 0
+}
+
+func ExamplePath_Transform() {
+	var p geom.Path
+	p.Start(f32.Pt(10, 0))
+	p.Line(f32.Pt(20, 0))
+
+	// Rotate by 90 degrees around the origin.
+	b := p.Transform(geom.Identity.Rotate(math.Pi / 2)).Bounds(geom.Identity)
+	fmt.Printf("%.2f %.2f %.2f %.2f\n", b.Min.X, b.Min.Y, b.Max.X, b.Max.Y)
+	// Output:
+	// 0.00 10.00 0.00 20.00
+}
+
+func ExamplePath_StrokeOutline() {
+	var p geom.Path
+	p.Start(f32.Pt(0, 0))
+	p.Line(f32.Pt(10, 0))
+
+	outline := p.StrokeOutline(2, geom.JoinOptions{LineJoin: geom.Bevel, TrailLineCap: geom.SquareCap}, 0.1)
+	fmt.Println(outline.Bounds(geom.Identity))
+	// Output:
+	// (-1,-1)-(11,1)
+}
+
+func ExamplePath_NonZero() {
+	// Two squares, one inside the other, in the same direction: the inner
+	// square is a hole using the even-odd rule.
+	var p geom.Path
+	p.RoundRect(0, 0, 10, 10, 0, 0)
+	p.RoundRect(2, 2, 8, 8, 0, 0)
+
+	// The inner square is reversed, so it's a hole using the non-zero rule.
+	fmt.Println(p.NonZero(0.1))
+	// Output:
+	// M0 0 L10 0 L10 10 L0 10 Z M2 8 L8 8 L8 2 L2 2 Z
+}
+
+func ExamplePath_Boolean() {
+	var a, b geom.Path
+	a.RoundRect(0, 0, 10, 10, 0, 0)
+	b.RoundRect(5, 5, 15, 15, 0, 0)
+
+	for _, op := range []geom.BooleanOp{geom.Union, geom.Intersection, geom.Difference, geom.Xor} {
+		fmt.Println(op, a.Boolean(op, b, 0.1).Bounds(geom.Identity))
+	}
+	// The methods are the same, such as Union.
+	fmt.Println(a.Union(b, 0.1).Bounds(geom.Identity))
+	// Output:
+	// Union (0,0)-(15,15)
+	// Intersection (5,5)-(10,10)
+	// Difference (0,0)-(10,10)
+	// Xor (0,0)-(15,15)
+	// (0,0)-(15,15)
+}
+
+func ExampleMatrix2D() {
+	// The transforms are applied from the last to the first, as the SVG
+	// `transform="translate(10 0) scale(2)"`.
+	m := geom.Identity.Translate(10, 0).Scale(2, 2)
+	fmt.Println(m.Transform(1, 1))
+	fmt.Println(m.TransformVector(1, 1))
+	fmt.Println(m.TFixed(f32.Pt(1, 1)))
+	fmt.Println(m.Invert().Transform(12, 2))
+	fmt.Println(m.Mult(geom.Identity.Translate(1, 0)).Transform(0, 0))
+	// Output:
+	// 12 2
+	// 2 2
+	// (12,2)
+	// 1 1
+	// 12 0
+}
+
+func ExampleMatrix2D_Rotate() {
+	// Rotate by 90 degrees around the point (12, 12).
+	m := geom.Identity.Translate(12, 12).Rotate(math.Pi/2).Translate(-12, -12)
+	x, y := m.Transform(12, 0)
+	fmt.Printf("%.0f %.0f\n", x, y)
+	// Output:
+	// 24 12
+}
+
+func ExampleMatrix2D_SkewX() {
+	x, y := geom.Identity.SkewX(math.Pi/4).Transform(0, 10)
+	fmt.Printf("%.0f %.0f\n", x, y)
+	x, y = geom.Identity.SkewY(math.Pi/4).Transform(10, 0)
+	fmt.Printf("%.0f %.0f\n", x, y)
+	// Output:
+	// 10 10
+	// 10 10
+}
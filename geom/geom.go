@@ -0,0 +1,147 @@
+// Package geom exposes the geometry used by giosvg: the affine matrices, the
+// paths and their operations, and the functions to flatten, stroke, reverse
+// and transform them. The paths of a Document are available from
+// giosvg.Document.Paths, in the coordinates of the viewBox.
+//
+// The types are the same used internally, so the paths can be given back to
+// giosvg without conversions. Since the types are aliases, their methods
+// aren't listed by go doc, and they are summarized below. See the examples
+// for their usage.
+//
+// A Path is built using:
+//
+//	Start(p)                  starts a new sub-path at p
+//	Line(p)                   adds a line to p
+//	QuadBezier(c, p)          adds a quadratic bezier curve to p
+//	CubeBezier(c1, c2, p)     adds a cubic bezier curve to p
+//	ArcTo(rx, ry, rotation, largeArc, sweep, x, y)
+//	                          adds an elliptical arc, like the `A` command of SVG
+//	Ellipse(cx, cy, rx, ry)   adds the closed sub-path of the ellipse
+//	RoundRect(minX, minY, maxX, maxY, rx, ry)
+//	                          adds the closed sub-path of the rounded rectangle
+//	Stop(close)               ends the sub-path, closing it if close is true
+//	Clear()                   removes all operations
+//
+// Then, without modifying the Path:
+//
+//	Bounds(m)                 the tight bounds after the transform m
+//	Segments()                the lines and curves, each one with its start point
+//	Flatten(tolerance)        the path with the curves replaced by lines
+//	Polygons(tolerance)       the points of each flattened sub-path
+//	Reverse()                 the path in the opposite direction
+//	Transform(m)              the path with the matrix m applied to all points
+//	StrokeOutline(width, join, tolerance)
+//	                          the outline of the stroke, which can be filled
+//	NonZero(tolerance)        the even-odd area, as non-zero winding
+//	Boolean(op, q, tolerance) the area of the BooleanOp with q, as do Union,
+//	                          Intersection, Difference and Xor
+//	ToSVGPath()               the SVG path data, such as "M0 0 L10 0 Z"
+//
+// A Matrix2D is changed by Translate(x, y), Scale(x, y), Rotate(theta),
+// SkewX(theta) and SkewY(theta), combined by Mult(b) and inverted by
+// Invert(). It's applied to points by Transform(x, y), to f32.Point by
+// TFixed(p), and to vectors, without the translation, by TransformVector(x, y).
+package geom
+
+import (
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// Matrix2D is an affine transform, like the SVG `matrix(a b c d e f)`:
+//
+//	x' = A*x + C*y + E
+//	y' = B*x + D*y + F
+//
+// The methods, such as Translate, Scale, Rotate, SkewX and SkewY, apply the
+// transform before the matrix, as the SVG `transform` attribute. The angles
+// are in radians.
+type Matrix2D = svgparser.Matrix2D
+
+// Identity is the matrix which doesn't change the points.
+var Identity = svgparser.Identity
+
+// Path is a list of operations, which starts with OpMoveTo. It's built
+// using Start, Line, QuadBezier, CubeBezier, ArcTo, Ellipse, RoundRect and
+// Stop.
+//
+// The methods Bounds, Flatten, Polygons, Reverse, Segments, StrokeOutline,
+// NonZero, Transform and the boolean operations, such as Union, return new
+// paths, or values, without modifying it.
+type Path = svgparser.Path
+
+// Operation is one of OpMoveTo, OpLineTo, OpQuadTo, OpCubicTo or OpClose.
+type Operation = svgparser.Operation
+
+type (
+	// OpMoveTo starts a new sub-path at the point.
+	OpMoveTo = svgparser.OpMoveTo
+
+	// OpLineTo adds a line from the current point.
+	OpLineTo = svgparser.OpLineTo
+
+	// OpQuadTo adds a quadratic bezier curve from the current point,
+	// using the control point and the end point.
+	OpQuadTo = svgparser.OpQuadTo
+
+	// OpCubicTo adds a cubic bezier curve from the current point,
+	// using the two control points and the end point.
+	OpCubicTo = svgparser.OpCubicTo
+
+	// OpClose adds a line to the start of the sub-path, and closes it.
+	OpClose = svgparser.OpClose
+)
+
+// BooleanOp is an operation between the areas of two paths, see
+// Path.Boolean. Both paths are filled using the non-zero winding rule.
+type BooleanOp = svgparser.BooleanOp
+
+const (
+	Union        = svgparser.Union        // the area of any path
+	Intersection = svgparser.Intersection // the area of both paths
+	Difference   = svgparser.Difference   // the area of the first path, outside the second
+	Xor          = svgparser.Xor          // the area of only one path
+)
+
+// Segment is a line or a curve, with the start point, returned by
+// Path.Segments.
+type Segment = svgparser.Segment
+
+// JoinOptions defines how the lines are joined and capped by
+// Path.StrokeOutline.
+type JoinOptions = svgparser.JoinOptions
+
+// JoinMode is how the lines are joined, such as Miter.
+type JoinMode = svgparser.JoinMode
+
+const (
+	Arc       = svgparser.Arc
+	Round     = svgparser.Round
+	Bevel     = svgparser.Bevel
+	Miter     = svgparser.Miter
+	MiterClip = svgparser.MiterClip
+	ArcClip   = svgparser.ArcClip
+)
+
+// CapMode is how the ends of the lines are drawn, such as RoundCap.
+type CapMode = svgparser.CapMode
+
+const (
+	NilCap       = svgparser.NilCap
+	ButtCap      = svgparser.ButtCap
+	SquareCap    = svgparser.SquareCap
+	RoundCap     = svgparser.RoundCap
+	CubicCap     = svgparser.CubicCap
+	QuadraticCap = svgparser.QuadraticCap
+)
+
+// GapMode is how the gap of the joins, which exceed the miter limit,
+// is filled.
+type GapMode = svgparser.GapMode
+
+const (
+	NilGap       = svgparser.NilGap
+	FlatGap      = svgparser.FlatGap
+	RoundGap     = svgparser.RoundGap
+	CubicGap     = svgparser.CubicGap
+	QuadraticGap = svgparser.QuadraticGap
+)
@@ -0,0 +1,122 @@
+package geom
+
+import (
+	"math"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+func TestSegments(t *testing.T) {
+	var p Path
+	p.Start(f32.Pt(0, 0))
+	p.Line(f32.Pt(10, 0))
+	p.QuadBezier(f32.Pt(10, 10), f32.Pt(0, 10))
+	p.Stop(true)
+	p.Start(f32.Pt(20, 0))
+	p.Line(f32.Pt(30, 0))
+	p.Line(f32.Pt(20, 0))
+	p.Stop(true)
+
+	segments := p.Segments()
+	expected := []Segment{
+		{Points: []f32.Point{{X: 0, Y: 0}, {X: 10, Y: 0}}},
+		{Points: []f32.Point{{X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}}},
+		{Points: []f32.Point{{X: 0, Y: 10}, {X: 0, Y: 0}}, Closing: true},
+		{Points: []f32.Point{{X: 20, Y: 0}, {X: 30, Y: 0}}},
+		{Points: []f32.Point{{X: 30, Y: 0}, {X: 20, Y: 0}}},
+	}
+	if len(segments) != len(expected) {
+		t.Fatalf("expected %d segments, got %v", len(expected), segments)
+	}
+	for i := range expected {
+		if len(segments[i].Points) != len(expected[i].Points) || segments[i].Closing != expected[i].Closing {
+			t.Fatalf("expected %v, got %v", expected[i], segments[i])
+		}
+		for j := range expected[i].Points {
+			if segments[i].Points[j] != expected[i].Points[j] {
+				t.Errorf("expected %v, got %v", expected[i], segments[i])
+			}
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	var p Path
+	p.Start(f32.Pt(0, 0))
+	p.Line(f32.Pt(10, 0))
+	p.QuadBezier(f32.Pt(10, 10), f32.Pt(0, 10))
+	p.Stop(true)
+	p.Start(f32.Pt(20, 0))
+	p.CubeBezier(f32.Pt(20, 10), f32.Pt(30, 10), f32.Pt(30, 0))
+
+	if got, expected := p.Reverse().String(), "M30 0 C30 10 20 10 20 0 M0 10 Q10 10 10 0 L0 0 Z"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	if got := p.Reverse().Reverse().String(); got != p.String() {
+		t.Errorf("expected %q, got %q", p.String(), got)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	var p Path
+	p.Ellipse(0, 0, 10, 10)
+
+	flat := p.Flatten(0.01)
+	if len(flat) < 16 {
+		t.Fatalf("expected the circle to be flattened, got %v", flat)
+	}
+	for _, op := range flat {
+		switch op := op.(type) {
+		case OpMoveTo, OpClose:
+		case OpLineTo:
+			if r := math.Hypot(float64(op.X), float64(op.Y)); math.Abs(r-10) > 0.01 {
+				t.Errorf("expected the point %v on the circle, got the radius %v", op, r)
+			}
+		default:
+			t.Errorf("unexpected operation %v", op)
+		}
+	}
+}
+
+func TestArcTo(t *testing.T) {
+	for _, c := range []struct {
+		rx, ry   float64
+		sweep    bool
+		expected f32.Rectangle
+	}{
+		{rx: 5, ry: 5, sweep: true, expected: f32.Rect(0, -5, 10, 0)},
+		{rx: 5, ry: 5, sweep: false, expected: f32.Rect(0, 0, 10, 5)},
+		{rx: 1, ry: 1, sweep: true, expected: f32.Rect(0, -5, 10, 0)}, // the radii are increased
+		{rx: 0, ry: 5, sweep: true, expected: f32.Rect(0, 0, 10, 0)},  // a line
+	} {
+		var p Path
+		p.Start(f32.Pt(0, 0))
+		p.ArcTo(c.rx, c.ry, 0, false, c.sweep, 10, 0)
+		if b := p.Bounds(Identity); !near(b, c.expected) {
+			t.Errorf("expected %v for %+v, got %v", c.expected, c, b)
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	var p Path
+	p.RoundRect(0, 0, 10, 10, 2, 2)
+
+	m := Identity.Translate(10, 0).Scale(2, 2)
+	if b := p.Transform(m).Bounds(Identity); !near(b, f32.Rect(10, 0, 30, 20)) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+	if b := p.Bounds(m.Invert().Mult(m)); !near(b, f32.Rect(0, 0, 10, 10)) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+}
+
+func near(a, b f32.Rectangle) bool {
+	for _, v := range [...][2]float32{{a.Min.X, b.Min.X}, {a.Min.Y, b.Min.Y}, {a.Max.X, b.Max.X}, {a.Max.Y, b.Max.Y}} {
+		if math.Abs(float64(v[0]-v[1])) > 1e-3 {
+			return false
+		}
+	}
+	return true
+}
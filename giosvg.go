@@ -2,18 +2,19 @@ package giosvg
 
 import (
 	"bytes"
-	"image"
 	"io"
 
 	"gioui.org/f32"
 	"gioui.org/layout"
 	"gioui.org/op"
-	"github.com/inkeliz/giosvg/internal/svgdraw"
-	"github.com/inkeliz/giosvg/internal/svgparser"
+	"golang.org/x/image/font/sfnt"
 )
 
 // Vector hold the information from the XML/SVG file, in order to avoid
 // decoding of the XML.
+//
+// The parsed data is never modified after NewVector, so the same Vector
+// can be used from multiple windows and goroutines at the same time.
 type Vector func(ops *op.Ops, constraints Constraints) layout.Dimensions
 
 // Layout implements layout.Widget, that renders the current vector without any cache.
@@ -32,44 +33,32 @@ func NewVector(data []byte) (Vector, error) { return NewVectorReader(bytes.NewRe
 // NewVectorReader creates an IconOp from the given io.Reader. The data is
 // expected to be an SVG/XML
 func NewVectorReader(reader io.Reader) (Vector, error) {
-	render, err := svgparser.ReadIcon(reader)
+	doc, err := NewDocumentReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Vector(), nil
+}
+
+// NewGlyphVector creates a Vector from the glyph of the rune, which is
+// filled with the current color. The font is expected to be parsed by
+// sfnt.Parse, see NewGlyphDocument.
+func NewGlyphVector(f *sfnt.Font, r rune) (Vector, error) {
+	doc, err := NewGlyphDocument(f, r)
 	if err != nil {
 		return nil, err
 	}
+	return doc.Vector(), nil
+}
 
-	return func(ops *op.Ops, constraints Constraints) layout.Dimensions {
-		var w, h float32
-		if constraints.Max != constraints.Min {
-			if render.ViewBox.W >= render.ViewBox.H {
-				d := float32(render.ViewBox.W) / float32(render.ViewBox.H)
-				if constraints.Max.Y*d > constraints.Max.X {
-					w, h = constraints.Max.X, constraints.Max.X/d
-				} else {
-					w, h = constraints.Max.Y*d, constraints.Max.Y
-				}
-			} else {
-				d := float32(render.ViewBox.H) / float32(render.ViewBox.W)
-				if constraints.Max.X*d > constraints.Max.Y {
-					w, h = constraints.Max.Y/d, constraints.Max.Y
-				} else {
-					w, h = constraints.Max.X, constraints.Max.X*d
-				}
-			}
-		}
-
-		if constraints.Min.X > w {
-			w = constraints.Min.X
-		}
-		if constraints.Min.Y > h {
-			h = constraints.Min.Y
-		}
-
-		render.SetTarget(0-render.ViewBox.X, 0-render.ViewBox.Y, float64(w), float64(h))
-		scale := float32(float32(float64(w)/render.ViewBox.W)+float32(float64(h)/render.ViewBox.H)) / 2
-		render.Draw(&svgdraw.Driver{Ops: ops, Scale: scale}, 1.0)
-
-		return layout.Dimensions{Size: image.Point{X: int(w), Y: int(h)}}
-	}, nil
+// NewGlyphVectorByName creates a Vector from the glyph with the given
+// name, see NewGlyphVector.
+func NewGlyphVectorByName(f *sfnt.Font, name string) (Vector, error) {
+	doc, err := NewGlyphDocumentByName(f, name)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Vector(), nil
 }
 
 // Constraints is the layout.Constraints with f32.Pt instead of image.Point.
@@ -105,7 +94,7 @@ type Icon struct {
 //
 // Make sure to not reuse the Icon with different sizes in the same frame,
 // if the same Icon is used twice  in the same frame you MUST create
-// two Icon, for each one.
+// two Icon, for each one, or use the IconCache.
 func NewIcon(vector Vector) *Icon {
 	return &Icon{
 		vector: vector,
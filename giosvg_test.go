@@ -0,0 +1,689 @@
+package giosvg
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+	"gioui.org/layout"
+	"gioui.org/op"
+	"gioui.org/widget"
+	"github.com/inkeliz/giosvg/geom"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+const testIcon = `<svg xmlns="http://www.w3.org/2000/svg" viewBox="2 2 20 20">
+	<g transform="rotate(45 12 12)">
+		<rect x="4" y="4" width="16" height="16" rx="2" fill="#2196F3"/>
+		<circle cx="12" cy="12" r="4" fill="none" stroke="currentColor" stroke-width="2"/>
+	</g>
+	<path d="M4 20 L20 4" stroke="red" transform="translate(1, 1) scale(0.9)"/>
+</svg>`
+
+func TestVectorConcurrent(t *testing.T) {
+	vector, err := NewVector([]byte(testIcon))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			ops := new(op.Ops)
+			for j := 0; j < 50; j++ {
+				ops.Reset()
+				size := float32(16 + i*8 + j)
+				dims := vector(ops, Constraints{Max: f32.Pt(size, size)})
+				if dims.Size.X != int(size) || dims.Size.Y != int(size) {
+					t.Errorf("unexpected size %v for %v", dims.Size, size)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDocumentPalette(t *testing.T) {
+	doc, err := NewDocument([]byte(testIcon))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	palette := doc.Palette()
+	expected := []color.NRGBA{{R: 0x21, G: 0x96, B: 0xF3, A: 0xFF}, {A: 0xFF}, {R: 0xFF, A: 0xFF}}
+	if len(palette) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, palette)
+	}
+	for i := range expected {
+		if palette[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, palette)
+		}
+	}
+}
+
+func TestDocumentVariables(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" style="--secondary: #90CAF9">
+		<path d="M4 4h16v16H4z" fill="var(--secondary)"/>
+		<path d="M8 8h8v8H8z" fill="var(--primary, var(--accent, currentColor))" stroke="var(--primary, red)"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	variables := doc.Variables()
+	if expected := []string{"secondary", "primary", "accent"}; fmt.Sprint(variables) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, variables)
+	}
+
+	palette := doc.Palette()
+	if expected := []color.NRGBA{{R: 0x90, G: 0xCA, B: 0xF9, A: 0xFF}, {R: 0xFF, A: 0xFF}}; fmt.Sprint(palette) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, palette)
+	}
+}
+
+func TestDocumentStopVariables(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24" style="--b: #0000ff">
+		<linearGradient id="g">
+			<stop offset="0" stop-color="var(--a, var(--b, red))"/>
+			<stop offset="1" stop-color="var(--c, var(--d, green))"/>
+		</linearGradient>
+		<path d="M4 4h16v16H4z" fill="url(#g)"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := []string{"a", "c"}; fmt.Sprint(doc.Variables()) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, doc.Variables())
+	}
+	// The nested fallbacks are resolved, using the custom property of the SVG.
+	palette := doc.Palette()
+	if expected := []color.NRGBA{{B: 0xFF, A: 0xFF}, {G: 0x80, A: 0xFF}}; fmt.Sprint(palette) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v, got %v", expected, palette)
+	}
+}
+
+func TestDocumentElement(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<g id="layer">
+			<rect id="valve" x="2" y="2" width="8" height="8"/>
+			<circle id="gauge" cx="16" cy="16" r="4"/>
+		</g>
+		<path id="needle" d="M12 12 L20 12"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if doc.Element("missing") != nil {
+		t.Fatal("unexpected element")
+	}
+	if paths := doc.Element("layer").paths; len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %v", paths)
+	}
+
+	doc.Element("valve").SetVisible(false)
+	doc.Element("needle").SetFill(color.NRGBA{R: 0xFF, A: 0xFF})
+
+	overrides, revisions := doc.snapshot()
+	if !overrides[0].Hidden || overrides[1].Hidden || overrides[2].Hidden {
+		t.Fatalf("unexpected visibility %v", overrides)
+	}
+	if revisions[0] != 1 || revisions[1] != 0 || revisions[2] != 1 {
+		t.Fatalf("unexpected revisions %v", revisions)
+	}
+
+	icon := NewDocumentIcon(doc)
+	gtx := layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(48, 48))}
+	if dims := icon.Layout(gtx); dims.Size != image.Pt(48, 48) {
+		t.Fatalf("unexpected size %v", dims.Size)
+	}
+}
+
+func TestDocumentBounds(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<g id="layer">
+			<rect id="valve" x="2" y="2" width="8" height="8"/>
+			<circle id="gauge" cx="16" cy="16" r="4"/>
+		</g>
+		<path id="needle" d="M12 12 L20 12" stroke="red" stroke-width="2" fill="none"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if vb := doc.ViewBox(); vb != f32.Rect(0, 0, 24, 24) {
+		t.Errorf("unexpected viewBox %v", vb)
+	}
+	if b := doc.Bounds(); b != f32.Rect(2, 2, 20, 20) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+	if b := doc.Element("needle").Bounds(); b != f32.Rect(12, 11, 20, 13) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+
+	// The stroke of the Element isn't added to the valve without stroke.
+	doc.Element("gauge").SetVisible(false)
+	doc.Element("valve").SetTransform(f32.Affine2D{}.Offset(f32.Pt(-2, -2)))
+	doc.Element("valve").SetStroke(color.NRGBA{R: 0xff, A: 0xff})
+	if b := doc.Element("layer").Bounds(); b != f32.Rect(0, 0, 8, 8) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+	if b := doc.Bounds(); b != f32.Rect(0, 0, 20, 13) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+}
+
+func TestDocumentPaths(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<g id="layer" transform="translate(2 2)">
+			<rect id="valve" width="8" height="8"/>
+			<circle id="gauge" cx="14" cy="14" r="4"/>
+		</g>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := doc.Paths()
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %v", paths)
+	}
+	if got, expected := paths[0].String(), "M2 2 L10 2 L10 10 L2 10 Z"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+	b := paths[1].Bounds(geom.Identity)
+	if got := fmt.Sprintf("%.3f %.3f %.3f %.3f", b.Min.X, b.Min.Y, b.Max.X, b.Max.Y); got != "12.000 12.000 20.000 20.000" {
+		t.Errorf("unexpected bounds %v", b)
+	}
+
+	doc.Element("valve").SetVisible(false)
+	if paths := doc.Element("layer").Paths(); len(paths) != 1 {
+		t.Errorf("expected 1 path, got %v", paths)
+	}
+}
+
+func TestElementCombine(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<g transform="translate(2 2)"><rect id="bell" width="16" height="16"/></g>
+		<circle id="dot" cx="18" cy="18" r="4" fill="red"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bell, dot := doc.Element("bell"), doc.Element("dot")
+	bell.Combine(geom.Difference, dot.Area())
+	if b := bell.Bounds(); b != f32.Rect(2, 2, 18, 18) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+	// The corner inside the dot is removed.
+	if ids := doc.HitTest(image.Pt(24, 24), f32.Pt(16.5, 16.5)); len(ids) == 0 || ids[0] != "dot" {
+		t.Errorf("expected the dot, got %v", ids)
+	}
+	dot.SetVisible(false)
+	if ids := doc.HitTest(image.Pt(24, 24), f32.Pt(16.5, 16.5)); len(ids) != 0 {
+		t.Errorf("expected nothing, got %v", ids)
+	}
+	if ids := doc.HitTest(image.Pt(24, 24), f32.Pt(13, 13)); len(ids) == 0 || ids[0] != "bell" {
+		t.Errorf("expected the bell, got %v", ids)
+	}
+
+	// The stroke of the Element isn't added to the bell without stroke.
+	area := bell.Area().Bounds(geom.Identity)
+	bell.SetStroke(color.NRGBA{R: 0xff, A: 0xff})
+	if b := bell.Area().Bounds(geom.Identity); b != area {
+		t.Errorf("expected the area bounds %v, got %v", area, b)
+	}
+
+	// The combined shape is written, without the hidden dot.
+	var out bytes.Buffer
+	if err := doc.WriteSVG(&out, SVGOptions{Precision: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(out.Bytes(), []byte("stroke")) {
+		t.Errorf("unexpected stroke: %s", out.String())
+	}
+	written, err := NewDocument(out.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paths := written.Paths(); len(paths) != 1 {
+		t.Fatalf("expected 1 path, got %s", out.String())
+	}
+	if ids := written.HitTest(image.Pt(24, 24), f32.Pt(16.5, 16.5)); len(ids) != 0 {
+		t.Errorf("expected nothing, got %v: %s", ids, out.String())
+	}
+
+	bell.Reset()
+	if ids := doc.HitTest(image.Pt(24, 24), f32.Pt(16.5, 16.5)); len(ids) == 0 || ids[0] != "bell" {
+		t.Errorf("expected the bell, got %v", ids)
+	}
+}
+
+func TestDocumentWithoutViewBox(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" width="100%"><rect x="10" y="10" width="20" height="10"/></svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vb := doc.ViewBox(); vb != f32.Rect(10, 10, 30, 20) {
+		t.Errorf("unexpected viewBox %v", vb)
+	}
+	gtx := layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(48, 48))}
+	if dims := NewIcon(doc.Vector()).Layout(gtx); dims.Size != image.Pt(48, 48) {
+		t.Errorf("unexpected size %v", dims.Size)
+	}
+
+	if _, err := NewVector([]byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`)); err == nil {
+		t.Error("expected an error for an empty svg without size")
+	}
+}
+
+func TestElementColorsWithoutPaint(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<rect id="outline" x="2" y="2" width="20" height="20" fill="none" stroke="blue" stroke-width="2"/>
+		<rect id="solid" x="8" y="8" width="8" height="8" fill="blue" stroke="none"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	red := color.NRGBA{R: 0xFF, A: 0xFF}
+	outline, solid := doc.Element("outline"), doc.Element("solid")
+	outline.SetFill(red)
+	outline.SetStroke(red)
+	solid.SetStroke(red)
+
+	// The stroke of the outline is replaced, but the fill of the outline
+	// and the stroke of the solid rect aren't added.
+	overrides, _ := doc.snapshot()
+	var paints paintRecorder
+	doc.render.DrawWith(&paints, svgparser.DrawOptions{Transform: svgparser.Identity, Opacity: 1, Overrides: overrides})
+	if expected := []string{"stroke {{255 0 0 255}}", "fill {{0 0 255 255}}"}; fmt.Sprint(paints.paints) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, paints.paints)
+	}
+}
+
+// paintRecorder is a svgparser.Driver which records the paints drawn.
+type paintRecorder struct {
+	paints []string
+}
+
+func (r *paintRecorder) SetupDrawers(willFill, willStroke bool) (filler svgparser.Filler, stroker svgparser.Stroker) {
+	if willFill {
+		filler = &paintDrawer{recorder: r, kind: "fill"}
+	}
+	if willStroke {
+		stroker = &paintDrawer{recorder: r, kind: "stroke"}
+	}
+	return filler, stroker
+}
+
+type paintDrawer struct {
+	recorder *paintRecorder
+	kind     string
+}
+
+func (*paintDrawer) Start(f32.Point)                            {}
+func (*paintDrawer) Line(f32.Point)                             {}
+func (*paintDrawer) QuadBezier(f32.Point, f32.Point)            {}
+func (*paintDrawer) CubeBezier(f32.Point, f32.Point, f32.Point) {}
+func (*paintDrawer) Stop(bool)                                  {}
+func (*paintDrawer) SetWinding(bool)                            {}
+func (*paintDrawer) SetStrokeOptions(svgparser.StrokeOptions)   {}
+
+func (d *paintDrawer) Draw(color svgparser.Pattern, _ float64) {
+	d.recorder.paints = append(d.recorder.paints, fmt.Sprint(d.kind, " ", color))
+}
+
+func TestDocumentHitTest(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<g id="floor">
+			<rect id="room" x="0" y="0" width="50" height="50"/>
+			<path id="donut" fill-rule="evenodd" d="M60 60 h30 v30 h-30 z M70 70 h10 v10 h-10 z"/>
+			<g id="moved" transform="translate(50, 0)">
+				<circle id="lamp" cx="25" cy="25" r="10"/>
+			</g>
+		</g>
+		<line id="wall" x1="0" y1="95" x2="50" y2="95" stroke="black" stroke-width="4"/>
+		<rect id="over" x="40" y="40" width="20" height="20" fill="none" stroke="red"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size := image.Pt(200, 200)
+	for _, c := range []struct {
+		p        f32.Point
+		expected []string
+	}{
+		{p: f32.Pt(10, 10), expected: []string{"room", "floor"}},
+		{p: f32.Pt(150, 50), expected: []string{"lamp", "moved", "floor"}},
+		{p: f32.Pt(130, 130), expected: []string{"donut", "floor"}},
+		{p: f32.Pt(150, 150), expected: nil},
+		{p: f32.Pt(50, 192), expected: []string{"wall"}},
+		{p: f32.Pt(50, 184), expected: nil},
+		{p: f32.Pt(80, 80), expected: []string{"over"}},
+		{p: f32.Pt(90, 90), expected: []string{"room", "floor"}},
+	} {
+		if ids := doc.HitTest(size, c.p); fmt.Sprint(ids) != fmt.Sprint(c.expected) {
+			t.Errorf("at %v: expected %v, got %v", c.p, c.expected, ids)
+		}
+	}
+
+	doc.Element("lamp").SetVisible(false)
+	if ids := doc.HitTest(size, f32.Pt(150, 50)); ids != nil {
+		t.Errorf("expected hidden element, got %v", ids)
+	}
+
+	// The color of the Element doesn't fill the paths without fill.
+	doc.Element("over").SetFill(color.NRGBA{R: 0xFF, A: 0xFF})
+	if ids := doc.HitTest(size, f32.Pt(110, 110)); ids != nil {
+		t.Errorf("expected nothing inside the outline, got %v", ids)
+	}
+}
+
+func TestDocumentTitleAt(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<title>Sales</title>
+		<rect x="0" y="20" width="20" height="80"><title>January: 80</title></rect>
+		<g>
+			<rect x="40" y="50" width="20" height="50"/>
+			<title>
+				February: 50
+			</title>
+		</g>
+		<g>
+			<title>March</title>
+			<rect x="80" y="0" width="20" height="50"><title>March: 100</title></rect>
+			<rect x="80" y="50" width="20" height="50"/>
+		</g>
+		<rect x="20" y="0" width="10" height="10"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	size := image.Pt(100, 100)
+	for _, c := range []struct {
+		p        f32.Point
+		expected string
+	}{
+		{p: f32.Pt(10, 50), expected: "January: 80"},
+		{p: f32.Pt(50, 75), expected: "February: 50"},
+		{p: f32.Pt(90, 25), expected: "March: 100"},
+		{p: f32.Pt(90, 75), expected: "March"},
+		{p: f32.Pt(25, 5), expected: ""},
+		{p: f32.Pt(50, 5), expected: ""},
+	} {
+		if title := doc.TitleAt(size, c.p); title != c.expected {
+			t.Errorf("at %v: expected %q, got %q", c.p, c.expected, title)
+		}
+	}
+}
+
+func TestMorph(t *testing.T) {
+	play, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><path d="M8 5 V19 L19 12 Z"/></svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pause, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><path id="bars" d="M6 19 H10 V5 H6 Z M14 5 V19 H18 V5 Z"/></svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ops := new(op.Ops)
+	for _, v := range []float32{-1, 0, 0.3, 0.5, 1, 2} {
+		ops.Reset()
+		if dims := Morph(play, pause, v)(ops, Constraints{Max: f32.Pt(48, 48)}); dims.Size != image.Pt(48, 48) {
+			t.Errorf("at %v: unexpected size %v", v, dims.Size)
+		}
+	}
+
+	// The ends draw the documents themselves, and t is clamped.
+	size := image.Pt(48, 48)
+	playProbes := []hitProbe{
+		{p: f32.Pt(24, 24), hit: true},  // inside the triangle, and the gap of the bars
+		{p: f32.Pt(18, 36), hit: true},  // inside both
+		{p: f32.Pt(32, 12), hit: false}, // inside the right bar only
+		{p: f32.Pt(37, 24), hit: true},  // the tip of the triangle
+		{p: f32.Pt(24, 12), hit: false}, // outside both
+	}
+	pauseProbes := []hitProbe{
+		{p: f32.Pt(24, 24), hit: false},
+		{p: f32.Pt(18, 36), hit: true},
+		{p: f32.Pt(32, 12), hit: true},
+		{p: f32.Pt(37, 24), hit: false},
+		{p: f32.Pt(24, 12), hit: false},
+	}
+	checkHits(t, morph(play, pause, -1), size, playProbes)
+	checkHits(t, morph(play, pause, 0), size, playProbes)
+	checkHits(t, morph(play, pause, 1), size, pauseProbes)
+	checkHits(t, morph(play, pause, 2), size, pauseProbes)
+
+	// The middle is between the shapes: the gap of the bars is partially
+	// filled, and the tip of the triangle is gone.
+	checkHits(t, morph(play, pause, 0.5), size, []hitProbe{
+		{p: f32.Pt(24, 24), hit: true},
+		{p: f32.Pt(24, 12), hit: false},
+		{p: f32.Pt(37, 24), hit: false},
+		{p: f32.Pt(18, 36), hit: true},
+	})
+
+	// The changes made by Element are included, but the stroke isn't
+	// added to the bars without stroke.
+	red := color.NRGBA{R: 0xff, A: 0xff}
+	pause.Element("bars").SetFill(red)
+	pause.Element("bars").SetStroke(red)
+	style := morph(play, pause, 1).render.SVGPaths[0].Style
+	if style.FillerColor != svgparser.NewPlainColor(0xff, 0, 0, 0xff) || style.LinerColor != nil {
+		t.Errorf("expected the fill of the Element only, got %v and %v", style.FillerColor, style.LinerColor)
+	}
+}
+
+// hitProbe is a point of a Document, and whether it hits any element.
+type hitProbe struct {
+	p   f32.Point
+	hit bool
+}
+
+// checkHits hit tests each probe on the Document drawn with the given size.
+func checkHits(t *testing.T, doc *Document, size image.Point, probes []hitProbe) {
+	t.Helper()
+	for _, probe := range probes {
+		if hit := doc.hitTest(size, probe.p) >= 0; hit != probe.hit {
+			t.Errorf("at %v: expected hit %v, got %v", probe.p, probe.hit, hit)
+		}
+	}
+}
+
+func TestLottieDocument(t *testing.T) {
+	doc, err := NewLottieDocument([]byte(`{"fr": 30, "ip": 0, "op": 30, "w": 24, "h": 24, "layers": [
+		{"ty": 4, "ip": 0, "op": 30, "st": 0, "ks": {"p": {"a": 1, "k": [{"t": 0, "s": [-6, 0]}, {"t": 30, "s": [6, 0]}]}},
+			"shapes": [
+				{"ty": "el", "p": {"a": 0, "k": [12, 12]}, "s": {"a": 0, "k": [8, 8]}},
+				{"ty": "fl", "c": {"a": 0, "k": [0, 0, 0, 1]}, "o": {"a": 0, "k": 100}}
+			]}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := doc.Duration(); ok {
+		t.Error("expected the animation to repeat indefinitely")
+	}
+	if palette := doc.Palette(); len(palette) != 1 || palette[0] != (color.NRGBA{A: 0xff}) {
+		t.Errorf("unexpected palette %v", palette)
+	}
+
+	ops := new(op.Ops)
+	if dims := doc.VectorAt(0)(ops, Constraints{Max: f32.Pt(48, 48)}); dims.Size != image.Pt(48, 48) {
+		t.Errorf("unexpected size %v", dims.Size)
+	}
+
+	// The keyframes of the layer position are played by VectorAt, and repeat.
+	for _, c := range []struct {
+		t      time.Duration
+		center f32.Point
+	}{
+		{t: 0, center: f32.Pt(6, 12)},
+		{t: 250 * time.Millisecond, center: f32.Pt(9, 12)},
+		{t: 500 * time.Millisecond, center: f32.Pt(12, 12)},
+		{t: 1500 * time.Millisecond, center: f32.Pt(12, 12)},
+	} {
+		overrides := doc.animated(c.t)
+		x, y := overrides[0].Transform.Mult(doc.render.SVGPaths[0].Style.Transform).Transform(12, 12)
+		if math.Abs(x-float64(c.center.X)) > 1e-3 || math.Abs(y-float64(c.center.Y)) > 1e-3 {
+			t.Errorf("at %v: expected the center %v, got %v, %v", c.t, c.center, x, y)
+		}
+	}
+
+	if _, err := NewLottieDocument([]byte(`{"layers": `)); err == nil {
+		t.Error("expected an error for the invalid JSON")
+	}
+}
+
+func TestVectorDrawableDocument(t *testing.T) {
+	doc, err := NewVectorDrawableDocument([]byte(`<vector xmlns:android="http://schemas.android.com/apk/res/android"
+		android:width="24dp" android:height="24dp" android:viewportWidth="24" android:viewportHeight="24">
+		<group android:translateX="12" android:rotation="45" android:pivotX="6" android:pivotY="6">
+			<path android:pathData="M0,0h12v12h-12z" android:fillColor="#ff0000"/>
+		</group>
+		<path android:pathData="M2,2h8v8h-8z" android:fillColor="@color/primary"/>
+	</vector>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if variables := doc.Variables(); len(variables) != 1 || variables[0] != "primary" {
+		t.Errorf("unexpected variables %v", variables)
+	}
+
+	// The color resource is the Theme variable, and the group
+	// is rotated around its pivot.
+	size := image.Pt(48, 48)
+	probes := []hitProbe{
+		{p: f32.Pt(12, 12), hit: true},
+		{p: f32.Pt(36, 1), hit: true}, // the top corner of the rotated square
+		{p: f32.Pt(25, 2), hit: false},
+	}
+	checkHits(t, doc, size, probes)
+	variables := map[string]color.NRGBA{"primary": {B: 0xff, A: 0xff}}
+	paints := func(doc *Document) []string {
+		var paints paintRecorder
+		doc.render.DrawWith(&paints, svgparser.DrawOptions{Transform: svgparser.Identity, Opacity: 1, Variables: variables})
+		return paints.paints
+	}
+	if expected := []string{"fill {{255 0 0 255}}", "fill {{0 0 255 255}}"}; fmt.Sprint(paints(doc)) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, paints(doc))
+	}
+
+	// The written VectorDrawable draws the same.
+	var out bytes.Buffer
+	if err := doc.WriteVectorDrawable(&out); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte(`android:fillColor="@color/primary"`)) {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+	read, err := NewVectorDrawableDocument(out.Bytes())
+	if err != nil {
+		t.Fatalf("%v, in:\n%s", err, out.String())
+	}
+	checkHits(t, read, size, probes)
+	if fmt.Sprint(paints(read)) != fmt.Sprint(paints(doc)) {
+		t.Errorf("expected %v, got %v", paints(doc), paints(read))
+	}
+}
+
+func TestIconVGDocument(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<path d="M4 4H20V20H4Z" fill="currentColor"/>
+		<path d="M4 12H20" fill="none" stroke="red" stroke-width="2"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := doc.WriteIconVG(&out); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := widget.NewIcon(out.Bytes()); err != nil {
+		t.Fatalf("expected a valid widget.Icon: %v", err)
+	}
+
+	decoded, err := NewIconVGDocument(out.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if palette := decoded.Palette(); len(palette) != 1 || palette[0] != (color.NRGBA{R: 0xff, A: 0xff}) {
+		t.Errorf("unexpected palette %v", palette)
+	}
+
+	// The decoded IconVG draws as the SVG, and the current color is kept.
+	probes := []hitProbe{
+		{p: f32.Pt(12, 12), hit: true},
+		{p: f32.Pt(24, 24), hit: true},
+		{p: f32.Pt(4, 4), hit: false},
+	}
+	checkHits(t, doc, image.Pt(48, 48), probes)
+	checkHits(t, decoded, image.Pt(48, 48), probes)
+
+	// IconVG has no strokes, so the stroke is decoded as a fill.
+	var paints paintRecorder
+	decoded.render.DrawWith(&paints, svgparser.DrawOptions{Transform: svgparser.Identity, Opacity: 1})
+	if expected := []string{fmt.Sprint("fill ", svgparser.CurrentColor{}), "fill {{255 0 0 255}}"}; fmt.Sprint(paints.paints) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, paints.paints)
+	}
+}
+
+func TestGlyphVector(t *testing.T) {
+	f, err := sfnt.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := NewGlyphDocument(f, 'O')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if palette := doc.Palette(); len(palette) != 0 {
+		t.Errorf("expected the current color only, got %v", palette)
+	}
+
+	// The outline is filled by the current color, and the counter is a hole.
+	checkHits(t, doc, image.Pt(48, 48), []hitProbe{
+		{p: f32.Pt(5, 25), hit: true},
+		{p: f32.Pt(24, 25), hit: false},
+		{p: f32.Pt(42, 25), hit: true},
+		{p: f32.Pt(24, 4), hit: false},
+	})
+	var paints paintRecorder
+	doc.render.DrawWith(&paints, svgparser.DrawOptions{Transform: svgparser.Identity, Opacity: 1})
+	if expected := []string{fmt.Sprint("fill ", svgparser.CurrentColor{})}; fmt.Sprint(paints.paints) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, paints.paints)
+	}
+
+	byName, err := NewGlyphDocumentByName(f, "O")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, path := doc.render.SVGPaths[0].Path.String(), byName.render.SVGPaths[0].Path.String(); path != expected {
+		t.Errorf("expected the same glyph by name, got %v and %v", expected, path)
+	}
+	if _, err := NewGlyphVectorByName(f, "O"); err != nil {
+		t.Error(err)
+	}
+	if _, err := NewGlyphVector(f, '\U0010FFFF'); err == nil {
+		t.Error("expected an error for the missing glyph")
+	}
+}
@@ -4,6 +4,7 @@ go 1.17
 
 require (
 	gioui.org v0.0.0-20220308094932-9b2bdf6c0c1e
+	golang.org/x/exp v0.0.0-20210722180016-6781d3edade3
 	golang.org/x/image v0.0.0-20210628002857-a66eb6448b8d
 	golang.org/x/net v0.0.0-20210924151903-3ad01bbaa167
 )
@@ -0,0 +1,203 @@
+package giosvg
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// update rewrites the reference images of the golden tests, with:
+//
+//	go test -run TestGolden -update
+var update = flag.Bool("update", false, "update the reference images of testdata/golden")
+
+const (
+	// goldenSize is the width and height of the rendered images.
+	goldenSize = 96
+
+	// goldenThreshold is the perceptual difference, from 0 to 1, which
+	// makes one pixel different.
+	goldenThreshold = 0.1
+
+	// goldenMismatch is the fraction of the pixels which may be different,
+	// due to the anti-aliasing.
+	goldenMismatch = 0.005
+)
+
+// TestGolden renders each SVG of testdata/golden and compares it against
+// the PNG of the same name. On failure, the actual image and the diff,
+// which highlights the different pixels in red, are written to the
+// temporary directory.
+//
+// Only the CPU rasterizer is compared. The Gio operations of svgdraw are
+// drawn by the GPU, which isn't available to the tests, so they are out of scope.
+func TestGolden(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "golden", "*.svg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no golden svg found")
+	}
+
+	for _, path := range paths {
+		path := path
+		name := strings.TrimSuffix(filepath.Base(path), ".svg")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			doc, err := NewDocument(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := Rasterize(doc, goldenSize, goldenSize)
+
+			reference := strings.TrimSuffix(path, ".svg") + ".png"
+			if *update {
+				if err := writePNG(reference, actual); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+
+			expected, err := readPNG(reference)
+			if err != nil {
+				t.Fatalf("%v (use -update to create it)", err)
+			}
+			if expected.Bounds() != actual.Bounds() {
+				t.Fatalf("expected the bounds %v, got %v", expected.Bounds(), actual.Bounds())
+			}
+
+			diff, mismatch := goldenDiff(expected, actual)
+			if total := actual.Bounds().Dx() * actual.Bounds().Dy(); float64(mismatch) > goldenMismatch*float64(total) {
+				dir := filepath.Join(os.TempDir(), "giosvg-golden")
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := writePNG(filepath.Join(dir, name+"-actual.png"), actual); err != nil {
+					t.Fatal(err)
+				}
+				if err := writePNG(filepath.Join(dir, name+"-diff.png"), diff); err != nil {
+					t.Fatal(err)
+				}
+				t.Errorf("%d of %d pixels are different, see %s", mismatch, total, filepath.Join(dir, name+"-diff.png"))
+			}
+		})
+	}
+}
+
+// TestGoldenRoundTrip writes each SVG of testdata/golden using WriteSVG,
+// and compares the render of the output against the reference image.
+func TestGoldenRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "golden", "*.svg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range paths {
+		path := path
+		name := strings.TrimSuffix(filepath.Base(path), ".svg")
+		t.Run(name, func(t *testing.T) {
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			doc, err := NewDocument(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var out bytes.Buffer
+			if err := doc.WriteSVG(&out, SVGOptions{Precision: 3, Relative: true, Merge: true}); err != nil {
+				t.Fatal(err)
+			}
+			doc, err = NewDocument(out.Bytes())
+			if err != nil {
+				t.Fatalf("%v, in:\n%s", err, out.String())
+			}
+
+			expected, err := readPNG(strings.TrimSuffix(path, ".svg") + ".png")
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := Rasterize(doc, goldenSize, goldenSize)
+			if _, mismatch := goldenDiff(expected, actual); float64(mismatch) > goldenMismatch*goldenSize*goldenSize {
+				t.Errorf("%d pixels are different, in:\n%s", mismatch, out.String())
+			}
+		})
+	}
+}
+
+// goldenDiff returns the image of the differences and the number of
+// different pixels. The equal pixels are faded, the different ones are red.
+func goldenDiff(expected, actual image.Image) (*image.RGBA, int) {
+	bounds := actual.Bounds()
+	diff := image.NewRGBA(bounds)
+	mismatch := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			a, b := expected.At(x, y), actual.At(x, y)
+			if colorDelta(a, b) > goldenThreshold {
+				diff.Set(x, y, color.RGBA{R: 0xff, A: 0xff})
+				mismatch++
+				continue
+			}
+			l, _, _ := blendWhite(a)
+			v := uint8(0xff - (0xff-l*0xff)*0.1)
+			diff.Set(x, y, color.RGBA{R: v, G: v, B: v, A: 0xff})
+		}
+	}
+	return diff, mismatch
+}
+
+// colorDelta returns the perceptual difference between the colors, from
+// 0 to 1, using the YIQ color space, after blending them over white.
+// See "Measuring perceived color difference using YIQ NTSC transmission
+// color space in mobile applications", by Y. Kotsarenko and F. Ramos.
+func colorDelta(a, b color.Color) float64 {
+	y1, i1, q1 := blendWhite(a)
+	y2, i2, q2 := blendWhite(b)
+	dy, di, dq := y1-y2, i1-i2, q1-q2
+	// The maximum is the difference between black and white.
+	return (0.5053*dy*dy + 0.299*di*di + 0.1957*dq*dq) / 0.5053
+}
+
+// blendWhite returns the YIQ components of the color over white, from
+// 0 to 1.
+func blendWhite(c color.Color) (y, i, q float64) {
+	r, g, b, a := c.RGBA()
+	white := float64(0xffff - a)
+	fr, fg, fb := (float64(r)+white)/0xffff, (float64(g)+white)/0xffff, (float64(b)+white)/0xffff
+	y = 0.29889531*fr + 0.58662247*fg + 0.11448223*fb
+	i = 0.59597799*fr - 0.27417610*fg - 0.32180189*fb
+	q = 0.21147017*fr - 0.52261711*fg + 0.31114694*fb
+	return y, i, q
+}
+
+func readPNG(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return png.Decode(f)
+}
+
+func writePNG(path string, img image.Image) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(f, img); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
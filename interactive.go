@@ -0,0 +1,402 @@
+package giosvg
+
+import (
+	"image"
+
+	"gioui.org/f32"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op/clip"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// ElementEventType is the type of an ElementEvent.
+type ElementEventType uint8
+
+const (
+	// ElementEnter is sent when the pointer enters an element.
+	ElementEnter ElementEventType = iota
+	// ElementLeave is sent when the pointer leaves an element.
+	ElementLeave
+	// ElementPress is sent when the element is pressed.
+	ElementPress
+	// ElementRelease is sent when the press of the element is released,
+	// even if the pointer is no longer over the element.
+	ElementRelease
+	// ElementClick is sent when the element is pressed and released, and
+	// the pointer is still over the same element.
+	ElementClick
+)
+
+func (t ElementEventType) String() string {
+	switch t {
+	case ElementEnter:
+		return "Enter"
+	case ElementLeave:
+		return "Leave"
+	case ElementPress:
+		return "Press"
+	case ElementRelease:
+		return "Release"
+	case ElementClick:
+		return "Click"
+	default:
+		return "<unknown ElementEventType>"
+	}
+}
+
+// ElementEvent is an event of one element of the InteractiveIcon.
+type ElementEvent struct {
+	Type     ElementEventType
+	ID       string    // ID of the element, or the closest group with ID
+	Parents  []string  // Parents holds the IDs of the groups, the closest first
+	Link     string    // Link is the href of the closest <a>, if any
+	Title    string    // Title is the <title> of the element, or of the closest group
+	Position f32.Point // Position of the pointer, relative to the icon
+}
+
+// InteractiveIcon is a DocumentIcon which reports the pointer events
+// of each element with ID, such as seats of a seat-map, and of
+// each link defined by `<a href>`.
+//
+// Gio hit tests the clip areas using their bounds, so the InteractiveIcon uses
+// a single input area and finds the element using Document.HitTest, which
+// uses the exact geometry of the elements. The pointer cursor is set from
+// the CSS `cursor` property of the element under the pointer.
+//
+// Each link is also focusable, using the keyboard, and described to
+// the accessibility services as a button labeled by its title or href.
+type InteractiveIcon struct {
+	// OnLink is called with the href when a link is clicked or
+	// activated using the keyboard, if not nil.
+	OnLink func(href string)
+
+	doc   *Document
+	icon  *DocumentIcon
+	links []*linkArea
+
+	size     image.Point
+	position f32.Point
+	hovered  hit
+	pressed  hit
+	events   []ElementEvent
+}
+
+// hit is the element found by the hit test.
+type hit struct {
+	index int // index of the SvgPath, or -1
+	ids   []string
+	link  *svgparser.Link
+}
+
+func (h hit) id() string {
+	if len(h.ids) == 0 {
+		return ""
+	}
+	return h.ids[0]
+}
+
+// interactive reports whether the element has an ID or a link.
+func (h hit) interactive() bool {
+	return h.id() != "" || h.link != nil
+}
+
+// same reports whether both hits are the same element, or the same link.
+func (h hit) same(o hit) bool {
+	return h.id() == o.id() && h.link == o.link
+}
+
+// linkArea is the keyboard and semantic area of one <a>, its
+// address is used as the tag.
+type linkArea struct {
+	link    *svgparser.Link
+	paths   []int
+	focused bool
+}
+
+// NewInteractiveIcon creates the layout.Widget from the Document.
+func NewInteractiveIcon(doc *Document) *InteractiveIcon {
+	icon := &InteractiveIcon{
+		doc:     doc,
+		icon:    NewDocumentIcon(doc),
+		hovered: hit{index: -1},
+		pressed: hit{index: -1},
+	}
+	for _, link := range doc.render.Links {
+		area := &linkArea{link: link}
+		for i, svgp := range doc.render.SVGPaths {
+			if svgp.Link == link {
+				area.paths = append(area.paths, i)
+			}
+		}
+		if len(area.paths) > 0 {
+			icon.links = append(icon.links, area)
+		}
+	}
+	return icon
+}
+
+// Events returns the events since the last call to Events.
+func (icon *InteractiveIcon) Events(gtx layout.Context) []ElementEvent {
+	icon.update(gtx)
+	events := icon.events
+	icon.events = nil
+	return events
+}
+
+// Hovered returns the ID of the element under the pointer, or an
+// empty string.
+func (icon *InteractiveIcon) Hovered() string {
+	return icon.hovered.id()
+}
+
+// Tooltip returns the <title> of the element under the pointer, and the
+// position of the pointer, relative to the icon. The title is empty if
+// there's no element under the pointer or if the element has no title.
+//
+// The title is found even for elements without ID, such as the
+// bars of a chart:
+//
+//	<rect x="0" y="20" width="10" height="80"><title>January: 80</title></rect>
+func (icon *InteractiveIcon) Tooltip() (title string, position f32.Point) {
+	if icon.hovered.index < 0 {
+		return "", icon.position
+	}
+	return icon.doc.render.SVGPaths[icon.hovered.index].Title, icon.position
+}
+
+// Focused returns the href of the link with the keyboard focus, or an
+// empty string.
+func (icon *InteractiveIcon) Focused() string {
+	for _, area := range icon.links {
+		if area.focused {
+			return area.link.Href
+		}
+	}
+	return ""
+}
+
+// Layout implements widget.Layout.
+// It will render the icon based on the given layout.Constraints.Max.
+func (icon *InteractiveIcon) Layout(gtx layout.Context) layout.Dimensions {
+	icon.update(gtx)
+
+	dims := icon.icon.Layout(gtx)
+	icon.size = dims.Size
+
+	defer clip.Rect{Max: dims.Size}.Push(gtx.Ops).Pop()
+	pointer.InputOp{
+		Tag:   icon,
+		Types: pointer.Enter | pointer.Leave | pointer.Move | pointer.Drag | pointer.Press | pointer.Release | pointer.Cancel,
+	}.Add(gtx.Ops)
+	if icon.hovered.index >= 0 {
+		cursor := icon.doc.render.SVGPaths[icon.hovered.index].Style.Cursor
+		if cursor == "" && icon.hovered.link != nil {
+			cursor = "pointer"
+		}
+		cursorFromCSS(cursor).Add(gtx.Ops)
+	}
+
+	if len(icon.links) > 0 {
+		icon.layoutLinks(gtx)
+	}
+
+	return dims
+}
+
+// layoutLinks adds the keyboard and semantic area of each link, using
+// the bounds of the elements inside the link.
+func (icon *InteractiveIcon) layoutLinks(gtx layout.Context) {
+	render := icon.doc.render
+	overrides, _ := icon.doc.snapshot()
+	options := svgparser.DrawOptions{
+		Transform: render.TargetTransform(0, 0, float64(icon.size.X), float64(icon.size.Y)),
+		Overrides: overrides,
+	}
+
+	for _, area := range icon.links {
+		var bounds f32.Rectangle
+		for _, i := range area.paths {
+			if r := render.PathBounds(i, options); !r.Empty() {
+				if bounds.Empty() {
+					bounds = r
+				} else {
+					bounds = bounds.Union(r)
+				}
+			}
+		}
+		if bounds.Empty() {
+			continue
+		}
+
+		r := image.Rect(int(bounds.Min.X), int(bounds.Min.Y), int(bounds.Max.X+0.5), int(bounds.Max.Y+0.5))
+		stack := clip.Rect(r).Push(gtx.Ops)
+		key.InputOp{Tag: area}.Add(gtx.Ops)
+		semantic.ClassOp(semantic.Button).Add(gtx.Ops)
+		if area.link.Title != "" {
+			semantic.LabelOp(area.link.Title).Add(gtx.Ops)
+			semantic.DescriptionOp(area.link.Href).Add(gtx.Ops)
+		} else {
+			semantic.LabelOp(area.link.Href).Add(gtx.Ops)
+		}
+		stack.Pop()
+	}
+}
+
+// activate calls OnLink with the href of the link.
+func (icon *InteractiveIcon) activate(link *svgparser.Link) {
+	if icon.OnLink != nil {
+		icon.OnLink(link.Href)
+	}
+}
+
+func (icon *InteractiveIcon) update(gtx layout.Context) {
+	for _, area := range icon.links {
+		for _, ev := range gtx.Events(area) {
+			switch e := ev.(type) {
+			case key.FocusEvent:
+				area.focused = e.Focus
+			case key.Event:
+				if e.State != key.Press {
+					continue
+				}
+				switch e.Name {
+				case key.NameReturn, key.NameEnter, key.NameSpace:
+					icon.activate(area.link)
+				}
+			}
+		}
+	}
+
+	for _, ev := range gtx.Events(icon) {
+		e, ok := ev.(pointer.Event)
+		if !ok {
+			continue
+		}
+
+		switch e.Type {
+		case pointer.Enter, pointer.Move, pointer.Drag:
+			icon.hover(e.Position, true)
+		case pointer.Leave:
+			icon.hover(e.Position, false)
+		case pointer.Cancel:
+			icon.hover(e.Position, false)
+			icon.pressed = hit{index: -1}
+		case pointer.Press:
+			icon.hover(e.Position, true)
+			if e.Buttons != pointer.ButtonPrimary && e.Source == pointer.Mouse || !icon.hovered.interactive() {
+				continue
+			}
+			icon.pressed = icon.hovered
+			icon.emit(ElementPress, icon.pressed, e.Position)
+		case pointer.Release:
+			icon.hover(e.Position, true)
+			if !icon.pressed.interactive() {
+				continue
+			}
+			icon.emit(ElementRelease, icon.pressed, e.Position)
+			if icon.pressed.same(icon.hovered) {
+				icon.emit(ElementClick, icon.pressed, e.Position)
+				if icon.pressed.link != nil {
+					icon.activate(icon.pressed.link)
+				}
+			}
+			icon.pressed = hit{index: -1}
+		}
+	}
+}
+
+// hover updates the element under the pointer, if the pointer is inside the icon.
+func (icon *InteractiveIcon) hover(p f32.Point, inside bool) {
+	h := hit{index: -1}
+	if inside {
+		if h.index = icon.doc.hitTest(icon.size, p); h.index >= 0 {
+			h.ids = icon.doc.ids(h.index)
+			h.link = icon.doc.render.SVGPaths[h.index].Link
+		}
+	}
+
+	if !h.same(icon.hovered) {
+		if icon.hovered.interactive() {
+			icon.emit(ElementLeave, icon.hovered, p)
+		}
+		if h.interactive() {
+			icon.emit(ElementEnter, h, p)
+		}
+	}
+	icon.hovered, icon.position = h, p
+}
+
+func (icon *InteractiveIcon) emit(t ElementEventType, h hit, p f32.Point) {
+	e := ElementEvent{Type: t, Position: p}
+	if len(h.ids) > 0 {
+		e.ID, e.Parents = h.ids[0], h.ids[1:]
+	}
+	if h.link != nil {
+		e.Link = h.link.Href
+	}
+	if h.index >= 0 {
+		e.Title = icon.doc.render.SVGPaths[h.index].Title
+	}
+	icon.events = append(icon.events, e)
+}
+
+// cursorFromCSS returns the pointer.Cursor of the given CSS cursor.
+func cursorFromCSS(css string) pointer.Cursor {
+	switch css {
+	case "none":
+		return pointer.CursorNone
+	case "text":
+		return pointer.CursorText
+	case "vertical-text":
+		return pointer.CursorVerticalText
+	case "pointer":
+		return pointer.CursorPointer
+	case "crosshair":
+		return pointer.CursorCrosshair
+	case "move", "all-scroll":
+		return pointer.CursorAllScroll
+	case "col-resize":
+		return pointer.CursorColResize
+	case "row-resize":
+		return pointer.CursorRowResize
+	case "grab":
+		return pointer.CursorGrab
+	case "grabbing":
+		return pointer.CursorGrabbing
+	case "not-allowed", "no-drop":
+		return pointer.CursorNotAllowed
+	case "wait":
+		return pointer.CursorWait
+	case "progress":
+		return pointer.CursorProgress
+	case "nw-resize":
+		return pointer.CursorNorthWestResize
+	case "ne-resize":
+		return pointer.CursorNorthEastResize
+	case "sw-resize":
+		return pointer.CursorSouthWestResize
+	case "se-resize":
+		return pointer.CursorSouthEastResize
+	case "ns-resize":
+		return pointer.CursorNorthSouthResize
+	case "ew-resize":
+		return pointer.CursorEastWestResize
+	case "w-resize":
+		return pointer.CursorWestResize
+	case "e-resize":
+		return pointer.CursorEastResize
+	case "n-resize":
+		return pointer.CursorNorthResize
+	case "s-resize":
+		return pointer.CursorSouthResize
+	case "nesw-resize":
+		return pointer.CursorNorthEastSouthWestResize
+	case "nwse-resize":
+		return pointer.CursorNorthWestSouthEastResize
+	default:
+		return pointer.CursorDefault
+	}
+}
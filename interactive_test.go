@@ -0,0 +1,132 @@
+package giosvg
+
+import (
+	"image"
+	"testing"
+
+	"gioui.org/f32"
+	"gioui.org/io/key"
+	"gioui.org/io/pointer"
+	"gioui.org/io/router"
+	"gioui.org/io/semantic"
+	"gioui.org/layout"
+	"gioui.org/op"
+)
+
+func TestInteractiveIcon(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<g id="row-a" style="cursor: pointer">
+			<circle id="seat-1" cx="25" cy="25" r="20"/>
+			<circle id="seat-2" cx="75" cy="25" r="20"/>
+		</g>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	icon := NewInteractiveIcon(doc)
+	r := new(router.Router)
+	gtx := layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(100, 100)), Queue: r}
+	frame := func() {
+		gtx.Ops.Reset()
+		icon.Layout(gtx)
+		r.Frame(gtx.Ops)
+	}
+
+	frame()
+	r.Queue(
+		pointer.Event{Type: pointer.Move, Source: pointer.Mouse, Position: f32.Pt(25, 25)},
+		pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: f32.Pt(25, 25)},
+		pointer.Event{Type: pointer.Release, Source: pointer.Mouse, Position: f32.Pt(26, 26)},
+		pointer.Event{Type: pointer.Move, Source: pointer.Mouse, Position: f32.Pt(75, 25)},
+		pointer.Event{Type: pointer.Move, Source: pointer.Mouse, Position: f32.Pt(50, 80)},
+	)
+	frame()
+
+	expected := []struct {
+		t  ElementEventType
+		id string
+	}{
+		{ElementEnter, "seat-1"},
+		{ElementPress, "seat-1"},
+		{ElementRelease, "seat-1"},
+		{ElementClick, "seat-1"},
+		{ElementLeave, "seat-1"},
+		{ElementEnter, "seat-2"},
+		{ElementLeave, "seat-2"},
+	}
+	events := icon.Events(gtx)
+	if len(events) != len(expected) {
+		t.Fatalf("expected %d events, got %v", len(expected), events)
+	}
+	for i, e := range expected {
+		if events[i].Type != e.t || events[i].ID != e.id || len(events[i].Parents) != 1 || events[i].Parents[0] != "row-a" {
+			t.Errorf("event %d: expected %v %v, got %v", i, e.t, e.id, events[i])
+		}
+	}
+	if icon.Hovered() != "" {
+		t.Errorf("expected no hovered element, got %v", icon.Hovered())
+	}
+}
+
+func TestInteractiveIconLinks(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink" viewBox="0 0 100 100">
+		<a href="https://example.com/a" title="Example">
+			<rect x="0" y="0" width="40" height="40"/>
+		</a>
+		<a xlink:href="#b"><g><circle cx="75" cy="75" r="20"/></g></a>
+		<rect x="60" y="0" width="40" height="40"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var links []string
+	icon := NewInteractiveIcon(doc)
+	icon.OnLink = func(href string) { links = append(links, href) }
+
+	r := new(router.Router)
+	gtx := layout.Context{Ops: new(op.Ops), Constraints: layout.Exact(image.Pt(100, 100)), Queue: r}
+	frame := func() {
+		gtx.Ops.Reset()
+		icon.Layout(gtx)
+		r.Frame(gtx.Ops)
+	}
+
+	frame()
+	r.Queue(
+		pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: f32.Pt(20, 20)},
+		pointer.Event{Type: pointer.Release, Source: pointer.Mouse, Position: f32.Pt(20, 20)},
+		pointer.Event{Type: pointer.Press, Source: pointer.Mouse, Buttons: pointer.ButtonPrimary, Position: f32.Pt(80, 20)},
+		pointer.Event{Type: pointer.Release, Source: pointer.Mouse, Position: f32.Pt(80, 20)},
+	)
+	frame()
+	if len(links) != 1 || links[0] != "https://example.com/a" {
+		t.Fatalf("expected one click on the first link, got %v", links)
+	}
+	if r.Cursor() != pointer.CursorDefault {
+		t.Errorf("expected the default cursor outside of links, got %v", r.Cursor())
+	}
+
+	r.MoveFocus(router.FocusRight)
+	frame()
+	focused := icon.Focused()
+	if focused == "" {
+		t.Fatal("expected a focused link")
+	}
+	r.Queue(key.Event{Name: key.NameReturn, State: key.Press})
+	frame()
+	if len(links) != 2 || links[1] != focused {
+		t.Fatalf("expected the focused link %v to be activated, got %v", focused, links)
+	}
+
+	var labels []string
+	for _, n := range r.AppendSemantics(nil) {
+		if n.Desc.Class == semantic.Button {
+			labels = append(labels, n.Desc.Label)
+		}
+	}
+	if len(labels) != 2 || labels[0] != "Example" || labels[1] != "#b" {
+		t.Errorf("expected the semantic labels of both links, got %v", labels)
+	}
+}
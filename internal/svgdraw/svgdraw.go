@@ -67,7 +67,7 @@ func (f *filler) Draw(color svgparser.Pattern, opacity float64) {
 		paint.PaintOp{}.Add(f.pathOp)
 	case svgparser.PlainColor:
 		if opacity < 1 {
-			c.NRGBA.A = uint8(math.Round(256 * opacity))
+			c.NRGBA.A = uint8(math.Round(float64(c.NRGBA.A) * opacity))
 		}
 		paint.ColorOp{Color: c.NRGBA}.Add(f.pathOp)
 		paint.PaintOp{}.Add(f.pathOp)
@@ -120,7 +120,7 @@ func (s *stroker) Draw(color svgparser.Pattern, opacity float64) {
 		paint.PaintOp{}.Add(s.pathOp)
 	case svgparser.PlainColor:
 		if opacity < 1 {
-			c.NRGBA.A = uint8(math.Round(256 * opacity))
+			c.NRGBA.A = uint8(math.Round(float64(c.NRGBA.A) * opacity))
 		}
 		paint.ColorOp{Color: c.NRGBA}.Add(s.pathOp)
 		paint.PaintOp{}.Add(s.pathOp)
@@ -0,0 +1,1336 @@
+package svgparser
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gioui.org/f32"
+	"github.com/inkeliz/giosvg/internal/svgparser/simplexml"
+)
+
+// This file implements the SMIL animations, such as <animate> and
+// <animateTransform>. The animations are parsed into a timeline, which is
+// sampled by Animate, returning one Override for each SvgPath.
+
+// Indefinite is the duration of animations which never ends, such
+// as `repeatCount="indefinite"`.
+const Indefinite = time.Duration(math.MaxInt64)
+
+// animationBudget limits how many begin instances are resolved by
+// resolveBegins, and by each call of Animate if they can't be resolved in
+// advance, since `begin="0s;other.end"` may create cycles.
+const animationBudget = 1 << 16
+
+// AnimationKind is the element which defines the Animation.
+type AnimationKind uint8
+
+const (
+	AnimateKind   AnimationKind = iota // <animate>
+	SetKind                            // <set>
+	TransformKind                      // <animateTransform>
+	MotionKind                         // <animateMotion>
+	KeyframesKind                      // CSS @keyframes, used by the `animation` property
+)
+
+// CalcMode is the interpolation between the values of the Animation.
+type CalcMode uint8
+
+const (
+	CalcLinear CalcMode = iota
+	CalcDiscrete
+	CalcPaced
+	CalcSpline
+)
+
+// animationElements are the elements which don't define any style and must
+// not be drawn, the <mpath> is included since it's part of <animateMotion>.
+var animationElements = map[string]AnimationKind{
+	"animate":          AnimateKind,
+	"set":              SetKind,
+	"animateTransform": TransformKind,
+	"animateMotion":    MotionKind,
+	"mpath":            MotionKind,
+}
+
+// Animation is one SMIL animation, parsed into a timeline. The CSS animations
+// are converted to one Animation for each property of the @keyframes.
+type Animation struct {
+	Kind AnimationKind
+	ID   string // ID is the `id`, or the name of the @keyframes
+
+	// Attribute is the `attributeName`, or the `type` of the <animateTransform>,
+	// such as "rotate".
+	Attribute string
+	Values    []string // Values holds the values, from `values` or `from`, `to` and `by`
+	Additive  bool     // Additive is true for `additive="sum"`
+
+	CalcMode   CalcMode
+	KeyTimes   []float64
+	KeySplines [][4]float64
+	KeyPoints  []float64 // KeyPoints is the progress along the path of <animateMotion>
+	Rotate     string    // Rotate is the `rotate` of <animateMotion>
+
+	Begin       []TimeValue
+	Dur         time.Duration // Dur is the simple duration, or Indefinite
+	RepeatCount float64       // RepeatCount is zero if not defined, or -1 if indefinite
+	RepeatDur   time.Duration // RepeatDur is zero if not defined
+	Freeze      bool          // Freeze is true for `fill="freeze"`
+
+	target     *animationTarget
+	targetID   string // targetID is the `href` of the target, resolved after parsing
+	motion     motionPath
+	motionData string // motionData is the `path` attribute, or the path referenced by <mpath>
+	times      []float64
+	instances  []time.Duration // instances are the begin times, see beginTimeline
+
+	// The CSS animations may run backwards, and apply the first value before
+	// they begin. The easing is the timing function of each interval.
+	alternate, reverse, backwards bool
+	easing                        []func(float64) float64
+}
+
+// TimeValue is one value of `begin`, either an offset or an offset
+// relative to the begin or end of another Animation.
+type TimeValue struct {
+	Offset time.Duration
+	Sync   int  // Sync is the index of the other Animation, or -1
+	End    bool // End is true for `other.end`
+
+	syncID string
+}
+
+// animationTarget is the element changed by the animations.
+type animationTarget struct {
+	tag                string
+	attrs              []simplexml.Attr
+	declarations       []simplexml.Attr // declarations are the style declarations, in the cascade order
+	depth              int
+	style, parentStyle PathStyle
+	paths              []int
+	animated           bool
+}
+
+// motionPath is the path of <animateMotion>, flattened.
+type motionPath struct {
+	points  []f32.Point
+	lengths []float64 // lengths is the cumulative length at each point
+}
+
+// shapeAttributes are the attributes which define the geometry of each element.
+var shapeAttributes = map[string][]string{
+	"rect":     {"x", "y", "width", "height", "rx", "ry"},
+	"circle":   {"cx", "cy", "r"},
+	"ellipse":  {"cx", "cy", "rx", "ry"},
+	"line":     {"x1", "y1", "x2", "y2"},
+	"polyline": {"points"},
+	"polygon":  {"points"},
+	"path":     {"d"},
+}
+
+// animationF reads <animate>, <set>, <animateTransform> and <animateMotion>.
+func animationF(kind AnimationKind) svgFunc {
+	return func(c *iconCursor, attrs []simplexml.Attr) error {
+		return c.readAnimation(kind, attrs)
+	}
+}
+
+// mpathF reads the <mpath>, which defines the path of the <animateMotion>.
+func mpathF(c *iconCursor, attrs []simplexml.Attr) error {
+	if len(c.icon.Animations) == 0 || c.icon.Animations[len(c.icon.Animations)-1].Kind != MotionKind {
+		return nil
+	}
+	for _, attr := range attrs {
+		if attr.Name.Local == "href" {
+			c.icon.Animations[len(c.icon.Animations)-1].motionData = attr.Value
+		}
+	}
+	return nil
+}
+
+func (c *iconCursor) readAnimation(kind AnimationKind, attrs []simplexml.Attr) (err error) {
+	a := Animation{Kind: kind, Dur: Indefinite}
+	switch kind {
+	case SetKind:
+		a.CalcMode = CalcDiscrete
+	case MotionKind:
+		a.CalcMode = CalcPaced
+	case TransformKind:
+		a.Attribute = "translate"
+	}
+
+	var from, to, by, values, begin string
+	for _, attr := range attrs {
+		v := strings.TrimSpace(attr.Value)
+		switch attr.Name.Local {
+		case "id":
+			a.ID = v
+		case "href":
+			a.targetID = strings.TrimPrefix(v, "#")
+		case "attributeName":
+			if kind != TransformKind {
+				a.Attribute = v
+			}
+		case "type":
+			if kind == TransformKind {
+				a.Attribute = strings.ToLower(v)
+			}
+		case "from":
+			from = v
+		case "to":
+			to = v
+		case "by":
+			by = v
+		case "values":
+			values = v
+		case "path":
+			a.motionData = v
+		case "rotate":
+			a.Rotate = v
+		case "additive":
+			a.Additive = v == "sum"
+		case "fill":
+			a.Freeze = v == "freeze"
+		case "begin":
+			begin = v
+		case "dur":
+			if v != "media" {
+				a.Dur, err = parseClock(v)
+			}
+		case "repeatCount":
+			if v == "indefinite" {
+				a.RepeatCount = -1
+			} else {
+				a.RepeatCount, err = parseBasicFloat(v)
+			}
+		case "repeatDur":
+			a.RepeatDur, err = parseClock(v)
+		case "calcMode":
+			switch v {
+			case "discrete":
+				a.CalcMode = CalcDiscrete
+			case "linear":
+				a.CalcMode = CalcLinear
+			case "paced":
+				a.CalcMode = CalcPaced
+			case "spline":
+				a.CalcMode = CalcSpline
+			}
+		case "keyTimes":
+			a.KeyTimes, err = parseFloatList(v)
+		case "keyPoints":
+			a.KeyPoints, err = parseFloatList(v)
+		case "keySplines":
+			for _, s := range strings.Split(v, ";") {
+				if s = strings.TrimSpace(s); s == "" {
+					continue
+				}
+				var spline []float64
+				if spline, err = parseFloatList(s); err == nil && len(spline) != 4 {
+					err = errParamMismatch
+				}
+				if err != nil {
+					break
+				}
+				a.KeySplines = append(a.KeySplines, [4]float64{spline[0], spline[1], spline[2], spline[3]})
+			}
+		}
+		if err != nil {
+			return c.handleError("invalid %s of <%s>: %s", attr.Name.Local, kind, err)
+		}
+	}
+	if a.Dur <= 0 {
+		a.Dur = Indefinite
+	}
+
+	if begin == "" {
+		begin = "0s"
+	}
+	for _, b := range strings.Split(begin, ";") {
+		if v, ok := parseTimeValue(strings.TrimSpace(b)); ok {
+			a.Begin = append(a.Begin, v)
+		}
+	}
+
+	if a.targetID == "" && len(c.elementStack) >= 2 {
+		a.target = c.elementTarget(len(c.elementStack) - 2)
+		a.target.animated = true
+	}
+
+	// The base value is used by animations without `from`, or `values`.
+	base := func() string {
+		if kind == TransformKind {
+			if a.Attribute == "scale" {
+				return "1"
+			}
+			return "0"
+		}
+		if a.target == nil {
+			return ""
+		}
+		return a.target.value(a.Attribute)
+	}
+	switch {
+	case values != "":
+		for _, v := range strings.Split(values, ";") {
+			if v = strings.TrimSpace(v); v != "" {
+				a.Values = append(a.Values, v)
+			}
+		}
+	case kind == SetKind:
+		a.Values = []string{to}
+	case to != "":
+		if from == "" {
+			from = base()
+		}
+		a.Values = []string{from, to}
+	case by != "":
+		if from == "" {
+			from = base()
+		}
+		a.Values = []string{from, interpolateValue(from, by, 1, 1)}
+	}
+	// An <animateMotion> without values uses the path, which may
+	// be defined by <mpath>, read later.
+	if len(a.Values) == 0 && kind != MotionKind {
+		return c.handleError("<%s> without values", kind)
+	}
+
+	c.icon.Animations = append(c.icon.Animations, a)
+	return nil
+}
+
+func (k AnimationKind) String() string {
+	switch k {
+	case AnimateKind:
+		return "animate"
+	case SetKind:
+		return "set"
+	case TransformKind:
+		return "animateTransform"
+	case MotionKind:
+		return "animateMotion"
+	case KeyframesKind:
+		return "@keyframes"
+	default:
+		return "<unknown AnimationKind>"
+	}
+}
+
+// elementTarget returns the animationTarget of the element at the given
+// index of the elementStack, creating it if needed.
+func (c *iconCursor) elementTarget(i int) *animationTarget {
+	e := &c.elementStack[i]
+	if e.target == nil {
+		e.target = c.newTarget(i)
+	}
+	return e.target
+}
+
+// newTarget creates the animationTarget of the element at the given index of
+// the elementStack. The styleStack has the default style at the bottom, so
+// the style of the element is at i+1.
+func (c *iconCursor) newTarget(i int) *animationTarget {
+	e := c.elementStack[i]
+	return &animationTarget{
+		tag:          e.tag,
+		attrs:        e.attrs,
+		declarations: e.declarations,
+		depth:        i,
+		style:        c.styleStack[i+1],
+		parentStyle:  c.styleStack[i],
+	}
+}
+
+// value returns the value of the attribute, or property, of the target. It
+// returns an empty string if not defined.
+func (t *animationTarget) value(name string) (value string) {
+	value, _ = declarationValue(t.declarations, name)
+	return value
+}
+
+// shape reports whether the target is a single shape, such as <circle>,
+// so the geometry can be animated.
+func (t *animationTarget) shape() bool {
+	_, ok := shapeAttributes[t.tag]
+	return ok && len(t.paths) == 1
+}
+
+// resolveAnimations resolves the references by ID, and computes
+// the timing of the animations. It's called after parsing the document.
+func (c *iconCursor) resolveAnimations() {
+	s := c.icon
+	if len(s.Animations) == 0 {
+		return
+	}
+
+	ids := make(map[string]int, len(s.Animations))
+	for i, a := range s.Animations {
+		if a.ID != "" {
+			ids[a.ID] = i
+		}
+	}
+
+	for i := range s.Animations {
+		a := &s.Animations[i]
+
+		begin := a.Begin[:0]
+		for _, b := range a.Begin {
+			if b.syncID != "" {
+				sync, ok := ids[b.syncID]
+				if !ok {
+					continue
+				}
+				b.Sync = sync
+			}
+			begin = append(begin, b)
+		}
+		a.Begin = begin
+
+		if a.target == nil && a.targetID != "" {
+			a.target = c.targets[a.targetID]
+		}
+		if a.Kind == MotionKind {
+			a.motion = c.readMotionPath(a)
+		}
+		a.times = a.keyTimes()
+	}
+
+	s.resolveBegins()
+	s.animationEnd = s.computeAnimationEnd()
+}
+
+// readMotionPath returns the path of the <animateMotion>, from `path`, <mpath> or `values`.
+func (c *iconCursor) readMotionPath(a *Animation) (m motionPath) {
+	var path Path
+	switch {
+	case strings.HasPrefix(a.motionData, "#"):
+		id := a.motionData[1:]
+		for _, svgp := range c.icon.SVGPaths {
+			if svgp.ID == id {
+				path = svgp.Path
+				break
+			}
+		}
+		if defs, ok := c.icon.defs[id]; ok && path == nil && len(defs) > 0 && defs[0].Tag == "path" {
+			for _, attr := range defs[0].Attrs {
+				if attr.Name.Local == "d" && c.compilePath(attr.Value) == nil {
+					path = append(Path{}, c.path...)
+				}
+			}
+			c.path = c.path[:0]
+		}
+	case a.motionData != "":
+		if c.compilePath(a.motionData) == nil {
+			path = append(Path{}, c.path...)
+		}
+		c.path = c.path[:0]
+	default:
+		for _, v := range a.Values {
+			if p, err := parseFloatList(v); err == nil && len(p) == 2 {
+				m.add(f32.Pt(float32(p[0]), float32(p[1])))
+			}
+		}
+		return m
+	}
+
+	path.flatten(0.1, false, func(a, b f32.Point) {
+		if len(m.points) == 0 || m.points[len(m.points)-1] != a {
+			m.add(a)
+		}
+		m.add(b)
+	})
+	return m
+}
+
+func (m *motionPath) add(p f32.Point) {
+	var length float64
+	if n := len(m.points); n > 0 {
+		length = m.lengths[n-1] + math.Hypot(float64(p.X-m.points[n-1].X), float64(p.Y-m.points[n-1].Y))
+	}
+	m.points, m.lengths = append(m.points, p), append(m.lengths, length)
+}
+
+// at returns the point at the given fraction of the length, and the
+// direction of the path at that point.
+func (m motionPath) at(fraction float64) (p f32.Point, angle float64) {
+	n := len(m.points)
+	if n == 0 {
+		return p, 0
+	}
+	if n == 1 {
+		return m.points[0], 0
+	}
+	distance := math.Max(0, math.Min(1, fraction)) * m.lengths[n-1]
+	i := sort.SearchFloat64s(m.lengths, distance)
+	if i < 1 {
+		i = 1
+	}
+	if i >= n {
+		i = n - 1
+	}
+	a, b := m.points[i-1], m.points[i]
+	var t float32
+	if span := m.lengths[i] - m.lengths[i-1]; span > 0 {
+		t = float32((distance - m.lengths[i-1]) / span)
+	}
+	return a.Add(b.Sub(a).Mul(t)), math.Atan2(float64(b.Y-a.Y), float64(b.X-a.X))
+}
+
+// count returns the number of values of the animation.
+func (a *Animation) count() int {
+	switch {
+	case a.Kind == MotionKind && len(a.KeyPoints) > 0:
+		return len(a.KeyPoints)
+	case a.Kind == MotionKind && len(a.Values) == 0:
+		return 2 // from the start to the end of the path
+	default:
+		return len(a.Values)
+	}
+}
+
+// keyTimes returns the `keyTimes`, or the default key times based on the
+// calcMode and the number of values.
+func (a *Animation) keyTimes() []float64 {
+	n := a.count()
+	if n == 0 {
+		return nil
+	}
+	if len(a.KeyTimes) == n && a.CalcMode != CalcPaced {
+		return a.KeyTimes
+	}
+	times := make([]float64, n)
+	if a.CalcMode == CalcDiscrete {
+		for i := range times {
+			times[i] = float64(i) / float64(n)
+		}
+		return times
+	}
+	if n == 1 {
+		return times
+	}
+
+	if a.CalcMode == CalcPaced {
+		// The time of each interval is proportional to the distance of the values.
+		var total float64
+		for i := 1; i < n; i++ {
+			total += a.distance(i - 1)
+			times[i] = total
+		}
+		if total > 0 {
+			for i := range times {
+				times[i] /= total
+			}
+			return times
+		}
+	}
+	for i := range times {
+		times[i] = float64(i) / float64(n-1)
+	}
+	return times
+}
+
+// distance returns the distance between the value i and the next value.
+func (a *Animation) distance(i int) float64 {
+	if a.Kind == MotionKind && len(a.KeyPoints) == 0 {
+		if len(a.Values) == 0 {
+			return 1
+		}
+		ka, _ := parseFloatList(a.Values[i])
+		kb, _ := parseFloatList(a.Values[i+1])
+		return distanceNumbers(ka, kb)
+	}
+	if a.Kind == MotionKind {
+		return math.Abs(a.KeyPoints[i+1] - a.KeyPoints[i])
+	}
+	if ca, ok := parsePlainColor(a.Values[i]); ok {
+		if cb, ok := parsePlainColor(a.Values[i+1]); ok {
+			return math.Sqrt(sq(float64(ca.R)-float64(cb.R)) + sq(float64(ca.G)-float64(cb.G)) + sq(float64(ca.B)-float64(cb.B)))
+		}
+	}
+	na, _ := splitNumbers(a.Values[i])
+	nb, _ := splitNumbers(a.Values[i+1])
+	if len(na) != len(nb) {
+		return 0
+	}
+	return distanceNumbers(na, nb)
+}
+
+func distanceNumbers(a, b []float64) float64 {
+	var d float64
+	for i := range a {
+		if i < len(b) {
+			d += sq(b[i] - a[i])
+		}
+	}
+	return math.Sqrt(d)
+}
+
+func sq(v float64) float64 { return v * v }
+
+// activeDuration returns the duration of all repetitions of the animation.
+func (a *Animation) activeDuration() time.Duration {
+	d := a.Dur
+	switch {
+	case a.RepeatCount < 0:
+		d = Indefinite
+	case a.RepeatCount > 0 && d != Indefinite:
+		d = time.Duration(float64(d) * a.RepeatCount)
+	case a.RepeatCount == 0 && a.RepeatDur != 0:
+		d = a.RepeatDur
+	}
+	if a.RepeatDur != 0 && a.RepeatDur < d {
+		d = a.RepeatDur
+	}
+	return d
+}
+
+// beginTimeline describes the begin instances of all animations, resolved
+// once by resolveBegins. Otherwise, the chains such as `a.end` and `b.end`
+// would be resolved again since the start of the document by each call of
+// Animate, which gets slower as the time goes.
+type beginTimeline struct {
+	resolved bool          // resolved is false if the Animation.instances are unknown
+	complete bool          // complete is true if there's no instance after the horizon
+	horizon  time.Duration // horizon is the time until which the instances are known
+	period   time.Duration // period is how the instances repeat after the horizon, or zero
+}
+
+// resolveBegins resolves the begin instances of the animations, in the order
+// of the time, until they repeat. The instances repeat when the pending
+// instances, relative to the current time, are the same as before, such as
+// `begin="0s;b.end"` and `begin="a.end"`.
+func (s *SVGRender) resolveBegins() {
+	type event struct {
+		t     time.Duration
+		index int
+	}
+	type dependent struct {
+		index int
+		delay time.Duration
+	}
+
+	s.begins = beginTimeline{}
+	var pending []event
+	insert := func(e event) {
+		i := sort.Search(len(pending), func(i int) bool {
+			return pending[i].t > e.t || pending[i].t == e.t && pending[i].index >= e.index
+		})
+		if i < len(pending) && pending[i] == e {
+			return
+		}
+		pending = append(pending, event{})
+		copy(pending[i+1:], pending[i:])
+		pending[i] = e
+	}
+	dependents := make([][]dependent, len(s.Animations))
+	for i := range s.Animations {
+		a := &s.Animations[i]
+		a.instances = nil
+		for _, b := range a.Begin {
+			if b.Sync < 0 {
+				insert(event{t: b.Offset, index: i})
+				continue
+			}
+			delay := b.Offset
+			if b.End {
+				d := s.Animations[b.Sync].activeDuration()
+				if d == Indefinite {
+					continue
+				}
+				delay += d
+			}
+			if delay < 0 {
+				// The instance is before the instance which creates it, so
+				// it can't be resolved in the order of the time.
+				return
+			}
+			dependents[b.Sync] = append(dependents[b.Sync], dependent{index: i, delay: delay})
+		}
+	}
+
+	seen := make(map[string]time.Duration)
+	var key strings.Builder
+	for n := 0; len(pending) > 0; n++ {
+		if n >= animationBudget {
+			s.begins.resolved = true
+			return
+		}
+		e := pending[0]
+		pending = pending[1:]
+		a := &s.Animations[e.index]
+		if k := len(a.instances); k == 0 || a.instances[k-1] != e.t {
+			a.instances = append(a.instances, e.t)
+			for _, d := range dependents[e.index] {
+				insert(event{t: e.t + d.delay, index: d.index})
+			}
+		}
+		if len(pending) > 0 && pending[0].t == e.t {
+			continue
+		}
+
+		// All instances until now are known, and the next ones depend only
+		// on the pending instances.
+		s.begins.horizon = e.t
+		key.Reset()
+		for _, p := range pending {
+			key.WriteString(strconv.FormatInt(int64(p.t-e.t), 36))
+			key.WriteByte(':')
+			key.WriteString(strconv.Itoa(p.index))
+			key.WriteByte(';')
+		}
+		if previous, ok := seen[key.String()]; ok {
+			s.begins.resolved, s.begins.period = true, e.t-previous
+			return
+		}
+		seen[key.String()] = e.t
+	}
+	s.begins.resolved, s.begins.complete = true, true
+}
+
+// resolvedBegin returns the last instance of the animation until the time
+// `t`, from the beginTimeline. It returns false if the animation didn't begin.
+func (a *Animation) resolvedBegin(timeline beginTimeline, t time.Duration) (time.Duration, bool) {
+	last := func(t time.Duration) (time.Duration, bool) {
+		i := sort.Search(len(a.instances), func(i int) bool { return a.instances[i] > t })
+		if i == 0 {
+			return 0, false
+		}
+		return a.instances[i-1], true
+	}
+	if timeline.period == 0 || t <= timeline.horizon {
+		return last(t)
+	}
+
+	// The instances after the start repeat every period, so the time is
+	// moved back by k periods, between the start and the horizon.
+	start, period := timeline.horizon-timeline.period, timeline.period
+	k := (t - timeline.horizon + period - 1) / period
+	if begin, ok := last(t - k*period); ok && begin > start {
+		return begin + k*period, true
+	}
+	if begin, ok := last(timeline.horizon); ok && begin > start {
+		return begin + (k-1)*period, true
+	}
+	return last(start)
+}
+
+// lastBegin returns the last time the animation begins until the time `t`. It returns
+// false if the animation didn't begin, or if the budget is exhausted.
+func (a *Animation) lastBegin(s *SVGRender, t time.Duration, budget *int) (begin time.Duration, ok bool) {
+	if b := s.begins; b.resolved && (b.complete || b.period > 0 || t <= b.horizon) {
+		return a.resolvedBegin(b, t)
+	}
+	if *budget--; *budget <= 0 {
+		return 0, false
+	}
+	for _, b := range a.Begin {
+		v := b.Offset
+		if b.Sync >= 0 {
+			if t < 0 {
+				// The other animation can't begin before the start of the document.
+				continue
+			}
+			sync := &s.Animations[b.Sync]
+			var duration time.Duration
+			if b.End {
+				if duration = sync.activeDuration(); duration == Indefinite {
+					continue
+				}
+			}
+			syncBegin, found := sync.lastBegin(s, t-b.Offset-duration, budget)
+			if !found {
+				continue
+			}
+			v = syncBegin + duration + b.Offset
+		}
+		if v <= t && (!ok || v > begin) {
+			begin, ok = v, true
+		}
+	}
+	return begin, ok
+}
+
+// progress returns the progress, between 0 and 1, of the simple duration at
+// the time `t`. It returns false if the animation has no effect at that time.
+func (a *Animation) progress(s *SVGRender, t time.Duration, budget *int) (float64, bool) {
+	begin, ok := a.lastBegin(s, t, budget)
+	if !ok {
+		if a.backwards {
+			return a.direction(0, 0), true
+		}
+		return 0, false
+	}
+
+	local, active := t-begin, a.activeDuration()
+	if active != Indefinite && local >= active {
+		if !a.Freeze {
+			return 0, false
+		}
+		if a.Dur == Indefinite {
+			return 0, true
+		}
+		if active > 0 && active%a.Dur == 0 {
+			return a.direction(1, int64(active/a.Dur)-1), true
+		}
+		return a.direction(float64(active%a.Dur)/float64(a.Dur), int64(active/a.Dur)), true
+	}
+	if a.Dur == Indefinite {
+		return 0, true
+	}
+	return a.direction(float64(local%a.Dur)/float64(a.Dur), int64(local/a.Dur)), true
+}
+
+// direction returns the progress of the given iteration, which is
+// reversed by the `animation-direction`.
+func (a *Animation) direction(p float64, iteration int64) float64 {
+	reverse := a.reverse
+	if a.alternate && iteration%2 == 1 {
+		reverse = !reverse
+	}
+	if reverse {
+		return 1 - p
+	}
+	return p
+}
+
+// interval returns the index of the value at the given progress, and the
+// progress between that value and the next one.
+func (a *Animation) interval(p float64) (i int, t float64) {
+	n := len(a.times)
+	if n <= 1 {
+		return 0, 0
+	}
+	if a.CalcMode == CalcDiscrete {
+		for i = n - 1; i > 0 && a.times[i] > p; i-- {
+		}
+		return i, 0
+	}
+	if p >= 1 {
+		return n - 2, 1
+	}
+	for i = 0; i < n-2 && a.times[i+1] <= p; i++ {
+	}
+	if span := a.times[i+1] - a.times[i]; span > 0 {
+		t = math.Max(0, math.Min(1, (p-a.times[i])/span))
+	}
+	if a.CalcMode == CalcSpline && i < len(a.KeySplines) {
+		t = keySpline(a.KeySplines[i], t)
+	}
+	if i < len(a.easing) && a.easing[i] != nil {
+		t = a.easing[i](t)
+	}
+	return i, t
+}
+
+// value returns the value of the <animate> or <set> at the given progress.
+func (a *Animation) value(p float64) string {
+	if len(a.Values) == 0 {
+		return ""
+	}
+	i, t := a.interval(p)
+	if a.CalcMode == CalcDiscrete || i+1 >= len(a.Values) {
+		return a.Values[i]
+	}
+	return interpolateValue(a.Values[i], a.Values[i+1], 1-t, t)
+}
+
+// transform returns the matrix of the <animateTransform> at the given progress.
+func (a *Animation) transform(p float64) (Matrix2D, bool) {
+	if len(a.Values) == 0 {
+		return Identity, false
+	}
+	i, t := a.interval(p)
+	values, err := parseFloatList(a.Values[i])
+	if err != nil {
+		return Identity, false
+	}
+	if a.CalcMode != CalcDiscrete && i+1 < len(a.Values) {
+		next, err := parseFloatList(a.Values[i+1])
+		if err != nil {
+			return Identity, false
+		}
+		for len(values) < len(next) {
+			values = append(values, 0)
+		}
+		for j := range values {
+			if j < len(next) {
+				values[j] += (next[j] - values[j]) * t
+			}
+		}
+	}
+	if a.Attribute == "scale" && len(values) == 1 {
+		values = append(values, values[0])
+	}
+
+	c := iconCursor{}
+	c.points = values
+	m, err := c.readTransformAttr(Identity, a.Attribute)
+	return m, err == nil
+}
+
+// motionTransform returns the matrix of the <animateMotion> at the given progress.
+func (a *Animation) motionTransform(p float64) Matrix2D {
+	var point f32.Point
+	var angle float64
+	switch {
+	case len(a.KeyPoints) > 0:
+		i, t := a.interval(p)
+		fraction := a.KeyPoints[i]
+		if a.CalcMode != CalcDiscrete && i+1 < len(a.KeyPoints) {
+			fraction += (a.KeyPoints[i+1] - fraction) * t
+		}
+		point, angle = a.motion.at(fraction)
+	case len(a.Values) > 0 && a.CalcMode != CalcPaced && len(a.motion.points) == len(a.Values):
+		i, t := a.interval(p)
+		point = a.motion.points[i]
+		if i+1 < len(a.motion.points) {
+			next := a.motion.points[i+1]
+			if a.CalcMode != CalcDiscrete {
+				point = point.Add(next.Sub(point).Mul(float32(t)))
+			}
+			angle = math.Atan2(float64(next.Y-a.motion.points[i].Y), float64(next.X-a.motion.points[i].X))
+		}
+	default:
+		point, angle = a.motion.at(p)
+	}
+
+	switch a.Rotate {
+	case "auto":
+	case "auto-reverse":
+		angle += math.Pi
+	default:
+		degrees, _ := parseBasicFloat(a.Rotate)
+		angle = degrees * math.Pi / 180
+	}
+	return Identity.Translate(float64(point.X), float64(point.Y)).Rotate(angle)
+}
+
+// keySpline returns the progress of the cubic bezier defined by the control
+// points (0,0), (x1,y1), (x2,y2) and (1,1), at the time t.
+func keySpline(s [4]float64, t float64) float64 {
+	bezier := func(a, b, u float64) float64 {
+		mu := 1 - u
+		return 3*mu*mu*u*a + 3*mu*u*u*b + u*u*u
+	}
+	lo, hi := 0.0, 1.0
+	for i := 0; i < 32; i++ {
+		mid := (lo + hi) / 2
+		if bezier(s[0], s[2], mid) < t {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return bezier(s[1], s[3], (lo+hi)/2)
+}
+
+// animationState holds the values of all animations of one target.
+type animationState struct {
+	target    *animationTarget
+	attrs     []simplexml.Attr
+	transform Matrix2D
+	motion    Matrix2D
+	changed   bool // changed is true if the transform or motion is animated
+}
+
+// AnimationEnd returns the time when all animations end, or Indefinite.
+func (s *SVGRender) AnimationEnd() time.Duration {
+	return s.animationEnd
+}
+
+func (s *SVGRender) computeAnimationEnd() (end time.Duration) {
+	if s.begins.period > 0 {
+		return Indefinite
+	}
+	budget := animationBudget
+	for i := range s.Animations {
+		a := &s.Animations[i]
+		begin, ok := a.lastBegin(s, Indefinite/2, &budget)
+		if budget <= 0 {
+			return Indefinite
+		}
+		if !ok {
+			continue
+		}
+		active := a.activeDuration()
+		if active == Indefinite {
+			return Indefinite
+		}
+		if begin+active > end {
+			end = begin + active
+		}
+	}
+	return end
+}
+
+// Animate returns the Overrides of all SvgPath at the time `t`, which
+// can be used by DrawOptions. It returns nil if the SVG has no animation.
+func (s *SVGRender) Animate(t time.Duration) []Override {
+	if len(s.Animations) == 0 {
+		return nil
+	}
+
+	budget := animationBudget
+	var states []*animationState
+	state := func(target *animationTarget) *animationState {
+		for _, st := range states {
+			if st.target == target {
+				return st
+			}
+		}
+		// The base transform is the own transform of the element.
+		st := &animationState{target: target, transform: target.parentStyle.Transform.Invert().Mult(target.style.Transform), motion: Identity}
+		states = append(states, st)
+		return st
+	}
+
+	for i := range s.Animations {
+		a := &s.Animations[i]
+		if a.target == nil || len(a.target.paths) == 0 {
+			continue
+		}
+		p, ok := a.progress(s, t, &budget)
+		if !ok {
+			continue
+		}
+
+		st := state(a.target)
+		switch a.Kind {
+		case AnimateKind, SetKind, KeyframesKind:
+			st.attrs = append(st.attrs, simplexml.Attr{Name: simplexml.Name{Local: a.Attribute}, Value: a.value(p)})
+		case TransformKind:
+			m, ok := a.transform(p)
+			if !ok {
+				continue
+			}
+			if a.Additive {
+				st.transform = st.transform.Mult(m)
+			} else {
+				st.transform = m
+			}
+			st.changed = true
+		case MotionKind:
+			st.motion, st.changed = a.motionTransform(p), true
+		}
+	}
+
+	overrides := make([]Override, len(s.SVGPaths))
+	for i := range overrides {
+		overrides[i] = DefaultOverride
+	}
+
+	// The outer elements are applied first, so the transform of the
+	// inner elements are relative to the animated outer elements.
+	sort.SliceStable(states, func(i, j int) bool { return states[i].target.depth < states[j].target.depth })
+	for _, st := range states {
+		s.applyAnimation(st, overrides)
+	}
+	return overrides
+}
+
+// applyAnimation changes the overrides of the paths of the target.
+func (s *SVGRender) applyAnimation(st *animationState, overrides []Override) {
+	target := st.target
+
+	if st.changed {
+		// The element transform is replaced by the motion and the animated transform:
+		//	parent * motion * transform * inverse(parent * own)
+		parent := target.parentStyle.Transform
+		if det := target.style.Transform.A*target.style.Transform.D - target.style.Transform.B*target.style.Transform.C; det != 0 {
+			m := parent.Mult(st.motion).Mult(st.transform).Mult(target.style.Transform.Invert())
+			for _, i := range target.paths {
+				overrides[i].Transform = overrides[i].Transform.Mult(m)
+			}
+		}
+	}
+	if len(st.attrs) == 0 {
+		return
+	}
+
+	var hidden bool
+	for _, attr := range st.attrs {
+		switch attr.Name.Local {
+		case "visibility":
+			hidden = attr.Value == "hidden" || attr.Value == "collapse"
+		case "display":
+			hidden = attr.Value == "none"
+		}
+	}
+
+	// The animated attributes replace the original ones, instead of being
+	// composed with them, such as the opacity.
+	attrs := append(withoutAttrs(target.attrs, st.attrs), st.attrs...)
+	declarations := append(withoutAttrs(target.declarations, st.attrs), st.attrs...)
+	c := &iconCursor{icon: s, styleStack: []PathStyle{target.parentStyle}}
+	if err := c.pushStyle(target.tag, declarations); err != nil {
+		return
+	}
+	style := c.styleStack[len(c.styleStack)-1]
+
+	for _, attr := range st.attrs {
+		if attr.Name.Local != "transform" && attr.Name.Local != "transform-origin" {
+			continue
+		}
+		// The CSS transform replaces the own transform of the element.
+		if det := target.style.Transform.A*target.style.Transform.D - target.style.Transform.B*target.style.Transform.C; det != 0 {
+			m := style.Transform.Mult(target.style.Transform.Invert())
+			for _, i := range target.paths {
+				overrides[i].Transform = overrides[i].Transform.Mult(m)
+			}
+		}
+		break
+	}
+
+	if target.shape() {
+		i := target.paths[0]
+		style.Transform = s.SVGPaths[i].Style.Transform
+		overrides[i].Style, overrides[i].Hidden = &style, overrides[i].Hidden || hidden
+		for _, attr := range st.attrs {
+			if !isShapeAttribute(target.tag, attr.Name.Local) {
+				continue
+			}
+			c.path = c.path[:0]
+			if drawFuncs[target.tag](c, attrs) == nil {
+				overrides[i].Path = append(Path{}, c.path...)
+			}
+			break
+		}
+		return
+	}
+
+	// The elements inside groups may define their own style, so only the
+	// animated attributes are changed, and the opacity is composed.
+	for _, i := range target.paths {
+		pathStyle := s.SVGPaths[i].Style
+		if overrides[i].Style != nil {
+			pathStyle = *overrides[i].Style
+		}
+		for _, attr := range st.attrs {
+			switch attr.Name.Local {
+			case "opacity", "fill-opacity", "stroke-opacity":
+				if target.style.FillOpacity > 0 {
+					pathStyle.FillOpacity *= style.FillOpacity / target.style.FillOpacity
+				} else {
+					pathStyle.FillOpacity = style.FillOpacity
+				}
+				if target.style.LineOpacity > 0 {
+					pathStyle.LineOpacity *= style.LineOpacity / target.style.LineOpacity
+				} else {
+					pathStyle.LineOpacity = style.LineOpacity
+				}
+			default:
+				_ = c.readStyleAttr(&pathStyle, attr.Name.Local, attr.Value)
+			}
+		}
+		overrides[i].Style, overrides[i].Hidden = &pathStyle, overrides[i].Hidden || hidden
+	}
+}
+
+// withoutAttrs returns a copy of the attributes, without those which have
+// the name of one of the replaced attributes.
+func withoutAttrs(attrs, replaced []simplexml.Attr) []simplexml.Attr {
+	out := make([]simplexml.Attr, 0, len(attrs)+len(replaced))
+	for _, attr := range attrs {
+		if _, ok := declarationValue(replaced, attr.Name.Local); !ok {
+			out = append(out, attr)
+		}
+	}
+	return out
+}
+
+func isShapeAttribute(tag, name string) bool {
+	for _, v := range shapeAttributes[tag] {
+		if v == name {
+			return true
+		}
+	}
+	return false
+}
+
+// parseClock parses a SMIL clock value, such as "1.5s", "500ms" or "00:01.5".
+func parseClock(v string) (time.Duration, error) {
+	v = strings.TrimSpace(v)
+	if v == "indefinite" {
+		return Indefinite, nil
+	}
+	if strings.Contains(v, ":") {
+		var seconds float64
+		for _, part := range strings.Split(v, ":") {
+			n, err := strconv.ParseFloat(part, 64)
+			if err != nil {
+				return 0, err
+			}
+			seconds = seconds*60 + n
+		}
+		return time.Duration(seconds * float64(time.Second)), nil
+	}
+
+	unit := time.Second
+	for _, suffix := range []struct {
+		name string
+		unit time.Duration
+	}{{"ms", time.Millisecond}, {"min", time.Minute}, {"h", time.Hour}, {"s", time.Second}} {
+		if strings.HasSuffix(v, suffix.name) {
+			v, unit = strings.TrimSuffix(v, suffix.name), suffix.unit
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(n * float64(unit)), nil
+}
+
+// parseTimeValue parses one value of `begin`. It returns false for values
+// which are not supported, such as events and `indefinite`.
+func parseTimeValue(v string) (TimeValue, bool) {
+	t := TimeValue{Sync: -1}
+	if v == "" || v == "indefinite" {
+		return t, false
+	}
+	if c := v[0]; c >= '0' && c <= '9' || c == '+' || c == '-' || c == '.' {
+		offset, err := parseClock(strings.TrimPrefix(v, "+"))
+		t.Offset = offset
+		return t, err == nil
+	}
+
+	for _, sync := range []string{".begin", ".end"} {
+		i := strings.Index(v, sync)
+		if i <= 0 {
+			continue
+		}
+		t.syncID, t.End = v[:i], sync == ".end"
+		if rest := strings.Join(strings.Fields(v[i+len(sync):]), ""); rest != "" {
+			offset, err := parseClock(strings.TrimPrefix(rest, "+"))
+			if err != nil {
+				return t, false
+			}
+			t.Offset = offset
+		}
+		return t, true
+	}
+	return t, false
+}
+
+// parseFloatList parses a list of numbers separated by commas, spaces
+// or semicolons, as used by `keyTimes`.
+func parseFloatList(v string) ([]float64, error) {
+	var list []float64
+	for _, s := range splitOnCommaOrSpace(strings.Replace(v, ";", " ", -1)) {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		f, err := parseBasicFloat(s)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, f)
+	}
+	if len(list) == 0 {
+		return nil, errors.New("empty list")
+	}
+	return list, nil
+}
+
+// parsePlainColor parses the color, it returns false if the value is not a color.
+func parsePlainColor(v string) (PlainColor, bool) {
+	if v == "" {
+		return PlainColor{}, false
+	}
+	c, err := parseSVGColor(v)
+	if err != nil || !c.valid || strings.HasPrefix(strings.ToLower(v), "url") {
+		return PlainColor{}, false
+	}
+	return c.color, true
+}
+
+// interpolateValue returns `a*wa + b*wb`. Colors are interpolated for each channel,
+// and other values, such as path data, are interpolated number by number if
+// both have the same structure. Otherwise, the closest value is returned.
+func interpolateValue(a, b string, wa, wb float64) string {
+	if ca, ok := parsePlainColor(a); ok {
+		if cb, ok := parsePlainColor(b); ok {
+			channel := func(x, y uint8) int {
+				return int(math.Round(math.Max(0, math.Min(255, float64(x)*wa+float64(y)*wb))))
+			}
+			return "rgb(" + strconv.Itoa(channel(ca.R, cb.R)) + "," + strconv.Itoa(channel(ca.G, cb.G)) + "," + strconv.Itoa(channel(ca.B, cb.B)) + ")"
+		}
+	}
+
+	na, ta := splitNumbers(a)
+	nb, tb := splitNumbers(b)
+	if len(na) == 0 || len(na) != len(nb) || strings.Join(ta, "") != strings.Join(tb, "") {
+		if wb > wa {
+			return b
+		}
+		return a
+	}
+
+	var out strings.Builder
+	for i, n := range na {
+		out.WriteString(ta[i])
+		out.WriteString(strconv.FormatFloat(n*wa+nb[i]*wb, 'f', -1, 64))
+	}
+	out.WriteString(ta[len(na)])
+	return out.String()
+}
+
+// splitNumbers splits the value into the numbers and the text between
+// them, there's always one text more than numbers.
+func splitNumbers(v string) (numbers []float64, texts []string) {
+	start := 0
+	for i := 0; i < len(v); {
+		end := scanNumber(v, i)
+		if end == i {
+			i++
+			continue
+		}
+		n, err := strconv.ParseFloat(v[i:end], 64)
+		if err != nil {
+			i = end
+			continue
+		}
+		numbers, texts = append(numbers, n), append(texts, v[start:i])
+		i, start = end, end
+	}
+	return numbers, append(texts, v[start:])
+}
+
+// scanNumber returns the end of the number starting at i, or i if
+// there's no number at i.
+func scanNumber(v string, i int) int {
+	digit := func(i int) bool { return i < len(v) && v[i] >= '0' && v[i] <= '9' }
+	start := i
+	if i < len(v) && (v[i] == '+' || v[i] == '-') {
+		i++
+	}
+	digits := false
+	for digit(i) {
+		i, digits = i+1, true
+	}
+	if i < len(v) && v[i] == '.' && digit(i+1) {
+		i++
+		for digit(i) {
+			i, digits = i+1, true
+		}
+	}
+	if !digits {
+		return start
+	}
+	if i < len(v) && (v[i] == 'e' || v[i] == 'E') {
+		j := i + 1
+		if j < len(v) && (v[j] == '+' || v[j] == '-') {
+			j++
+		}
+		if digit(j) {
+			for i = j; digit(i); i++ {
+			}
+		}
+	}
+	return i
+}
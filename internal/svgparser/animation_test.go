@@ -0,0 +1,287 @@
+package svgparser
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func readAnimatedIcon(t *testing.T, svg string) *SVGRender {
+	t.Helper()
+	icon, err := ReadIcon(strings.NewReader(svg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return icon
+}
+
+func TestParseClock(t *testing.T) {
+	for _, d := range []struct {
+		s   string
+		val time.Duration
+	}{
+		{s: "2", val: 2 * time.Second},
+		{s: "1.5s", val: 1500 * time.Millisecond},
+		{s: "250ms", val: 250 * time.Millisecond},
+		{s: "2min", val: 2 * time.Minute},
+		{s: "1h", val: time.Hour},
+		{s: "01:30", val: 90 * time.Second},
+		{s: "01:00:02.5", val: time.Hour + 2500*time.Millisecond},
+		{s: "indefinite", val: Indefinite},
+	} {
+		value, err := parseClock(d.s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != d.val {
+			t.Errorf("for %s, expected %v, got %v", d.s, d.val, value)
+		}
+	}
+}
+
+func TestInterpolateValue(t *testing.T) {
+	for _, d := range []struct {
+		a, b, val string
+	}{
+		{a: "0", b: "10", val: "2.5"},
+		{a: "red", b: "blue", val: "rgb(191,0,64)"},
+		{a: "M0 0 L10 10", b: "M10 10 L30 -10", val: "M2.5 2.5 L15 5"},
+		{a: "M0 0 L10 10", b: "M0 0 H10", val: "M0 0 L10 10"},
+		{a: "hidden", b: "visible", val: "hidden"},
+	} {
+		if value := interpolateValue(d.a, d.b, 0.75, 0.25); value != d.val {
+			t.Errorf("for %s and %s, expected %s, got %s", d.a, d.b, d.val, value)
+		}
+	}
+}
+
+func TestAnimate(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect x="0" y="0" width="10" height="10">
+			<animate attributeName="opacity" from="0" to="1" dur="1s" fill="freeze"/>
+		</rect>
+		<rect x="0" y="0" width="10" height="10">
+			<animateTransform attributeName="transform" type="rotate" from="0 5 5" to="360 5 5" dur="2s" repeatCount="indefinite"/>
+		</rect>
+		<circle cx="50" cy="50" r="0">
+			<animate attributeName="r" values="0;10;0" keyTimes="0;0.5;1" dur="1s" begin="1s"/>
+		</circle>
+		<rect width="10" height="10">
+			<set attributeName="visibility" to="hidden" begin="0.5s"/>
+		</rect>
+	</svg>`)
+
+	if len(icon.SVGPaths) != 4 || len(icon.Animations) != 4 {
+		t.Fatalf("expected 4 paths and 4 animations, got %d and %d", len(icon.SVGPaths), len(icon.Animations))
+	}
+	if end := icon.AnimationEnd(); end != Indefinite {
+		t.Errorf("expected an indefinite animation, got %v", end)
+	}
+
+	overrides := icon.Animate(250 * time.Millisecond)
+	if o := overrides[0].Style.FillOpacity; !almostEqual(o, 0.25) {
+		t.Errorf("expected opacity 0.25, got %v", o)
+	}
+	if x, y := overrides[1].Transform.Transform(10, 5); math.Abs(x-(5+5/math.Sqrt2)) > 1e-9 || math.Abs(y-(5+5/math.Sqrt2)) > 1e-9 {
+		t.Errorf("expected the point rotated by 45 degrees around the center, got %v, %v", x, y)
+	}
+	if overrides[2].Path != nil || len(icon.SVGPaths[2].Path) != 0 {
+		t.Errorf("expected an empty circle before the animation begins")
+	}
+	if overrides[3].Hidden {
+		t.Errorf("expected a visible element before the <set> begins")
+	}
+
+	overrides = icon.Animate(1250 * time.Millisecond)
+	if o := overrides[0].Style.FillOpacity; !almostEqual(o, 1) {
+		t.Errorf("expected the frozen opacity 1, got %v", o)
+	}
+	if bounds := icon.PathBounds(2, DrawOptions{Transform: Identity, Overrides: overrides}); math.Abs(float64(bounds.Dx())-10) > 0.1 {
+		t.Errorf("expected the circle with radius 5, got %v", bounds)
+	}
+	if !overrides[3].Hidden {
+		t.Errorf("expected the element hidden by <set>")
+	}
+
+	overrides = icon.Animate(3 * time.Second)
+	if overrides[2].Path != nil {
+		t.Errorf("expected the circle without the animation, after the end")
+	}
+}
+
+func TestAnimateOpacity(t *testing.T) {
+	// The animated opacity replaces the opacity of the element.
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect width="10" height="10" opacity="0.5" fill-opacity="0.5">
+			<animate attributeName="opacity" from="1" to="0" dur="1s"/>
+		</rect>
+		<g opacity="0">
+			<animate attributeName="opacity" from="0" to="1" dur="1s"/>
+			<rect width="10" height="10"/>
+		</g>
+		<g opacity="0.5">
+			<animate attributeName="opacity" from="1" to="0" dur="1s"/>
+			<rect width="10" height="10" opacity="0.5"/>
+		</g>
+	</svg>`)
+
+	overrides := icon.Animate(250 * time.Millisecond)
+	for i, opacity := range []float64{0.375, 0.25, 0.375} {
+		if o := overrides[i].Style.FillOpacity; !almostEqual(o, opacity) {
+			t.Errorf("path %d: expected opacity %v, got %v", i, opacity, o)
+		}
+	}
+}
+
+func TestAnimateTiming(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect id="box" width="10" height="10"/>
+		<animate id="first" href="#box" attributeName="x" from="0" to="10" dur="1s" begin="0s"/>
+		<animate id="second" href="#box" attributeName="x" from="20" to="30" dur="1s" begin="first.end+0.5s" repeatCount="2"/>
+		<animate href="#box" attributeName="fill" values="red;blue" dur="1s" begin="second.end" calcMode="discrete"/>
+	</svg>`)
+
+	if end := icon.AnimationEnd(); end != 4500*time.Millisecond {
+		t.Errorf("expected the end at 4.5s, got %v", end)
+	}
+
+	for _, d := range []struct {
+		t      time.Duration
+		x      float64
+		fill   PlainColor
+		static bool
+	}{
+		{t: 500 * time.Millisecond, x: 5},
+		{t: 1250 * time.Millisecond, static: true},
+		{t: 1750 * time.Millisecond, x: 22.5},
+		{t: 2750 * time.Millisecond, x: 22.5},
+		{t: 3750 * time.Millisecond, fill: NewPlainColor(0xff, 0, 0, 0xff)},
+		{t: 4250 * time.Millisecond, fill: NewPlainColor(0, 0, 0xff, 0xff)},
+		{t: 5 * time.Second, static: true},
+	} {
+		overrides := icon.Animate(d.t)
+		o := overrides[0]
+		if d.static {
+			if o.Path != nil || o.Style != nil {
+				t.Errorf("at %v: expected no animation", d.t)
+			}
+			continue
+		}
+		if o.Style == nil {
+			t.Errorf("at %v: expected the animation", d.t)
+			continue
+		}
+		if d.fill != (PlainColor{}) {
+			if o.Path != nil || o.Style.FillerColor != d.fill {
+				t.Errorf("at %v: expected only the fill %v, got %v", d.t, d.fill, o.Style.FillerColor)
+			}
+			continue
+		}
+		if x := float64(icon.PathBounds(0, DrawOptions{Transform: Identity, Overrides: overrides}).Min.X); !almostEqual(x, d.x) {
+			t.Errorf("at %v: expected x = %v, got %v", d.t, d.x, x)
+		}
+	}
+}
+
+func TestAnimateMotion(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<path id="track" d="M0 0 H100 V100" fill="none"/>
+		<rect x="-1" y="-1" width="2" height="2">
+			<animateMotion dur="2s" rotate="auto" keySplines="0.5 0 0.5 1" calcMode="paced">
+				<mpath href="#track"/>
+			</animateMotion>
+		</rect>
+	</svg>`)
+
+	for _, d := range []struct {
+		t    time.Duration
+		x, y float64
+	}{
+		{t: 500 * time.Millisecond, x: 50, y: 0},
+		{t: 1500 * time.Millisecond, x: 100, y: 50},
+	} {
+		o := icon.Animate(d.t)[1]
+		if x, y := o.Transform.Transform(0, 0); math.Abs(x-d.x) > 1e-3 || math.Abs(y-d.y) > 1e-3 {
+			t.Errorf("at %v: expected %v, %v, got %v, %v", d.t, d.x, d.y, x, y)
+		}
+	}
+
+	// The rotation follows the direction of the path.
+	o := icon.Animate(1500 * time.Millisecond)[1]
+	if x, y := o.Transform.Transform(1, 0); math.Abs(x-100) > 1e-3 || math.Abs(y-51) > 1e-3 {
+		t.Errorf("expected the rotated point at 100, 51, got %v, %v", x, y)
+	}
+}
+
+func TestAnimateCycle(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect width="10" height="10">
+			<animate id="a" attributeName="x" from="0" to="10" dur="1s" begin="0s;b.end"/>
+			<animate id="b" attributeName="x" from="50" to="60" dur="1s" begin="a.end"/>
+		</rect>
+	</svg>`)
+
+	if end := icon.AnimationEnd(); end != Indefinite {
+		t.Errorf("expected an indefinite animation, got %v", end)
+	}
+	for _, d := range []struct {
+		t time.Duration
+		x float64
+	}{
+		{t: 500 * time.Millisecond, x: 5},
+		{t: 1500 * time.Millisecond, x: 55},
+		{t: 2500 * time.Millisecond, x: 5},
+		{t: time.Hour + 1500*time.Millisecond, x: 55},
+		{t: 48*time.Hour + 500*time.Millisecond, x: 5},
+		{t: 48*time.Hour + 1500*time.Millisecond, x: 55},
+	} {
+		overrides := icon.Animate(d.t)
+		if x := float64(icon.PathBounds(0, DrawOptions{Transform: Identity, Overrides: overrides}).Min.X); !almostEqual(x, d.x) {
+			t.Errorf("at %v: expected x = %v, got %v", d.t, d.x, x)
+		}
+	}
+}
+
+func TestAnimateBeginTimeline(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect width="10" height="10">
+			<animate id="a" attributeName="x" from="0" to="10" dur="1s" begin="0.5s;c.end+0.25s"/>
+			<animate id="b" attributeName="y" from="0" to="10" dur="0.5s" begin="a.begin+0.2s;2s"/>
+			<animate id="c" attributeName="width" from="10" to="20" dur="0.75s" begin="a.end"/>
+			<animate attributeName="height" from="10" to="20" dur="1s" begin="3s"/>
+		</rect>
+	</svg>`)
+	if end := icon.AnimationEnd(); end != Indefinite {
+		t.Errorf("expected an indefinite animation, got %v", end)
+	}
+	if period := icon.begins.period; period != 2*time.Second {
+		t.Fatalf("expected the period of 2s, got %v", period)
+	}
+
+	// The instances of the timeline are the same resolved by each call.
+	resolved := icon.begins
+	for at := -time.Second; at < 20*time.Second; at += 50 * time.Millisecond {
+		for i := range icon.Animations {
+			a := &icon.Animations[i]
+			icon.begins = resolved
+			expected, expectedOk := a.resolvedBegin(resolved, at)
+			icon.begins = beginTimeline{}
+			budget := animationBudget
+			got, ok := a.lastBegin(icon, at, &budget)
+			if got != expected || ok != expectedOk {
+				t.Errorf("animation %d at %v: expected %v %v, got %v %v", i, at, expected, expectedOk, got, ok)
+			}
+		}
+	}
+	icon.begins = resolved
+
+	// The same instant of the period gives the same result, however long
+	// the animation runs.
+	at := 10*time.Second + 300*time.Millisecond
+	expected := icon.PathBounds(0, DrawOptions{Transform: Identity, Overrides: icon.Animate(at)})
+	got := icon.PathBounds(0, DrawOptions{Transform: Identity, Overrides: icon.Animate(at + 48*time.Hour)})
+	if got != expected {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,551 @@
+package svgparser
+
+import (
+	"math"
+	"sort"
+
+	"gioui.org/f32"
+)
+
+// BooleanOp is an operation between the areas of two paths, see Path.Boolean.
+type BooleanOp uint8
+
+const (
+	Union        BooleanOp = iota // the area of any path
+	Intersection                  // the area of both paths
+	Difference                    // the area of the first path, outside the second
+	Xor                           // the area of only one path
+)
+
+func (op BooleanOp) String() string {
+	switch op {
+	case Union:
+		return "Union"
+	case Intersection:
+		return "Intersection"
+	case Difference:
+		return "Difference"
+	case Xor:
+		return "Xor"
+	default:
+		return "<unknown BooleanOp>"
+	}
+}
+
+// inside reports if the point is in the result, given if it's in the
+// area of each path.
+func (op BooleanOp) inside(a, b bool) bool {
+	switch op {
+	case Union:
+		return a || b
+	case Intersection:
+		return a && b
+	case Difference:
+		return a && !b
+	default:
+		return a != b
+	}
+}
+
+// Union returns the area of both paths, see Boolean.
+func (p Path) Union(q Path, tolerance float64) Path { return p.Boolean(Union, q, tolerance) }
+
+// Intersection returns the area common to both paths, see Boolean.
+func (p Path) Intersection(q Path, tolerance float64) Path {
+	return p.Boolean(Intersection, q, tolerance)
+}
+
+// Difference returns the area of the path outside `q`, see Boolean.
+func (p Path) Difference(q Path, tolerance float64) Path { return p.Boolean(Difference, q, tolerance) }
+
+// Xor returns the area of only one of the paths, see Boolean.
+func (p Path) Xor(q Path, tolerance float64) Path { return p.Boolean(Xor, q, tolerance) }
+
+// Boolean returns the path of the area resulting from the operation between
+// the areas of the paths, both filled using the non-zero winding rule. Use
+// NonZero first for the paths filled using the even-odd rule.
+//
+// The curves are flattened within the tolerance to find the intersections,
+// but the parts of the curves kept in the result remain curves. The result
+// has only closed sub-paths, and the same area using either fill rule: the
+// sub-paths around holes have the opposite orientation.
+func (p Path) Boolean(op BooleanOp, q Path, tolerance float64) Path {
+	if tolerance <= 0 {
+		tolerance = dashTolerance
+	}
+	b := &booleanBuilder{grid: tolerance / 1024, offset: tolerance / 16}
+	b.add(0, p, tolerance)
+	b.add(1, q, tolerance)
+	b.split()
+	b.classify(op)
+	return b.chain()
+}
+
+// boolPoint is a point with float64 precision.
+type boolPoint struct{ x, y float64 }
+
+func (a boolPoint) sub(b boolPoint) boolPoint { return boolPoint{a.x - b.x, a.y - b.y} }
+
+func (a boolPoint) lerp(b boolPoint, t float64) boolPoint {
+	return boolPoint{a.x + (b.x-a.x)*t, a.y + (b.y-a.y)*t}
+}
+
+func boolCross(a, b boolPoint) float64 { return a.x*b.y - a.y*b.x }
+
+func boolDot(a, b boolPoint) float64 { return a.x*b.x + a.y*b.y }
+
+// boolCurve is a curve of one of the paths, with 3 points for quadratic
+// curves and 4 points for cubic curves.
+type boolCurve struct {
+	points []boolPoint
+}
+
+// point returns the point of the curve at t.
+func (c boolCurve) point(t float64) boolPoint {
+	ts := make([]float64, len(c.points)-1)
+	for i := range ts {
+		ts[i] = t
+	}
+	return c.at(ts...)
+}
+
+// at returns the blossom of the curve. The control points of the part of
+// the curve between t0 and t1 are the blossoms of (t0, t0, t0), (t0, t0, t1),
+// (t0, t1, t1) and (t1, t1, t1), for cubic curves.
+func (c boolCurve) at(ts ...float64) boolPoint {
+	points := append([]boolPoint(nil), c.points...)
+	for level, t := range ts {
+		for i := 0; i < len(points)-1-level; i++ {
+			points[i] = points[i].lerp(points[i+1], t)
+		}
+	}
+	return points[0]
+}
+
+// boolEdge is a line of the flattened paths. The lines which approximate a
+// curve have the parameters of the curve at their ends.
+type boolEdge struct {
+	a, b    boolPoint
+	operand int
+	curve   int // index in booleanBuilder.curves, or -1 for lines
+	t0, t1  float64
+}
+
+type boolSplit struct {
+	t float64
+	p boolPoint
+}
+
+// booleanBuilder computes the result of Path.Boolean.
+type booleanBuilder struct {
+	grid   float64 // points are snapped to the grid, so equal points are equal
+	offset float64 // distance of the points used to find the area at both sides of each edge
+
+	curves   []boolCurve
+	operands [2][]boolEdge // the edges of each path, to compute the winding
+	edges    []boolEdge    // the edges of both paths, split at the intersections
+}
+
+func (b *booleanBuilder) snap(p f32.Point) boolPoint {
+	return boolPoint{math.Round(float64(p.X)/b.grid) * b.grid, math.Round(float64(p.Y)/b.grid) * b.grid}
+}
+
+func (b *booleanBuilder) snapPoint(p boolPoint) boolPoint {
+	return boolPoint{math.Round(p.x/b.grid) * b.grid, math.Round(p.y/b.grid) * b.grid}
+}
+
+// add flattens the path, closing all sub-paths.
+func (b *booleanBuilder) add(operand int, p Path, tolerance float64) {
+	var start, last boolPoint
+	open := false
+	edge := func(e boolEdge) {
+		if e.a != e.b {
+			e.operand = operand
+			b.operands[operand] = append(b.operands[operand], e)
+		}
+	}
+	closePath := func() {
+		if open {
+			edge(boolEdge{a: last, b: start, curve: -1})
+		}
+		open = false
+	}
+	curve := func(points ...f32.Point) {
+		c := boolCurve{points: []boolPoint{last}}
+		for _, p := range points {
+			c.points = append(c.points, boolPoint{float64(p.X), float64(p.Y)})
+		}
+		// The number of lines is given by the second differences of the
+		// control points (Wang's formula).
+		var dd float64
+		for i := 0; i+2 < len(c.points); i++ {
+			d := c.points[i].sub(c.points[i+1]).sub(c.points[i+1].sub(c.points[i+2]))
+			dd = math.Max(dd, math.Hypot(d.x, d.y))
+		}
+		degree := float64(len(points))
+		n := int(math.Ceil(math.Sqrt(degree * (degree - 1) / 8 * dd / tolerance)))
+		if n < 1 {
+			n = 1
+		} else if n > 1000 {
+			n = 1000
+		}
+		index := len(b.curves)
+		b.curves = append(b.curves, c)
+		end := b.snap(points[len(points)-1])
+		for i := 1; i <= n; i++ {
+			t0, t1 := float64(i-1)/float64(n), float64(i)/float64(n)
+			next := end
+			if i < n {
+				next = b.snapPoint(c.point(t1))
+			}
+			edge(boolEdge{a: last, b: next, curve: index, t0: t0, t1: t1})
+			last = next
+		}
+	}
+
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			closePath()
+			start, last = b.snap(f32.Point(op)), b.snap(f32.Point(op))
+			open = true
+		case OpLineTo:
+			next := b.snap(f32.Point(op))
+			edge(boolEdge{a: last, b: next, curve: -1})
+			last, open = next, true
+		case OpQuadTo:
+			curve(op[0], op[1])
+			open = true
+		case OpCubicTo:
+			curve(op[0], op[1], op[2])
+			open = true
+		case OpClose:
+			closePath()
+			last = start
+		}
+	}
+	closePath()
+}
+
+// split splits the edges at the intersections, and at the ends of other
+// edges which touch them.
+func (b *booleanBuilder) split() {
+	edges := append(append([]boolEdge(nil), b.operands[0]...), b.operands[1]...)
+	splits := make([][]boolSplit, len(edges))
+
+	// The edges are sorted by the minimum x, so only the edges which
+	// overlap horizontally are compared.
+	order := make([]int, len(edges))
+	for i := range order {
+		order[i] = i
+	}
+	minX := func(e boolEdge) float64 { return math.Min(e.a.x, e.b.x) }
+	maxX := func(e boolEdge) float64 { return math.Max(e.a.x, e.b.x) }
+	sort.Slice(order, func(i, j int) bool { return minX(edges[order[i]]) < minX(edges[order[j]]) })
+
+	for n, i := range order {
+		e := edges[i]
+		for _, j := range order[n+1:] {
+			f := edges[j]
+			if minX(f) > maxX(e) {
+				break
+			}
+			if math.Min(f.a.y, f.b.y) > math.Max(e.a.y, e.b.y) || math.Max(f.a.y, f.b.y) < math.Min(e.a.y, e.b.y) {
+				continue
+			}
+			b.intersect(e, f, &splits[i], &splits[j])
+		}
+	}
+
+	for i, e := range edges {
+		list := splits[i]
+		if len(list) == 0 {
+			b.edges = append(b.edges, e)
+			continue
+		}
+		sort.Slice(list, func(i, j int) bool { return list[i].t < list[j].t })
+		last, lastT := e.a, 0.0
+		for _, s := range append(list, boolSplit{t: 1, p: e.b}) {
+			if s.p == last {
+				continue
+			}
+			part := e
+			part.a, part.b = last, s.p
+			part.t0, part.t1 = e.t0+(e.t1-e.t0)*lastT, e.t0+(e.t1-e.t0)*s.t
+			b.edges = append(b.edges, part)
+			last, lastT = s.p, s.t
+		}
+	}
+}
+
+// intersect adds the points where the edges cross or touch each other.
+func (b *booleanBuilder) intersect(e, f boolEdge, se, sf *[]boolSplit) {
+	r, s := e.b.sub(e.a), f.b.sub(f.a)
+	lr, ls := math.Hypot(r.x, r.y), math.Hypot(s.x, s.y)
+
+	// onEdge adds the point p, which is on the line of the edge, if it's
+	// between the ends of the edge.
+	onEdge := func(e boolEdge, r boolPoint, length float64, p boolPoint, splits *[]boolSplit) {
+		if p == e.a || p == e.b {
+			return
+		}
+		t := boolDot(p.sub(e.a), r) / (length * length)
+		if t*length > b.grid && (1-t)*length > b.grid {
+			*splits = append(*splits, boolSplit{t: t, p: p})
+		}
+	}
+
+	d := boolCross(r, s)
+	if math.Abs(d) <= 1e-12*lr*ls {
+		// Parallel edges only touch if they are on the same line.
+		if math.Abs(boolCross(f.a.sub(e.a), r))/lr > b.grid {
+			return
+		}
+		onEdge(e, r, lr, f.a, se)
+		onEdge(e, r, lr, f.b, se)
+		onEdge(f, s, ls, e.a, sf)
+		onEdge(f, s, ls, e.b, sf)
+		return
+	}
+
+	qp := f.a.sub(e.a)
+	t, u := boolCross(qp, s)/d, boolCross(qp, r)/d
+	et, eu := b.grid/lr, b.grid/ls
+	if t < -et || t > 1+et || u < -eu || u > 1+eu {
+		return
+	}
+	// The ends of the edges are kept exact, since they are shared by
+	// the adjacent edges.
+	var p boolPoint
+	switch {
+	case t <= et:
+		p = e.a
+	case t >= 1-et:
+		p = e.b
+	case u <= eu:
+		p = f.a
+	case u >= 1-eu:
+		p = f.b
+	default:
+		p = b.snapPoint(e.a.lerp(e.b, t))
+	}
+	onEdge(e, r, lr, p, se)
+	onEdge(f, s, ls, p, sf)
+}
+
+// winding returns the winding number of the point, for the edges of the
+// operand.
+func (b *booleanBuilder) winding(operand int, p boolPoint) (w int) {
+	for _, e := range b.operands[operand] {
+		if e.a.y <= p.y {
+			if e.b.y > p.y && boolCross(e.b.sub(e.a), p.sub(e.a)) > 0 {
+				w++
+			}
+		} else if e.b.y <= p.y && boolCross(e.b.sub(e.a), p.sub(e.a)) < 0 {
+			w--
+		}
+	}
+	return w
+}
+
+// classify keeps the edges between the inside and the outside of the
+// result, oriented so the inside is at the left.
+func (b *booleanBuilder) classify(op BooleanOp) {
+	type key struct{ a, b boolPoint }
+	seen := make(map[key]bool, len(b.edges))
+
+	kept := b.edges[:0]
+	for _, e := range b.edges {
+		d := e.b.sub(e.a)
+		l := math.Hypot(d.x, d.y)
+		offset := math.Min(b.offset, l/4)
+		m := e.a.lerp(e.b, 0.5)
+		n := boolPoint{-d.y / l * offset, d.x / l * offset}
+		left, right := boolPoint{m.x + n.x, m.y + n.y}, boolPoint{m.x - n.x, m.y - n.y}
+
+		in := func(p boolPoint) bool {
+			return op.inside(b.winding(0, p) != 0, b.winding(1, p) != 0)
+		}
+		inLeft, inRight := in(left), in(right)
+		if inLeft == inRight {
+			continue
+		}
+		if inRight {
+			e.a, e.b, e.t0, e.t1 = e.b, e.a, e.t1, e.t0
+		}
+		// Equal edges of both paths are kept once.
+		if k := (key{e.a, e.b}); !seen[k] {
+			seen[k] = true
+			kept = append(kept, e)
+		}
+	}
+	b.edges = kept
+}
+
+// chain joins the edges into closed sub-paths, replacing the lines which
+// approximate a curve by the curve.
+func (b *booleanBuilder) chain() (out Path) {
+	outgoing := make(map[boolPoint][]int, len(b.edges))
+	for i, e := range b.edges {
+		outgoing[e.a] = append(outgoing[e.a], i)
+	}
+	used := make([]bool, len(b.edges))
+
+	for i := range b.edges {
+		if used[i] {
+			continue
+		}
+		var loop []boolEdge
+		for next := i; next >= 0; {
+			used[next] = true
+			e := b.edges[next]
+			loop = append(loop, e)
+			if e.b == b.edges[i].a {
+				break
+			}
+			// The edge which continues the same curve is preferred,
+			// so the curves are kept whole.
+			next = -1
+			for _, j := range outgoing[e.b] {
+				if used[j] {
+					continue
+				}
+				if next < 0 || b.continues(e, b.edges[j]) {
+					next = j
+				}
+			}
+		}
+		b.emit(&out, loop)
+	}
+	return out
+}
+
+// continues reports if the edge f follows e on the same curve.
+func (b *booleanBuilder) continues(e, f boolEdge) bool {
+	return e.curve >= 0 && e.curve == f.curve && e.operand == f.operand && e.t1 == f.t0
+}
+
+// emit adds the closed loop of edges to the path.
+func (b *booleanBuilder) emit(out *Path, loop []boolEdge) {
+	var area float64
+	for _, e := range loop {
+		area += boolCross(e.a, e.b)
+	}
+	if math.Abs(area) <= b.grid*b.grid {
+		return
+	}
+
+	// The loop starts at the start of a curve, if possible, so the
+	// curve isn't split by the start of the loop.
+	for k := range loop {
+		if !b.continues(loop[(k+len(loop)-1)%len(loop)], loop[k]) {
+			loop = append(loop[k:], loop[:k]...)
+			break
+		}
+	}
+
+	pt := func(p boolPoint) f32.Point { return f32.Pt(float32(p.x), float32(p.y)) }
+	out.Start(pt(loop[0].a))
+	for i := 0; i < len(loop); {
+		e := loop[i]
+		if e.curve < 0 {
+			if i < len(loop)-1 {
+				out.Line(pt(e.b))
+			}
+			i++
+			continue
+		}
+		// The consecutive edges of the same curve are replaced by the
+		// part of the curve between their ends.
+		j := i + 1
+		for j < len(loop) && b.continues(loop[j-1], loop[j]) {
+			j++
+		}
+		t0, t1, end := e.t0, loop[j-1].t1, loop[j-1].b
+		c := b.curves[e.curve]
+		if len(c.points) == 3 {
+			out.QuadBezier(pt(c.at(t0, t1)), pt(end))
+		} else {
+			out.CubeBezier(pt(c.at(t0, t0, t1)), pt(c.at(t0, t1, t1)), pt(end))
+		}
+		i = j
+	}
+	out.Stop(true)
+}
+
+// PathArea returns the area drawn by the SvgPath at the given index, in the
+// coordinates of the DrawOptions.Transform: the union of the fill, using the
+// fill rule of the style, and the outline of the stroke. It returns nil if
+// the SvgPath is hidden, or if nothing is drawn.
+func (s *SVGRender) PathArea(index int, o DrawOptions, tolerance float64) Path {
+	override := DefaultOverride
+	if o.Overrides != nil {
+		override = o.Overrides[index]
+	}
+	path, style := s.SVGPaths[index].resolved(override)
+	if override.Hidden || len(path) == 0 {
+		return nil
+	}
+	fill, stroke := override.patterns(style)
+
+	m := o.Transform.Mult(override.Transform).Mult(style.Transform)
+	var area Path
+	if fill != nil {
+		area = path.Transform(m)
+		if !style.UseNonZeroWinding {
+			area = area.NonZero(tolerance)
+		}
+	}
+	if stroke != nil && style.LineWidth > 0 {
+		scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+		if scale == 0 {
+			return area
+		}
+		strokePath := path
+		if len(style.Dash.Dash) > 0 {
+			strokePath = path.dash(style.Dash, dashTolerance/scale)
+		}
+		outline := strokePath.Transform(m).StrokeOutline(style.LineWidth*scale, style.strokeJoin(), tolerance)
+		area = append(area, outline...)
+	}
+	if len(area) == 0 {
+		return nil
+	}
+	return area.Union(nil, tolerance)
+}
+
+// Area returns the union of the PathArea of all SvgPath, which is the area
+// drawn by the SVGRender.
+func (s *SVGRender) Area(o DrawOptions, tolerance float64) (area Path) {
+	for i := range s.SVGPaths {
+		area = append(area, s.PathArea(i, o, tolerance)...)
+	}
+	if len(area) == 0 {
+		return nil
+	}
+	return area.Union(nil, tolerance)
+}
+
+// CombinedPath returns the path of the SvgPath at the given index, after the
+// Override, combined with the shape by the operation. The shape and the
+// tolerance are in the coordinates of the viewBox, but the result is in the
+// coordinates of the SvgPath, so it can be used as the Override.Path. The
+// paths filled using the even-odd rule are converted by NonZero first.
+func (s *SVGRender) CombinedPath(index int, override Override, op BooleanOp, shape Path, tolerance float64) Path {
+	path, style := s.SVGPaths[index].resolved(override)
+	m := override.Transform.Mult(style.Transform)
+	scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+	if scale == 0 {
+		return path
+	}
+	tolerance /= scale
+	if !style.UseNonZeroWinding {
+		path = path.NonZero(tolerance)
+	}
+	// The result is never nil, since the nil Override.Path keeps the
+	// original path.
+	if path = path.Boolean(op, shape.Transform(m.Invert()), tolerance); path == nil {
+		path = Path{}
+	}
+	return path
+}
@@ -0,0 +1,102 @@
+package svgparser
+
+import (
+	"math"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+// pathArea returns the area of the path filled using the non-zero rule,
+// which must not have self-intersections.
+func pathArea(p Path) (area float64) {
+	for _, polygon := range p.Polygons(0.001) {
+		area += polygonArea(polygon) / 2
+	}
+	return area
+}
+
+func TestBoolean(t *testing.T) {
+	var a, b, c, d, e Path
+	a.RoundRect(0, 0, 10, 10, 0, 0)
+	b.RoundRect(5, 5, 15, 15, 0, 0)
+	c.Ellipse(50, 50, 20, 20)
+	d.Ellipse(60, 50, 10, 10)
+	e.RoundRect(20, 20, 30, 30, 0, 0)
+
+	circle := math.Pi * 400
+	for _, x := range []struct {
+		name   string
+		op     BooleanOp
+		p, q   Path
+		area   float64
+		bounds f32.Rectangle
+	}{
+		{"squares", Union, a, b, 175, f32.Rect(0, 0, 15, 15)},
+		{"squares", Intersection, a, b, 25, f32.Rect(5, 5, 10, 10)},
+		{"squares", Difference, a, b, 75, f32.Rect(0, 0, 10, 10)},
+		{"squares", Xor, a, b, 150, f32.Rect(0, 0, 15, 15)},
+		{"circles", Union, c, d, circle, f32.Rect(30, 30, 70, 70)},
+		{"circles", Intersection, c, d, circle / 4, f32.Rect(50, 40, 70, 60)},
+		{"circles", Difference, c, d, circle * 3 / 4, f32.Rect(30, 30, 70, 70)},
+		{"same", Union, a, a, 100, f32.Rect(0, 0, 10, 10)},
+		{"same", Difference, a, a, 0, f32.Rectangle{}},
+		{"disjoint", Union, a, e, 200, f32.Rect(0, 0, 30, 30)},
+		{"disjoint", Intersection, a, e, 0, f32.Rectangle{}},
+		{"empty", Union, a, nil, 100, f32.Rect(0, 0, 10, 10)},
+		{"empty", Difference, nil, a, 0, f32.Rectangle{}},
+	} {
+		r := x.p.Boolean(x.op, x.q, 0.01)
+		if area := pathArea(r); math.Abs(area-x.area) > x.area*1e-3+1e-3 {
+			t.Errorf("%s %v: expected the area %v, got %v: %v", x.name, x.op, x.area, area, r)
+		}
+		if bounds := r.Bounds(Identity); !nearRect(bounds, x.bounds, 1e-3) {
+			t.Errorf("%s %v: expected the bounds %v, got %v", x.name, x.op, x.bounds, bounds)
+		}
+	}
+}
+
+func TestBooleanCurves(t *testing.T) {
+	var bell, dot Path
+	bell.Ellipse(0, 0, 10, 10)
+	dot.Ellipse(8, -8, 4, 4)
+
+	r := bell.Difference(dot, 0.01)
+	for _, op := range r {
+		if _, ok := op.(OpLineTo); ok {
+			t.Fatalf("expected the curves to be preserved, got %v", r)
+		}
+	}
+
+	// The curves of the result must be on the original circles.
+	for _, polygon := range r.Polygons(0.001) {
+		for _, p := range polygon {
+			r1 := math.Hypot(float64(p.X), float64(p.Y))
+			r2 := math.Hypot(float64(p.X-8), float64(p.Y+8))
+			if math.Abs(r1-10) > 0.01 && math.Abs(r2-4) > 0.01 {
+				t.Errorf("unexpected point %v", p)
+			}
+		}
+	}
+}
+
+func TestBooleanSelfUnion(t *testing.T) {
+	// Overlapping sub-paths of the same path are merged by the union with
+	// nothing, and the holes are kept.
+	var p Path
+	p.RoundRect(0, 0, 10, 10, 0, 0)
+	p.RoundRect(5, 0, 15, 10, 0, 0)
+	p.Start(f32.Pt(1, 4))
+	p.Line(f32.Pt(1, 6))
+	p.Line(f32.Pt(4, 6))
+	p.Line(f32.Pt(4, 4))
+	p.Stop(true)
+
+	r := p.Union(nil, 0.01)
+	if area := pathArea(r); math.Abs(area-144) > 1e-3 {
+		t.Errorf("expected the area 144, got %v: %v", area, r)
+	}
+	if area := pathArea(r.NonZero(0.01)); math.Abs(area-144) > 1e-3 {
+		t.Errorf("expected the same area using the even-odd rule, got %v", area)
+	}
+}
@@ -0,0 +1,162 @@
+package svgparser
+
+import (
+	"math"
+
+	"gioui.org/f32"
+)
+
+// boundsTolerance is the maximum error, in the coordinates of the
+// DrawOptions.Transform, of the bounds of the strokes.
+const boundsTolerance = 0.001
+
+// Bounds returns the tight bounds of the path after the transform `m`. Unlike
+// the control points, it uses the extrema of the curves. It returns an empty
+// rectangle if the path has no points.
+func (p Path) Bounds(m Matrix2D) f32.Rectangle {
+	var (
+		min, max = [2]float64{math.Inf(1), math.Inf(1)}, [2]float64{math.Inf(-1), math.Inf(-1)}
+		last     [2]float64
+	)
+	add := func(x, y float64) {
+		if math.IsNaN(x) || math.IsNaN(y) {
+			return
+		}
+		min[0], min[1] = math.Min(min[0], x), math.Min(min[1], y)
+		max[0], max[1] = math.Max(max[0], x), math.Max(max[1], y)
+	}
+	point := func(p f32.Point) [2]float64 {
+		x, y := m.Transform(float64(p.X), float64(p.Y))
+		return [2]float64{x, y}
+	}
+
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			last = point(f32.Point(op))
+			add(last[0], last[1])
+		case OpLineTo:
+			last = point(f32.Point(op))
+			add(last[0], last[1])
+		case OpQuadTo:
+			b, c := point(op[0]), point(op[1])
+			for axis := 0; axis < 2; axis++ {
+				// The derivative is zero at (a-b)/(a-2b+c).
+				if d := last[axis] - 2*b[axis] + c[axis]; d != 0 {
+					if t := (last[axis] - b[axis]) / d; t > 0 && t < 1 {
+						add(quadAt(last[0], b[0], c[0], t), quadAt(last[1], b[1], c[1], t))
+					}
+				}
+			}
+			last = c
+			add(last[0], last[1])
+		case OpCubicTo:
+			b, c, d := point(op[0]), point(op[1]), point(op[2])
+			for axis := 0; axis < 2; axis++ {
+				for _, t := range cubicExtrema(last[axis], b[axis], c[axis], d[axis]) {
+					add(cubicAt(last[0], b[0], c[0], d[0], t), cubicAt(last[1], b[1], c[1], d[1], t))
+				}
+			}
+			last = d
+			add(last[0], last[1])
+		}
+	}
+	if min[0] > max[0] {
+		return f32.Rectangle{}
+	}
+	return f32.Rectangle{
+		Min: f32.Pt(float32(min[0]), float32(min[1])),
+		Max: f32.Pt(float32(max[0]), float32(max[1])),
+	}
+}
+
+// cubicExtrema returns the values of `t`, between 0 and 1, where the
+// derivative of the cubic bezier curve is zero.
+func cubicExtrema(a, b, c, d float64) (ts []float64) {
+	// The derivative, divided by 3, is qa*t² + qb*t + qc.
+	qa := -a + 3*b - 3*c + d
+	qb := 2 * (a - 2*b + c)
+	qc := b - a
+	add := func(t float64) {
+		if t > 0 && t < 1 {
+			ts = append(ts, t)
+		}
+	}
+	if math.Abs(qa) < 1e-12 {
+		if qb != 0 {
+			add(-qc / qb)
+		}
+		return ts
+	}
+	disc := qb*qb - 4*qa*qc
+	if disc < 0 {
+		return ts
+	}
+	sq := math.Sqrt(disc)
+	add((-qb + sq) / (2 * qa))
+	add((-qb - sq) / (2 * qa))
+	return ts
+}
+
+func quadAt(a, b, c, t float64) float64 {
+	u := 1 - t
+	return u*u*a + 2*u*t*b + t*t*c
+}
+
+func cubicAt(a, b, c, d, t float64) float64 {
+	u := 1 - t
+	return u*u*u*a + 3*u*u*t*b + 3*u*t*t*c + t*t*t*d
+}
+
+// TightPathBounds returns the bounds of the SvgPath at the given index, in the
+// coordinates of the DrawOptions.Transform. Unlike PathBounds, the bounds are
+// tight: the curves use their extrema, and the stroke includes the joins,
+// the caps and the dashes. It returns an empty rectangle if the SvgPath is
+// hidden, or if nothing is drawn.
+func (s *SVGRender) TightPathBounds(index int, o DrawOptions) f32.Rectangle {
+	override := DefaultOverride
+	if o.Overrides != nil {
+		override = o.Overrides[index]
+	}
+	path, style := s.SVGPaths[index].resolved(override)
+	if override.Hidden || len(path) == 0 {
+		return f32.Rectangle{}
+	}
+	fill, stroke := override.patterns(style)
+
+	m := o.Transform.Mult(override.Transform).Mult(style.Transform)
+	return path.drawnBounds(m, style, fill, stroke)
+}
+
+// drawnBounds returns the tight bounds of the area drawn by the path, after
+// the transform `m`, using the fill and the stroke with the given style.
+func (p Path) drawnBounds(m Matrix2D, style *PathStyle, fill, stroke Pattern) f32.Rectangle {
+	var bounds f32.Rectangle
+	if fill != nil {
+		bounds = p.Bounds(m)
+	}
+	if stroke != nil && style.LineWidth > 0 {
+		// The stroke is created as drawn: the dashes are in the coordinates
+		// of the path, and the width is scaled by the transform.
+		scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+		if scale == 0 {
+			return bounds
+		}
+		strokePath := p
+		if len(style.Dash.Dash) > 0 {
+			strokePath = p.dash(style.Dash, dashTolerance/scale)
+		}
+		outline := strokePath.Transform(m).StrokeOutline(style.LineWidth*scale, style.strokeJoin(), boundsTolerance)
+		bounds = bounds.Union(outline.Bounds(Identity))
+	}
+	return bounds
+}
+
+// TightBounds returns the union of the TightPathBounds of all SvgPath,
+// which is the area drawn by the SVGRender.
+func (s *SVGRender) TightBounds(o DrawOptions) (bounds f32.Rectangle) {
+	for i := range s.SVGPaths {
+		bounds = bounds.Union(s.TightPathBounds(i, o))
+	}
+	return bounds
+}
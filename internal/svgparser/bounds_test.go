@@ -0,0 +1,83 @@
+package svgparser
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+func TestTightPathBounds(t *testing.T) {
+	for _, c := range []struct {
+		element  string
+		expected f32.Rectangle
+	}{
+		{`<circle cx="50" cy="50" r="10"/>`, f32.Rect(40, 40, 60, 60)},
+		{`<path d="M0 0 Q50 100 100 0"/>`, f32.Rect(0, 0, 100, 50)},
+		{`<path d="M0 0 C0 100 100 100 100 0"/>`, f32.Rect(0, 0, 100, 75)},
+		{`<path d="M0 0 C0 100 100 100 100 0" transform="translate(10 20) scale(0.5)"/>`, f32.Rect(10, 20, 60, 57.5)},
+		{`<rect width="10" height="10" transform="rotate(45)"/>`, f32.Rect(-7.0711, 0, 7.0711, 14.1421)},
+		{`<path d="M10 10 H50" stroke="red" stroke-width="4"/>`, f32.Rect(10, 8, 50, 12)},
+		{`<path d="M10 10 H50" stroke="red" stroke-width="4" stroke-linecap="square"/>`, f32.Rect(8, 8, 52, 12)},
+		{`<path d="M10 10 H50" stroke="red" stroke-width="4" stroke-linecap="round"/>`, f32.Rect(8, 8, 52, 12)},
+		{`<path d="M0 0 L10 10 L20 0" fill="none" stroke="red" stroke-width="2"/>`, f32.Rect(-0.7071, -0.7071, 20.7071, 10.7071)},
+		{`<path d="M0 0 L10 10 L20 0" fill="none" stroke="red" stroke-width="2" stroke-linejoin="miter"/>`, f32.Rect(-0.7071, -0.7071, 20.7071, 11.4142)},
+		{`<path d="M0 0 H100" stroke="red" stroke-width="2" stroke-dasharray="10 90"/>`, f32.Rect(0, -1, 10, 1)},
+		{`<circle cx="50" cy="50" r="10" fill="none" stroke="red" stroke-width="4"/>`, f32.Rect(38, 38, 62, 62)},
+		{`<circle cx="50" cy="50" r="10" fill="none"/>`, f32.Rectangle{}},
+	} {
+		icon, err := ReadIcon(strings.NewReader(`<svg viewBox="0 0 100 100">` + c.element + `</svg>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(icon.SVGPaths) == 0 {
+			if c.expected != (f32.Rectangle{}) {
+				t.Errorf("expected a path for %s", c.element)
+			}
+			continue
+		}
+		if got := icon.TightPathBounds(0, DrawOptions{Transform: Identity}); !nearRect(got, c.expected, 1e-3) {
+			t.Errorf("expected %v for %s, got %v", c.expected, c.element, got)
+		}
+	}
+}
+
+func TestTightBounds(t *testing.T) {
+	icon, err := ReadIcon(strings.NewReader(`<svg viewBox="0 0 24 24">
+		<rect x="4" y="4" width="4" height="4"/>
+		<path d="M12 20 H20" stroke="red" stroke-width="2"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := icon.TightBounds(DrawOptions{Transform: Identity}), f32.Rect(4, 4, 20, 21); !nearRect(got, expected, 1e-3) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// The stroke width is scaled by the transform, and the hidden
+	// paths are ignored.
+	o := DrawOptions{Transform: Identity.Scale(2, 2), Overrides: []Override{DefaultOverride, DefaultOverride}}
+	o.Overrides[0].Hidden = true
+	if got, expected := icon.TightBounds(o), f32.Rect(24, 38, 40, 42); !nearRect(got, expected, 1e-3) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	// The PathBounds are conservative, so they contain the tight bounds.
+	for i := range icon.SVGPaths {
+		tight, conservative := icon.TightPathBounds(i, o), icon.PathBounds(i, o)
+		if tight.Union(conservative) != conservative {
+			t.Errorf("expected %v to contain %v", conservative, tight)
+		}
+	}
+}
+
+func nearRect(a, b f32.Rectangle, tolerance float64) bool {
+	for _, v := range [...][2]float32{{a.Min.X, b.Min.X}, {a.Min.Y, b.Min.Y}, {a.Max.X, b.Max.X}, {a.Max.Y, b.Max.Y}} {
+		if math.Abs(float64(v[0]-v[1])) > tolerance {
+			return false
+		}
+	}
+	return true
+}
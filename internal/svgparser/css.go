@@ -0,0 +1,834 @@
+package svgparser
+
+import (
+	"bytes"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/inkeliz/giosvg/internal/svgparser/simplexml"
+)
+
+// styleSheet holds the rules and the @keyframes of all <style> elements.
+// Only the simple selectors are supported: the type, `.class`, `#id` and `*`,
+// combined with the descendant and child (`>`) combinators. The rules with
+// other selectors, such as `:hover`, are ignored.
+type styleSheet struct {
+	rules     []cssRule
+	keyframes map[string][]keyframe
+}
+
+// cssRule is one selector of a rule, with the declarations of the rule.
+type cssRule struct {
+	selector     cssSelector
+	declarations []simplexml.Attr
+}
+
+// cssSelector is a complex selector, such as `g.icon > path`.
+type cssSelector struct {
+	compounds   []cssCompound // compounds are ordered from the outer to the inner element
+	child       []bool        // child[i] is true if compounds[i+1] must be a child of compounds[i]
+	specificity int
+}
+
+// cssCompound is a compound selector, such as `rect.primary#box`.
+type cssCompound struct {
+	tag, id string
+	classes []string
+}
+
+// keyframe is one keyframe of @keyframes, the offset is between 0 and 1.
+type keyframe struct {
+	offset       float64
+	declarations []simplexml.Attr
+}
+
+// cssAnimation is one of the animations defined by the `animation` properties.
+type cssAnimation struct {
+	name            string
+	duration, delay time.Duration
+	timing          func(float64) float64 // timing is nil for `linear`
+	iterations      float64               // iterations is -1 for `infinite`
+	direction       string
+	fillMode        string
+}
+
+// styleF reads the <style>, which is already parsed by readStyleSheet.
+func styleF(c *iconCursor, attrs []simplexml.Attr) error {
+	return nil
+}
+
+// readStyleSheet reads the rules of all <style> elements before the document
+// is parsed, so the rules apply to the elements before the <style> too, as
+// in the browsers. The errors of the XML are reported by the parser.
+func readStyleSheet(data []byte) (s styleSheet) {
+	decoder := simplexml.NewDecoder(bytes.NewReader(data))
+	var (
+		inStyle bool
+		text    strings.Builder
+	)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return s
+		}
+		switch se := t.(type) {
+		case simplexml.StartElement:
+			if se.Name.Local == "style" {
+				inStyle = true
+				text.Reset()
+			}
+		case simplexml.EndElement:
+			if se.Name.Local == "style" && inStyle {
+				s.parse(text.String())
+				inStyle = false
+			}
+		case simplexml.CharData:
+			if inStyle {
+				text.Write(se)
+			}
+		}
+	}
+}
+
+// parse adds the rules and @keyframes of the CSS text.
+func (s *styleSheet) parse(text string) {
+	text = stripComments(text)
+	for {
+		text = strings.TrimSpace(text)
+		open := strings.IndexByte(text, '{')
+		if open < 0 {
+			return
+		}
+		if end := strings.IndexByte(text, ';'); end >= 0 && end < open && strings.HasPrefix(text, "@") {
+			// Statement at-rules, such as @import, aren't supported.
+			text = text[end+1:]
+			continue
+		}
+		prelude := strings.TrimSpace(text[:open])
+		var body string
+		body, text = cssBlock(text[open:])
+
+		switch {
+		case strings.HasPrefix(prelude, "@keyframes"), strings.HasPrefix(prelude, "@-webkit-keyframes"):
+			if fields := strings.Fields(prelude); len(fields) == 2 {
+				s.parseKeyframes(strings.Trim(fields[1], `"'`), body)
+			}
+		case strings.HasPrefix(prelude, "@"):
+			// Other at-rules, such as @media, aren't supported.
+		default:
+			declarations := parseDeclarations(body)
+			for _, v := range splitTopLevel(prelude, ',') {
+				if selector, ok := parseSelector(v); ok {
+					s.rules = append(s.rules, cssRule{selector: selector, declarations: declarations})
+				}
+			}
+		}
+	}
+}
+
+// parseKeyframes adds the keyframes of the @keyframes with the given name,
+// which replaces any previous @keyframes with the same name.
+func (s *styleSheet) parseKeyframes(name, body string) {
+	var frames []keyframe
+	for {
+		body = strings.TrimSpace(body)
+		open := strings.IndexByte(body, '{')
+		if open < 0 {
+			break
+		}
+		prelude := body[:open]
+		var block string
+		block, body = cssBlock(body[open:])
+		declarations := parseDeclarations(block)
+		for _, v := range splitTopLevel(prelude, ',') {
+			var offset float64
+			switch v = strings.ToLower(strings.TrimSpace(v)); v {
+			case "from":
+				offset = 0
+			case "to":
+				offset = 1
+			default:
+				if !strings.HasSuffix(v, "%") {
+					continue
+				}
+				percentage, err := strconv.ParseFloat(v[:len(v)-1], 64)
+				if err != nil || percentage < 0 || percentage > 100 {
+					continue
+				}
+				offset = percentage / 100
+			}
+			frames = append(frames, keyframe{offset: offset, declarations: declarations})
+		}
+	}
+	sort.SliceStable(frames, func(i, j int) bool { return frames[i].offset < frames[j].offset })
+	if s.keyframes == nil {
+		s.keyframes = make(map[string][]keyframe)
+	}
+	s.keyframes[name] = frames
+}
+
+// match returns the declarations of the rules which match the element, ordered
+// by the specificity. The ancestors are the open elements, the parent last.
+func (s *styleSheet) match(tag string, attrs []simplexml.Attr, ancestors []element) []simplexml.Attr {
+	var rules []cssRule
+	for _, rule := range s.rules {
+		if rule.selector.matches(tag, attrs, ancestors) {
+			rules = append(rules, rule)
+		}
+	}
+	sort.SliceStable(rules, func(i, j int) bool { return rules[i].selector.specificity < rules[j].selector.specificity })
+
+	var declarations []simplexml.Attr
+	for _, rule := range rules {
+		declarations = append(declarations, rule.declarations...)
+	}
+	return declarations
+}
+
+// declarations returns the style declarations of the element in the cascade
+// order: the attributes, the rules of <style> and the `style` attribute.
+func (c *iconCursor) declarations(tag string, attrs []simplexml.Attr) []simplexml.Attr {
+	var declarations, inline []simplexml.Attr
+	for _, attr := range attrs {
+		if strings.ToLower(attr.Name.Local) == "style" {
+			inline = append(inline, parseDeclarations(attr.Value)...)
+			continue
+		}
+		declarations = append(declarations, attr)
+	}
+	declarations = append(declarations, c.styleSheet.match(tag, attrs, c.elementStack)...)
+	return append(declarations, inline...)
+}
+
+// parseSelector parses one selector of the selector list. It returns
+// false if the selector isn't supported.
+func parseSelector(v string) (s cssSelector, ok bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return s, false
+	}
+	child := false
+	for v != "" {
+		end := strings.IndexAny(v, " \t\r\n>+~")
+		if end < 0 {
+			end = len(v)
+		}
+		compound, ok := parseCompound(v[:end])
+		if !ok {
+			return s, false
+		}
+		if len(s.compounds) > 0 {
+			s.child = append(s.child, child)
+		}
+		s.compounds = append(s.compounds, compound)
+		s.specificity += 10000*len(compound.id) + 100*len(compound.classes)
+		if compound.tag != "" {
+			s.specificity++
+		}
+
+		v, child = strings.TrimSpace(v[end:]), false
+		if strings.HasPrefix(v, ">") {
+			v, child = strings.TrimSpace(v[1:]), true
+		}
+		if strings.HasPrefix(v, "+") || strings.HasPrefix(v, "~") {
+			return s, false // the sibling combinators aren't supported
+		}
+		if child && v == "" {
+			return s, false
+		}
+	}
+	return s, true
+}
+
+// parseCompound parses a compound selector, such as `rect.primary`.
+func parseCompound(v string) (c cssCompound, ok bool) {
+	if v == "" {
+		return c, false
+	}
+	end := strings.IndexAny(v, ".#")
+	if end < 0 {
+		end = len(v)
+	}
+	if c.tag = v[:end]; c.tag == "*" {
+		c.tag = ""
+	}
+	if strings.ContainsAny(c.tag, "[]:()") {
+		return c, false
+	}
+	for v = v[end:]; v != ""; {
+		end := strings.IndexAny(v[1:], ".#") + 1
+		if end == 0 {
+			end = len(v)
+		}
+		name := v[1:end]
+		if name == "" || strings.ContainsAny(name, "[]:()") {
+			return c, false
+		}
+		if v[0] == '#' {
+			if c.id != "" {
+				return c, false
+			}
+			c.id = name
+		} else {
+			c.classes = append(c.classes, name)
+		}
+		v = v[end:]
+	}
+	return c, true
+}
+
+// matches reports whether the element matches the selector.
+func (s cssSelector) matches(tag string, attrs []simplexml.Attr, ancestors []element) bool {
+	n := len(s.compounds)
+	return s.compounds[n-1].matches(tag, attrs) && s.matchAncestors(n-2, ancestors)
+}
+
+// matchAncestors reports whether the compounds until the index i match the ancestors.
+func (s cssSelector) matchAncestors(i int, ancestors []element) bool {
+	if i < 0 {
+		return true
+	}
+	for j := len(ancestors) - 1; j >= 0; j-- {
+		if s.compounds[i].matches(ancestors[j].tag, ancestors[j].attrs) && s.matchAncestors(i-1, ancestors[:j]) {
+			return true
+		}
+		if s.child[i] {
+			return false
+		}
+	}
+	return false
+}
+
+func (c cssCompound) matches(tag string, attrs []simplexml.Attr) bool {
+	if c.tag != "" && c.tag != tag {
+		return false
+	}
+	var id string
+	var classes []string
+	for _, attr := range attrs {
+		switch attr.Name.Local {
+		case "id":
+			id = attr.Value
+		case "class":
+			classes = strings.Fields(attr.Value)
+		}
+	}
+	if c.id != "" && c.id != id {
+		return false
+	}
+	for _, class := range c.classes {
+		found := false
+		for _, v := range classes {
+			if v == class {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// parseDeclarations parses the declarations of a rule, or of the `style` attribute.
+func parseDeclarations(v string) (declarations []simplexml.Attr) {
+	for _, declaration := range splitTopLevel(v, ';') {
+		kv := strings.SplitN(declaration, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		k, v := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		if k == "" {
+			continue
+		}
+		if !strings.HasPrefix(k, "--") { // custom properties are case-sensitive
+			k = strings.ToLower(k)
+		}
+		v = strings.TrimSpace(strings.TrimSuffix(v, "!important"))
+		declarations = append(declarations, simplexml.Attr{Name: simplexml.Name{Local: k}, Value: v})
+	}
+	return declarations
+}
+
+// stripComments removes the /* comments */ of the CSS text.
+func stripComments(text string) string {
+	for {
+		start := strings.Index(text, "/*")
+		if start < 0 {
+			return text
+		}
+		end := strings.Index(text[start+2:], "*/")
+		if end < 0 {
+			return text[:start]
+		}
+		text = text[:start] + " " + text[start+2+end+2:]
+	}
+}
+
+// cssBlock returns the content of the block, which starts with "{", and the
+// text after the block.
+func cssBlock(text string) (body, rest string) {
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth == 0 {
+				return text[1:i], text[i+1:]
+			}
+		}
+	}
+	return text[1:], ""
+}
+
+// splitTopLevel splits the text on the separator, except inside
+// parentheses and quotes, such as `cubic-bezier(0, 0, 1, 1)`.
+// The separator ' ' splits on any space.
+func splitTopLevel(text string, separator byte) (parts []string) {
+	var depth int
+	var quote byte
+	start := 0
+	for i := 0; i < len(text); i++ {
+		switch ch := text[i]; {
+		case quote != 0:
+			if ch == quote {
+				quote = 0
+			}
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+		case depth == 0 && (ch == separator || separator == ' ' && strings.IndexByte(" \t\r\n", ch) >= 0):
+			if part := strings.TrimSpace(text[start:i]); part != "" || separator != ' ' {
+				parts = append(parts, part)
+			}
+			start = i + 1
+		}
+	}
+	if part := strings.TrimSpace(text[start:]); part != "" {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// transformOrigin returns the point of the `transform-origin`, relative to the
+// reference box of the `transform-box`. The `fill-box` is only supported by
+// shapes, the other elements use the `view-box`.
+func (c *iconCursor) transformOrigin(tag string, declarations []simplexml.Attr, origin, box string) (x, y float64, err error) {
+	reference := Bounds{W: c.icon.ViewBox.W, H: c.icon.ViewBox.H}
+	if box == "fill-box" {
+		if bounds, ok := c.shapeBounds(tag, declarations); ok {
+			reference = bounds
+		}
+	}
+
+	values := strings.Fields(strings.ToLower(origin))
+	if len(values) == 3 {
+		values = values[:2] // the z offset doesn't change the 2D transform
+	}
+	if len(values) == 0 {
+		values = []string{"0", "0"}
+	}
+	if len(values) == 1 {
+		switch values[0] {
+		case "top", "bottom":
+			values = []string{"center", values[0]}
+		default:
+			values = append(values, "center")
+		}
+	}
+	if values[0] == "top" || values[0] == "bottom" || values[1] == "left" || values[1] == "right" {
+		values[0], values[1] = values[1], values[0]
+	}
+
+	position := func(v string, start, size float64) (float64, error) {
+		switch v {
+		case "left", "top":
+			return start, nil
+		case "center":
+			return start + size/2, nil
+		case "right", "bottom":
+			return start + size, nil
+		}
+		if strings.HasSuffix(v, "%") {
+			percentage, err := parseBasicFloat(v[:len(v)-1])
+			return start + size*percentage/100, err
+		}
+		value, err := parseBasicFloat(strings.TrimSuffix(v, "px"))
+		return start + value, err
+	}
+	if x, err = position(values[0], reference.X, reference.W); err != nil {
+		return 0, 0, err
+	}
+	y, err = position(values[1], reference.Y, reference.H)
+	return x, y, err
+}
+
+// shapeBounds returns the bounds of the control points of the shape.
+func (c *iconCursor) shapeBounds(tag string, attrs []simplexml.Attr) (b Bounds, ok bool) {
+	if _, shape := shapeAttributes[tag]; !shape {
+		return b, false
+	}
+	scratch := &iconCursor{icon: c.icon}
+	if err := drawFuncs[tag](scratch, attrs); err != nil || len(scratch.path) == 0 {
+		return b, false
+	}
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	add := func(x, y float32) {
+		minX, minY = math.Min(minX, float64(x)), math.Min(minY, float64(y))
+		maxX, maxY = math.Max(maxX, float64(x)), math.Max(maxY, float64(y))
+	}
+	for _, op := range scratch.path {
+		switch op := op.(type) {
+		case OpMoveTo:
+			add(op.X, op.Y)
+		case OpLineTo:
+			add(op.X, op.Y)
+		case OpQuadTo:
+			for _, p := range op {
+				add(p.X, p.Y)
+			}
+		case OpCubicTo:
+			for _, p := range op {
+				add(p.X, p.Y)
+			}
+		}
+	}
+	return Bounds{X: minX, Y: minY, W: maxX - minX, H: maxY - minY}, true
+}
+
+// readCSSAnimations adds the Animations of the `animation` properties of
+// the current element, using the @keyframes of the styleSheet.
+func (c *iconCursor) readCSSAnimations(declarations []simplexml.Attr) {
+	animations := parseCSSAnimations(declarations)
+	if len(animations) == 0 || c.inDefs {
+		return
+	}
+	var target *animationTarget
+	for _, anim := range animations {
+		frames, ok := c.styleSheet.keyframes[anim.name]
+		if !ok || len(frames) == 0 || anim.duration <= 0 || anim.iterations == 0 {
+			continue
+		}
+		if target == nil {
+			target = c.elementTarget(len(c.elementStack) - 1)
+			target.animated = true
+		}
+
+		var properties []string
+		for _, frame := range frames {
+			for _, d := range frame.declarations {
+				if !strings.HasPrefix(d.Name.Local, "animation") && !containsString(properties, d.Name.Local) {
+					properties = append(properties, d.Name.Local)
+				}
+			}
+		}
+		for _, property := range properties {
+			a := Animation{
+				Kind:        KeyframesKind,
+				ID:          anim.name,
+				Attribute:   property,
+				Begin:       []TimeValue{{Offset: anim.delay, Sync: -1}},
+				Dur:         anim.duration,
+				RepeatCount: anim.iterations,
+				Freeze:      anim.fillMode == "forwards" || anim.fillMode == "both",
+				backwards:   anim.fillMode == "backwards" || anim.fillMode == "both",
+				alternate:   strings.HasPrefix(anim.direction, "alternate"),
+				reverse:     strings.HasSuffix(anim.direction, "reverse"),
+				target:      target,
+			}
+			for _, frame := range frames {
+				value, ok := declarationValue(frame.declarations, property)
+				if !ok {
+					continue
+				}
+				timing := anim.timing
+				if v, ok := declarationValue(frame.declarations, "animation-timing-function"); ok {
+					timing, _ = parseTimingFunction(v)
+				}
+				if n := len(a.KeyTimes); n > 0 && a.KeyTimes[n-1] == frame.offset {
+					// The last keyframe with the same offset takes precedence.
+					a.Values[n-1], a.easing[n-1] = value, timing
+					continue
+				}
+				a.KeyTimes = append(a.KeyTimes, frame.offset)
+				a.Values = append(a.Values, value)
+				a.easing = append(a.easing, timing)
+			}
+
+			// The missing `from` and `to` keyframes use the value of the element.
+			base := target.value(property)
+			if base == "" {
+				base = defaultPropertyValues[property]
+			}
+			if base != "" && a.KeyTimes[0] > 0 {
+				a.KeyTimes = append([]float64{0}, a.KeyTimes...)
+				a.Values = append([]string{base}, a.Values...)
+				a.easing = append([]func(float64) float64{anim.timing}, a.easing...)
+			}
+			if base != "" && a.KeyTimes[len(a.KeyTimes)-1] < 1 {
+				a.KeyTimes = append(a.KeyTimes, 1)
+				a.Values = append(a.Values, base)
+			}
+			if property == "transform" {
+				for i, v := range a.Values {
+					if v == "none" {
+						a.Values[i] = identityTransform(a.Values)
+					}
+				}
+			}
+			c.icon.Animations = append(c.icon.Animations, a)
+		}
+	}
+}
+
+// defaultPropertyValues are the initial values of the properties,
+// used by the keyframes which don't define all offsets.
+var defaultPropertyValues = map[string]string{
+	"transform":         "none",
+	"opacity":           "1",
+	"fill-opacity":      "1",
+	"stroke-opacity":    "1",
+	"stroke-dashoffset": "0",
+	"stroke-width":      "1",
+	"visibility":        "visible",
+	"display":           "inline",
+}
+
+// identityTransform returns the identity transform with the same functions as
+// the other values, such as "rotate(0deg)" for "rotate(360deg)", so that
+// `none` can be interpolated.
+func identityTransform(values []string) string {
+	for _, v := range values {
+		if v == "none" {
+			continue
+		}
+		var s strings.Builder
+		for _, function := range strings.SplitAfter(v, ")") {
+			open := strings.IndexByte(function, '(')
+			if open < 0 {
+				continue
+			}
+			name := strings.ToLower(strings.TrimSpace(function[:open]))
+			args := splitOnCommaOrSpace(strings.TrimSuffix(function[open+1:], ")"))
+			identity := "0"
+			if strings.HasPrefix(name, "scale") {
+				identity = "1"
+			}
+			s.WriteString(function[:open+1])
+			for i, arg := range args {
+				if i > 0 {
+					s.WriteString(",")
+				}
+				if name == "matrix" {
+					s.WriteString([]string{"1", "0", "0", "1", "0", "0"}[i%6])
+					continue
+				}
+				numbers, texts := splitNumbers(arg)
+				s.WriteString(identity)
+				if len(numbers) == 1 && len(texts) == 2 {
+					s.WriteString(texts[1]) // the unit, such as "deg"
+				}
+			}
+			s.WriteString(") ")
+		}
+		return strings.TrimSpace(s.String())
+	}
+	return "none"
+}
+
+// parseCSSAnimations parses the `animation` shorthand and the longhands.
+func parseCSSAnimations(declarations []simplexml.Attr) (animations []cssAnimation) {
+	for _, d := range declarations {
+		k, v := d.Name.Local, strings.TrimSpace(d.Value)
+		if k == "animation" {
+			animations = animations[:0]
+			for _, part := range splitTopLevel(v, ',') {
+				animations = append(animations, parseAnimationShorthand(part))
+			}
+			continue
+		}
+		if !strings.HasPrefix(k, "animation-") {
+			continue
+		}
+		values := splitTopLevel(v, ',')
+		if len(values) == 0 {
+			continue
+		}
+		if k == "animation-name" {
+			for len(animations) < len(values) {
+				animations = append(animations, newCSSAnimation())
+			}
+			animations = animations[:len(values)]
+		}
+		for i := range animations {
+			value := strings.ToLower(values[i%len(values)])
+			a := &animations[i]
+			switch k {
+			case "animation-name":
+				a.name = strings.Trim(values[i], `"'`)
+			case "animation-duration":
+				a.duration, _ = parseCSSTime(value)
+			case "animation-delay":
+				a.delay, _ = parseCSSTime(value)
+			case "animation-timing-function":
+				if timing, ok := parseTimingFunction(value); ok {
+					a.timing = timing
+				}
+			case "animation-iteration-count":
+				a.iterations, _ = parseIterationCount(value)
+			case "animation-direction":
+				a.direction = value
+			case "animation-fill-mode":
+				a.fillMode = value
+			}
+		}
+	}
+	return animations
+}
+
+func newCSSAnimation() cssAnimation {
+	timing, _ := parseTimingFunction("ease")
+	return cssAnimation{timing: timing, iterations: 1, direction: "normal", fillMode: "none"}
+}
+
+// parseAnimationShorthand parses one animation of the `animation` shorthand,
+// such as "spin 1s linear infinite".
+func parseAnimationShorthand(v string) cssAnimation {
+	a := newCSSAnimation()
+	var hasDuration bool
+	for _, token := range splitTopLevel(v, ' ') {
+		value := strings.ToLower(token)
+		if t, ok := parseCSSTime(value); ok {
+			if !hasDuration {
+				a.duration, hasDuration = t, true
+			} else {
+				a.delay = t
+			}
+			continue
+		}
+		if timing, ok := parseTimingFunction(value); ok {
+			a.timing = timing
+			continue
+		}
+		if iterations, ok := parseIterationCount(value); ok {
+			a.iterations = iterations
+			continue
+		}
+		switch value {
+		case "normal", "reverse", "alternate", "alternate-reverse":
+			a.direction = value
+		case "none", "forwards", "backwards", "both":
+			a.fillMode = value
+		case "running", "paused":
+		default:
+			a.name = strings.Trim(token, `"'`)
+		}
+	}
+	return a
+}
+
+// parseCSSTime parses a CSS time, such as "1s" or "250ms".
+func parseCSSTime(v string) (time.Duration, bool) {
+	if !strings.HasSuffix(v, "s") {
+		return 0, false
+	}
+	t, err := parseClock(v)
+	return t, err == nil && t != Indefinite
+}
+
+// parseIterationCount parses the `animation-iteration-count`.
+func parseIterationCount(v string) (float64, bool) {
+	if v == "infinite" {
+		return -1, true
+	}
+	count, err := strconv.ParseFloat(v, 64)
+	return count, err == nil && count >= 0
+}
+
+// parseTimingFunction parses the `animation-timing-function`, which is
+// nil for `linear`. It returns false if the value isn't a timing function.
+func parseTimingFunction(v string) (func(float64) float64, bool) {
+	spline := func(s [4]float64) func(float64) float64 {
+		return func(t float64) float64 { return keySpline(s, t) }
+	}
+	steps := func(n int, start bool) func(float64) float64 {
+		return func(t float64) float64 {
+			if t >= 1 {
+				return 1
+			}
+			if start {
+				return math.Min(1, math.Floor(t*float64(n)+1)/float64(n))
+			}
+			return math.Floor(t*float64(n)) / float64(n)
+		}
+	}
+
+	switch v = strings.ToLower(strings.TrimSpace(v)); v {
+	case "linear":
+		return nil, true
+	case "ease":
+		return spline([4]float64{0.25, 0.1, 0.25, 1}), true
+	case "ease-in":
+		return spline([4]float64{0.42, 0, 1, 1}), true
+	case "ease-out":
+		return spline([4]float64{0, 0, 0.58, 1}), true
+	case "ease-in-out":
+		return spline([4]float64{0.42, 0, 0.58, 1}), true
+	case "step-start":
+		return steps(1, true), true
+	case "step-end":
+		return steps(1, false), true
+	}
+
+	open := strings.IndexByte(v, '(')
+	if open < 0 || !strings.HasSuffix(v, ")") {
+		return nil, false
+	}
+	args := splitOnCommaOrSpace(v[open+1 : len(v)-1])
+	switch v[:open] {
+	case "cubic-bezier":
+		values, err := parseFloatList(strings.Join(args, " "))
+		if err != nil || len(values) != 4 {
+			return nil, false
+		}
+		return spline([4]float64{values[0], values[1], values[2], values[3]}), true
+	case "steps":
+		if len(args) == 0 {
+			return nil, false
+		}
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return nil, false
+		}
+		start := len(args) > 1 && (args[1] == "start" || args[1] == "jump-start")
+		return steps(n, start), true
+	}
+	return nil, false
+}
+
+// declarationValue returns the value of the last declaration of the property.
+func declarationValue(declarations []simplexml.Attr, property string) (value string, ok bool) {
+	for _, d := range declarations {
+		if d.Name.Local == property {
+			value, ok = strings.TrimSpace(d.Value), true
+		}
+	}
+	return value, ok
+}
+
+func containsString(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
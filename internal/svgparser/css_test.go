@@ -0,0 +1,167 @@
+package svgparser
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gioui.org/f32"
+)
+
+func TestStyleSheet(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<defs>
+			<style>
+				/* The rules are applied by specificity, then by order. */
+				rect { fill: red; }
+				.primary { fill: blue; stroke-width: 4px }
+				g.icon > rect.primary { fill: green; }
+				#box { fill: yellow; }
+				g rect:hover { fill: black; }
+			</style>
+		</defs>
+		<g class="icon">
+			<rect width="10" height="10"/>
+			<rect class="primary" width="10" height="10"/>
+			<rect id="box" class="primary" width="10" height="10" fill="white"/>
+			<rect class="primary" width="10" height="10" style="fill: #ff00ff"/>
+			<g><rect class="primary" width="10" height="10"/></g>
+		</g>
+		<rect class="primary" width="10" height="10" transform="rotate(90deg)" style="transform-origin: 5px 5px"/>
+	</svg>`)
+
+	for i, fill := range []PlainColor{
+		NewPlainColor(0xff, 0, 0, 0xff),
+		NewPlainColor(0, 0x80, 0, 0xff),
+		NewPlainColor(0xff, 0xff, 0, 0xff),
+		NewPlainColor(0xff, 0, 0xff, 0xff),
+		NewPlainColor(0, 0, 0xff, 0xff),
+	} {
+		if c := icon.SVGPaths[i].Style.FillerColor; c != fill {
+			t.Errorf("path %d: expected fill %v, got %v", i, fill, c)
+		}
+	}
+	if w := icon.SVGPaths[1].Style.LineWidth; w != 4 {
+		t.Errorf("expected stroke-width 4, got %v", w)
+	}
+	if x, y := icon.SVGPaths[5].Style.Transform.Transform(10, 0); math.Abs(x-10) > 1e-9 || math.Abs(y-10) > 1e-9 {
+		t.Errorf("expected the rotation around the transform-origin, got %v, %v", x, y)
+	}
+}
+
+func TestStyleSheetAfterElements(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<rect class="r" width="10" height="10"/>
+		<rect class="spinner" width="10" height="10"/>
+		<style>.r { fill: red } .spinner { animation: spin 2s linear infinite }</style>
+		<style>@keyframes spin { to { transform: rotate(1turn) } } .r { fill: blue }</style>
+	</svg>`)
+
+	if c, fill := icon.SVGPaths[0].Style.FillerColor, NewPlainColor(0, 0, 0xff, 0xff); c != fill {
+		t.Errorf("expected fill %v, got %v", fill, c)
+	}
+	if len(icon.Animations) != 1 {
+		t.Fatalf("expected the animation of the @keyframes, got %v", icon.Animations)
+	}
+}
+
+func TestTransformList(t *testing.T) {
+	for _, c := range []struct {
+		transform string
+		x, y      float64
+	}{
+		{transform: "scale(2)", x: 2, y: 4},
+		{transform: "scale(2, 3)", x: 2, y: 6},
+		{transform: "scaleX(2) translateY(1)", x: 2, y: 3},
+		{transform: "translateX(10px) rotate(90deg)", x: 8, y: 1},
+		{transform: "skew(45deg)", x: 3, y: 2},
+	} {
+		var cursor iconCursor
+		m, err := cursor.parseTransformList(c.transform)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if x, y := m.Transform(1, 2); math.Abs(x-c.x) > 1e-9 || math.Abs(y-c.y) > 1e-9 {
+			t.Errorf("%s: expected %v, %v, got %v, %v", c.transform, c.x, c.y, x, y)
+		}
+	}
+}
+
+func TestKeyframes(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<style><![CDATA[
+			@keyframes spin { to { transform: rotate(1turn); } }
+			@keyframes fade { from { opacity: 0 } 50% { opacity: 1; animation-timing-function: steps(2) } }
+			@keyframes dash { from { stroke-dashoffset: 100 } to { stroke-dashoffset: 0 } }
+			.spinner { animation: spin 2s linear infinite; transform-origin: center; }
+			.fade { animation: fade 1s linear 0.5s alternate 2 backwards; }
+			.dash { animation-name: dash; animation-duration: 1s; animation-timing-function: linear; animation-fill-mode: forwards; }
+		]]></style>
+		<rect class="spinner" x="40" y="40" width="10" height="10"/>
+		<rect class="fade" width="10" height="10"/>
+		<path class="dash" d="M0 0 H100" stroke="black" stroke-dasharray="100"/>
+	</svg>`)
+
+	if len(icon.Animations) != 3 {
+		t.Fatalf("expected 3 animations, got %d", len(icon.Animations))
+	}
+	if end := icon.AnimationEnd(); end != Indefinite {
+		t.Errorf("expected an indefinite animation, got %v", end)
+	}
+
+	overrides := icon.Animate(500 * time.Millisecond)
+	// The rotation of 90 degrees is around the center of the view-box.
+	if x, y := overrides[0].Transform.Transform(100, 50); math.Abs(x-50) > 1e-6 || math.Abs(y-100) > 1e-6 {
+		t.Errorf("expected the point rotated by 90 degrees around the center, got %v, %v", x, y)
+	}
+	if o := overrides[2].Style.Dash.DashOffset; !almostEqual(o, 50) {
+		t.Errorf("expected the dash offset 50, got %v", o)
+	}
+
+	for _, d := range []struct {
+		t       time.Duration
+		opacity float64
+	}{
+		{t: 0, opacity: 0},                        // backwards
+		{t: 750 * time.Millisecond, opacity: 0.5}, // linear
+		{t: 1250 * time.Millisecond, opacity: 1},  // steps(2), then the implicit 100%
+		{t: 1750 * time.Millisecond, opacity: 1},  // alternate
+		{t: 2250 * time.Millisecond, opacity: 0.5},
+		{t: 3 * time.Second, opacity: 1}, // no fill-mode forwards
+	} {
+		overrides := icon.Animate(d.t)
+		opacity := icon.SVGPaths[1].Style.FillOpacity
+		if overrides[1].Style != nil {
+			opacity = overrides[1].Style.FillOpacity
+		}
+		if !almostEqual(opacity, d.opacity) {
+			t.Errorf("at %v: expected opacity %v, got %v", d.t, d.opacity, opacity)
+		}
+	}
+
+	overrides = icon.Animate(5 * time.Second)
+	if o := overrides[2].Style; o == nil || o.Dash.DashOffset != 0 {
+		t.Errorf("expected the frozen dash offset 0, got %v", o)
+	}
+}
+
+func TestDash(t *testing.T) {
+	var path Path
+	path.Start(f32.Pt(0, 0))
+	path.Line(f32.Pt(10, 0))
+	path.Line(f32.Pt(10, 10))
+
+	dashed := path.dash(DashOptions{Dash: []float64{4}, DashOffset: 2}, 0.1)
+	expected := Path{
+		OpMoveTo(f32.Pt(0, 0)), OpLineTo(f32.Pt(2, 0)),
+		OpMoveTo(f32.Pt(6, 0)), OpLineTo(f32.Pt(10, 0)),
+		OpMoveTo(f32.Pt(10, 4)), OpLineTo(f32.Pt(10, 8)),
+	}
+	if dashed.String() != expected.String() {
+		t.Errorf("expected %v, got %v", expected, dashed)
+	}
+
+	if dashed := path.dash(DashOptions{Dash: []float64{0, 0}}, 0.1); dashed.String() != path.String() {
+		t.Errorf("expected the path without dashes, got %v", dashed)
+	}
+}
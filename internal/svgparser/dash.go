@@ -0,0 +1,102 @@
+package svgparser
+
+import (
+	"math"
+
+	"gioui.org/f32"
+)
+
+// dashTolerance is the maximum distance, in the coordinates of the
+// Driver, between the dashes and the curves of the path.
+const dashTolerance = 0.1
+
+// pattern returns the dash pattern of the `stroke-dasharray`, which is
+// repeated if the number of values is odd. It returns nil if the pattern
+// doesn't create dashes, such as "0" or negative values.
+func (d DashOptions) pattern() []float64 {
+	var total float64
+	for _, v := range d.Dash {
+		if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil
+		}
+		total += v
+	}
+	if total <= 0 {
+		return nil
+	}
+	if len(d.Dash)%2 == 1 {
+		return append(append([]float64(nil), d.Dash...), d.Dash...)
+	}
+	return d.Dash
+}
+
+// dash splits the path into the dashes of the DashOptions, the curves are
+// flattened within the given tolerance. The pattern restarts at the beginning
+// of each sub-path, shifted by the `stroke-dashoffset`.
+func (p Path) dash(d DashOptions, tolerance float64) Path {
+	pattern := d.pattern()
+	if pattern == nil {
+		return p
+	}
+	var total float64
+	for _, v := range pattern {
+		total += v
+	}
+	offset := math.Mod(d.DashOffset, total)
+	if offset < 0 {
+		offset += total
+	}
+
+	var (
+		out       Path
+		index     int     // index of the current dash, even indices are drawn
+		remaining float64 // remaining length of the current dash
+		drawing   bool    // drawing is true if the last point is part of the current dash
+		last      f32.Point
+		started   bool
+	)
+	restart := func() {
+		index, remaining, drawing = 0, pattern[0], false
+		for o := offset; o > 0; {
+			if o < remaining {
+				remaining -= o
+				break
+			}
+			o -= remaining
+			index = (index + 1) % len(pattern)
+			remaining = pattern[index]
+		}
+	}
+
+	p.flatten(tolerance, false, func(a, b f32.Point) {
+		if !started || a != last {
+			// A new sub-path.
+			restart()
+			started = true
+		}
+		last = b
+
+		length := math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y))
+		var position float64
+		for position < length {
+			step := math.Min(remaining, length-position)
+			if index%2 == 0 {
+				if !drawing {
+					out.Start(lerp(a, b, position/length))
+					drawing = true
+				}
+				out.Line(lerp(a, b, (position+step)/length))
+			}
+			position += step
+			if remaining -= step; remaining <= 0 {
+				index = (index + 1) % len(pattern)
+				remaining, drawing = pattern[index], false
+			}
+		}
+	})
+	return out
+}
+
+func lerp(a, b f32.Point, t float64) f32.Point {
+	return a.Add(b.Sub(a).Mul(float32(t)))
+}
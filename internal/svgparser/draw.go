@@ -1,6 +1,9 @@
 package svgparser
 
 import (
+	"image/color"
+	"math"
+
 	"gioui.org/f32"
 )
 
@@ -12,7 +15,7 @@ import (
 // Drawer knows how to do the actual draw operations
 // but doesn't need any SVG kwowledge
 // In particular, tranformations matrix are already applied to the points
-// before sending them to the Drawer.
+// before sending them to the Drawer, and to the gradients given to Draw.
 type Drawer interface {
 	// Start starts a new path at the given point.
 	Start(a f32.Point)
@@ -183,11 +186,129 @@ var DefaultStyle = PathStyle{
 	Transform:   Identity,
 }
 
-// SetTarget sets the Transform matrix to draw within the bounds of the rectangle arguments
+// SetTarget sets the Transform matrix to draw within the bounds of the rectangle arguments.
+//
+// SetTarget modifies the SVGRender, use TargetTransform and DrawWith
+// if the same SVGRender is drawn from multiple goroutines.
 func (s *SVGRender) SetTarget(x, y, w, h float64) {
+	s.Transform = s.TargetTransform(x, y, w, h)
+}
+
+// TargetTransform returns the matrix to draw within the bounds of the rectangle arguments,
+// without modifying the SVGRender. The origin of the viewBox is drawn at (x, y).
+func (s *SVGRender) TargetTransform(x, y, w, h float64) Matrix2D {
 	scaleW := w / s.ViewBox.W
 	scaleH := h / s.ViewBox.H
-	s.Transform = Identity.Translate(x-s.ViewBox.X, y-s.ViewBox.Y).Scale(scaleW, scaleH)
+	return Identity.Translate(x, y).Scale(scaleW, scaleH).Translate(-s.ViewBox.X, -s.ViewBox.Y)
+}
+
+// DrawOptions holds the state of a single draw call. It's given
+// to DrawWith, so the SVGRender is never modified while drawing.
+type DrawOptions struct {
+	Transform Matrix2D // Transform applied to all paths, see `TargetTransform`
+	Opacity   float64  // Opacity composed with the opacity of each path
+	Colors    ColorMap // Colors replaced before drawing
+
+	// Variables defines the CSS custom properties, by name
+	// without the `--` prefix. The value is not replaced by Colors.
+	Variables map[string]color.NRGBA
+
+	// Overrides changes each SvgPath, by index, and must be nil or have
+	// the same length of SVGPaths.
+	Overrides []Override
+}
+
+// Override changes how a single SvgPath is drawn, without
+// modifying the SvgPath itself.
+type Override struct {
+	Hidden    bool     // Hidden skips the SvgPath
+	Transform Matrix2D // Transform is applied after the SvgPath transform, in the viewBox coordinates
+	Opacity   float64  // Opacity is composed with the fill and stroke opacity
+
+	// FillerColor and LinerColor replaces the style colors, if not nil.
+	FillerColor, LinerColor Pattern
+
+	// Style and Path replaces the style and the geometry of the SvgPath, if not
+	// nil, such as animated by Animate.
+	Style *PathStyle
+	Path  Path
+}
+
+// DefaultOverride is the Override which doesn't change the SvgPath.
+var DefaultOverride = Override{Transform: Identity, Opacity: 1}
+
+// Compose returns the Override which applies `o` over `a`, such as
+// the changes made by the user over the animation.
+func (o Override) Compose(a Override) Override {
+	a.Hidden = a.Hidden || o.Hidden
+	a.Transform = o.Transform.Mult(a.Transform)
+	a.Opacity *= o.Opacity
+	if o.FillerColor != nil {
+		a.FillerColor = o.FillerColor
+	}
+	if o.LinerColor != nil {
+		a.LinerColor = o.LinerColor
+	}
+	if o.Style != nil {
+		a.Style = o.Style
+	}
+	if o.Path != nil {
+		a.Path = o.Path
+	}
+	return a
+}
+
+// WithOverrides returns a copy of the SVGRender with the Overrides applied
+// to the SvgPath, such as to write the changes made to the paths. The hidden
+// SvgPath are removed, and the Override.Transform and Override.Opacity are
+// merged into the style. The overrides must be nil or have the same length
+// as SVGPaths.
+func (s *SVGRender) WithOverrides(overrides []Override) *SVGRender {
+	if overrides == nil {
+		return s
+	}
+	c := *s
+	c.SVGPaths = make([]SvgPath, 0, len(s.SVGPaths))
+	for i, svgp := range s.SVGPaths {
+		override := overrides[i]
+		path, style := svgp.resolved(override)
+		if override.Hidden || len(path) == 0 {
+			continue
+		}
+		svgp.Path, svgp.Style = path, *style
+		svgp.Style.Transform = override.Transform.Mult(style.Transform)
+		svgp.Style.FillOpacity *= override.Opacity
+		svgp.Style.LineOpacity *= override.Opacity
+		svgp.Style.FillerColor, svgp.Style.LinerColor = override.patterns(style)
+		c.SVGPaths = append(c.SVGPaths, svgp)
+	}
+	return &c
+}
+
+// resolved returns the path and the style of the SvgPath, after the Override.
+func (svgp *SvgPath) resolved(override Override) (Path, *PathStyle) {
+	path, style := svgp.Path, &svgp.Style
+	if override.Path != nil {
+		path = override.Path
+	}
+	if override.Style != nil {
+		style = override.Style
+	}
+	return path, style
+}
+
+// patterns returns the fill and the stroke of the style, after the
+// Override. The colors of the Override only replace the existing fill and
+// stroke, so the paths without fill, such as `fill="none"`, are never filled.
+func (override Override) patterns(style *PathStyle) (fill, stroke Pattern) {
+	fill, stroke = style.FillerColor, style.LinerColor
+	if fill != nil && override.FillerColor != nil {
+		fill = override.FillerColor
+	}
+	if stroke != nil && override.LinerColor != nil {
+		stroke = override.LinerColor
+	}
+	return fill, stroke
 }
 
 // Draw the compiled SVG icon into the driver `d`.
@@ -196,60 +317,111 @@ func (s *SVGRender) SetTarget(x, y, w, h float64) {
 // All elements should be contained by the Bounds rectangle of the SVGRender:
 // see `SetTarget` method.
 func (s *SVGRender) Draw(d Driver, opacity float64) {
-	for _, svgp := range s.SVGPaths {
-		svgp.drawTransformed(d, opacity, s.Transform)
+	s.DrawWith(d, DrawOptions{Transform: s.Transform, Opacity: opacity})
+}
+
+// DrawWith is like Draw, but uses the given DrawOptions instead of
+// the Transform of the SVGRender. The SVGRender is never modified, so
+// it's safe to call DrawWith from multiple goroutines.
+func (s *SVGRender) DrawWith(d Driver, o DrawOptions) {
+	for i := range s.SVGPaths {
+		s.DrawPath(d, i, o)
 	}
 }
 
-// drawTransformed draws the compiled SvgPath into the driver while applying transform t.
-func (svgp *SvgPath) drawTransformed(d Driver, opacity float64, t Matrix2D) {
-	m := svgp.Style.Transform
-	svgp.Style.Transform = t.Mult(m)
-	defer func() { svgp.Style.Transform = m }() // Restore untransformed matrix
+// DrawPath draws only the SvgPath at the given index, see DrawWith.
+func (s *SVGRender) DrawPath(d Driver, index int, o DrawOptions) {
+	override := DefaultOverride
+	if o.Overrides != nil {
+		override = o.Overrides[index]
+	}
+	if path, _ := s.SVGPaths[index].resolved(override); override.Hidden || len(path) == 0 {
+		return
+	}
+	s.SVGPaths[index].drawTransformed(d, o, override)
+}
 
-	filler, stroker := d.SetupDrawers(svgp.Style.FillerColor != nil, svgp.Style.LinerColor != nil)
+// TransformedPath returns the path of the SvgPath at the given index, in the
+// coordinates of the DrawOptions.Transform, after the Override. It returns
+// nil if the SvgPath is hidden.
+func (s *SVGRender) TransformedPath(index int, o DrawOptions) Path {
+	override := DefaultOverride
+	if o.Overrides != nil {
+		override = o.Overrides[index]
+	}
+	path, style := s.SVGPaths[index].resolved(override)
+	if override.Hidden || len(path) == 0 {
+		return nil
+	}
+	return path.Transform(o.Transform.Mult(override.Transform).Mult(style.Transform))
+}
+
+// drawTransformed draws the compiled SvgPath into the driver while applying the options.
+func (svgp *SvgPath) drawTransformed(d Driver, o DrawOptions, override Override) {
+	path, style := svgp.resolved(override)
+	transform := o.Transform.Mult(override.Transform).Mult(style.Transform)
+	fillerColor, linerColor := override.patterns(style)
+	fillerColor, linerColor = o.resolve(fillerColor), o.resolve(linerColor)
+	fillerColor, linerColor = transformPattern(fillerColor, path, transform), transformPattern(linerColor, path, transform)
+	opacity := o.Opacity * override.Opacity
+
+	filler, stroker := d.SetupDrawers(fillerColor != nil, linerColor != nil)
 	if filler != nil { // nil color disable filling
-		filler.SetWinding(svgp.Style.UseNonZeroWinding)
+		filler.SetWinding(style.UseNonZeroWinding)
 
-		for _, op := range svgp.Path {
-			op.drawTo(filler, svgp.Style.Transform)
+		for _, op := range path {
+			op.drawTo(filler, transform)
 		}
 		filler.Stop(false)
 
-		filler.Draw(svgp.Style.FillerColor, svgp.Style.FillOpacity*opacity)
+		filler.Draw(fillerColor, style.FillOpacity*opacity)
 		filler.SetWinding(true) // default is true
 	}
 
 	if stroker != nil { // nil color disable lining
-		lineGap := svgp.Style.Join.LineGap
-		if lineGap == NilGap {
-			lineGap = DefaultStyle.Join.LineGap
-		}
-		lineCap := svgp.Style.Join.TrailLineCap
-		if lineCap == NilCap {
-			lineCap = DefaultStyle.Join.TrailLineCap
-		}
-		leadLineCap := lineCap
-		if svgp.Style.Join.LeadLineCap != NilCap {
-			leadLineCap = svgp.Style.Join.LeadLineCap
-		}
 		stroker.SetStrokeOptions(StrokeOptions{
-			LineWidth: float32(svgp.Style.LineWidth),
-			Join: JoinOptions{
-				MiterLimit:   svgp.Style.Join.MiterLimit,
-				LineJoin:     svgp.Style.Join.LineJoin,
-				LeadLineCap:  leadLineCap,
-				TrailLineCap: lineCap,
-				LineGap:      lineGap,
-			},
-			Dash: svgp.Style.Dash,
+			LineWidth: float32(style.LineWidth),
+			Join:      style.strokeJoin(),
 		})
 
-		for _, op := range svgp.Path {
-			op.drawTo(stroker, svgp.Style.Transform)
+		// The dashes are created here, since the drivers only draw solid lines.
+		strokePath := path
+		if len(style.Dash.Dash) > 0 {
+			det := transform.A*transform.D - transform.B*transform.C
+			if det == 0 {
+				return
+			}
+			strokePath = path.dash(style.Dash, dashTolerance/math.Sqrt(math.Abs(det)))
+		}
+		for _, op := range strokePath {
+			op.drawTo(stroker, transform)
 		}
 		stroker.Stop(false)
 
-		stroker.Draw(svgp.Style.LinerColor, svgp.Style.LineOpacity*opacity)
+		stroker.Draw(linerColor, style.LineOpacity*opacity)
+	}
+}
+
+// strokeJoin returns the JoinOptions of the style, replacing the
+// unset caps and gap by the defaults.
+func (style *PathStyle) strokeJoin() JoinOptions {
+	lineGap := style.Join.LineGap
+	if lineGap == NilGap {
+		lineGap = DefaultStyle.Join.LineGap
+	}
+	lineCap := style.Join.TrailLineCap
+	if lineCap == NilCap {
+		lineCap = DefaultStyle.Join.TrailLineCap
+	}
+	leadLineCap := lineCap
+	if style.Join.LeadLineCap != NilCap {
+		leadLineCap = style.Join.LeadLineCap
+	}
+	return JoinOptions{
+		MiterLimit:   style.Join.MiterLimit,
+		LineJoin:     style.Join.LineJoin,
+		LeadLineCap:  leadLineCap,
+		TrailLineCap: lineCap,
+		LineGap:      lineGap,
 	}
 }
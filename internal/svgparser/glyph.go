@@ -0,0 +1,92 @@
+package svgparser
+
+import (
+	"errors"
+
+	"gioui.org/f32"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// ReadGlyph reads the outline of the glyph, in font units. The glyph is
+// filled with the CurrentColor, and the ViewBox is the advance width of the
+// glyph and the height of the line, from the ascent to the descent.
+func ReadGlyph(f *sfnt.Font, x sfnt.GlyphIndex) (*SVGRender, error) {
+	var buf sfnt.Buffer
+	ppem := fixed.I(int(f.UnitsPerEm()))
+	segments, err := f.LoadGlyph(&buf, x, ppem, nil)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := f.Metrics(&buf, ppem, font.HintingNone)
+	if err != nil {
+		return nil, err
+	}
+	bounds, advance, err := f.GlyphBounds(&buf, x, ppem, font.HintingNone)
+	if err != nil {
+		return nil, err
+	}
+
+	icon := &SVGRender{defs: make(map[string][]definition), grads: make(map[string]*Gradient), Transform: Identity}
+	icon.ViewBox = Bounds{W: fixedToFloat(advance), H: fixedToFloat(metrics.Ascent + metrics.Descent)}
+
+	// The origin of the glyph is the baseline, but the viewBox starts
+	// at the ascent.
+	origin := fixed.Point26_6{Y: -metrics.Ascent}
+	if advance <= 0 {
+		// Some icon fonts don't advance, then the viewBox starts
+		// at the glyph.
+		icon.ViewBox.W = fixedToFloat(bounds.Max.X - bounds.Min.X)
+		origin.X = bounds.Min.X
+	}
+	pt := func(p fixed.Point26_6) f32.Point {
+		return f32.Pt(float32(fixedToFloat(p.X-origin.X)), float32(fixedToFloat(p.Y-origin.Y)))
+	}
+
+	var path Path
+	for _, s := range segments {
+		switch s.Op {
+		case sfnt.SegmentOpMoveTo:
+			if len(path) > 0 {
+				path.Stop(true)
+			}
+			path.Start(pt(s.Args[0]))
+		case sfnt.SegmentOpLineTo:
+			path.Line(pt(s.Args[0]))
+		case sfnt.SegmentOpQuadTo:
+			path.QuadBezier(pt(s.Args[0]), pt(s.Args[1]))
+		case sfnt.SegmentOpCubeTo:
+			path.CubeBezier(pt(s.Args[0]), pt(s.Args[1]), pt(s.Args[2]))
+		}
+	}
+	if len(path) == 0 {
+		return icon, errors.New("sfnt: the glyph is empty")
+	}
+	path.Stop(true)
+
+	style := DefaultStyle
+	style.FillerColor = CurrentColor{}
+	icon.SVGPaths = append(icon.SVGPaths, SvgPath{Path: path, Style: style})
+	return icon, nil
+}
+
+// GlyphIndexByName returns the index of the glyph with the given
+// PostScript name, such as `home` for Material Symbols.
+func GlyphIndexByName(f *sfnt.Font, name string) (sfnt.GlyphIndex, error) {
+	var buf sfnt.Buffer
+	for x := 0; x < f.NumGlyphs(); x++ {
+		n, err := f.GlyphName(&buf, sfnt.GlyphIndex(x))
+		if err != nil {
+			return 0, err
+		}
+		if n == name {
+			return sfnt.GlyphIndex(x), nil
+		}
+	}
+	return 0, sfnt.ErrNotFound
+}
+
+func fixedToFloat(v fixed.Int26_6) float64 {
+	return float64(v) / 64
+}
@@ -0,0 +1,53 @@
+package svgparser
+
+import (
+	"testing"
+
+	"gioui.org/f32"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+)
+
+func TestReadGlyph(t *testing.T) {
+	f, err := sfnt.Parse(goregular.TTF)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x, err := GlyphIndexByName(f, "O")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf sfnt.Buffer
+	if r, _ := f.GlyphIndex(&buf, 'O'); r != x {
+		t.Errorf("expected the glyph %d, got %d", r, x)
+	}
+	if _, err := GlyphIndexByName(f, "missing"); err != sfnt.ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	icon, err := ReadGlyph(f, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if icon.ViewBox.X != 0 || icon.ViewBox.Y != 0 || icon.ViewBox.W <= 0 || icon.ViewBox.H <= icon.ViewBox.W {
+		t.Errorf("unexpected viewBox %v", icon.ViewBox)
+	}
+	if len(icon.SVGPaths) != 1 {
+		t.Fatalf("expected 1 path, got %d", len(icon.SVGPaths))
+	}
+	if _, ok := icon.SVGPaths[0].Style.FillerColor.(CurrentColor); !ok {
+		t.Errorf("expected the current color, got %v", icon.SVGPaths[0].Style.FillerColor)
+	}
+
+	bounds := icon.PathBounds(0, DrawOptions{Transform: Identity})
+	if bounds.Min.Y < 0 || float64(bounds.Max.Y) > icon.ViewBox.H || float64(bounds.Max.X) > icon.ViewBox.W {
+		t.Errorf("expected the glyph within the viewBox %v, got %v", icon.ViewBox, bounds)
+	}
+	center := bounds.Min.Add(bounds.Max).Mul(0.5)
+	if icon.HitTest(center, DrawOptions{Transform: Identity}) >= 0 {
+		t.Error("expected the counter of the glyph")
+	}
+	if icon.HitTest(f32.Pt(bounds.Min.X+1, center.Y), DrawOptions{Transform: Identity}) != 0 {
+		t.Error("expected the outline of the glyph")
+	}
+}
@@ -0,0 +1,217 @@
+package svgparser
+
+import (
+	"math"
+
+	"gioui.org/f32"
+)
+
+// This file implements the hit testing of paths, which finds
+// the SvgPath under a given point.
+
+// hitTolerance is the maximum distance, in the coordinates of the
+// point given to HitTest, between a curve and its flattened segments.
+const hitTolerance = 0.25
+
+// HitTest returns the index of the topmost SvgPath which contains the point
+// `p`, or -1 if none. The point uses the same coordinates of the DrawOptions.Transform,
+// and the Overrides are respected.
+//
+// Fills are tested using the fill rule of the path and strokes using the
+// stroke width. Paths without fill and without stroke, or hidden by the
+// Overrides, are ignored.
+func (s *SVGRender) HitTest(p f32.Point, o DrawOptions) int {
+	for i := len(s.SVGPaths) - 1; i >= 0; i-- {
+		override := DefaultOverride
+		if o.Overrides != nil {
+			override = o.Overrides[i]
+		}
+		if override.Hidden {
+			continue
+		}
+		if s.SVGPaths[i].contains(p, o.Transform.Mult(override.Transform), override) {
+			return i
+		}
+	}
+	return -1
+}
+
+// contains reports whether the point is inside the fill or the stroke of the
+// SvgPath, after applying the transform t.
+func (svgp *SvgPath) contains(p f32.Point, t Matrix2D, override Override) bool {
+	path, style := svgp.resolved(override)
+	fillerColor, linerColor := override.patterns(style)
+	if fillerColor == nil && linerColor == nil {
+		return false
+	}
+
+	// The point is moved to the coordinates of the path, since inverting
+	// the matrix is cheaper than transforming the path.
+	m := t.Mult(style.Transform)
+	det := m.A*m.D - m.B*m.C
+	if det == 0 || math.IsNaN(det) {
+		return false
+	}
+	x, y := m.Invert().Transform(float64(p.X), float64(p.Y))
+	local := f32.Point{X: float32(x), Y: float32(y)}
+	tolerance := hitTolerance / math.Sqrt(math.Abs(det))
+
+	if fillerColor != nil {
+		winding := 0
+		path.flatten(tolerance, true, func(a, b f32.Point) {
+			winding += crossing(local, a, b)
+		})
+		if style.UseNonZeroWinding && winding != 0 || !style.UseNonZeroWinding && winding%2 != 0 {
+			return true
+		}
+	}
+
+	if linerColor != nil {
+		width := style.LineWidth / 2
+		inside := false
+		path.flatten(tolerance, false, func(a, b f32.Point) {
+			if !inside && distanceToSegment(local, a, b) <= width {
+				inside = true
+			}
+		})
+		return inside
+	}
+	return false
+}
+
+// crossing returns the winding contribution of the segment ab for
+// a horizontal ray starting at p and going to the right.
+func crossing(p, a, b f32.Point) int {
+	if a.Y <= p.Y {
+		if b.Y > p.Y && cross(a, b, p) > 0 {
+			return 1
+		}
+	} else if b.Y <= p.Y && cross(a, b, p) < 0 {
+		return -1
+	}
+	return 0
+}
+
+// cross returns the cross product of ab and ap, which is positive if p is
+// at the left side of ab.
+func cross(a, b, p f32.Point) float64 {
+	return float64(b.X-a.X)*float64(p.Y-a.Y) - float64(p.X-a.X)*float64(b.Y-a.Y)
+}
+
+// distanceToSegment returns the distance between p and the segment ab.
+func distanceToSegment(p, a, b f32.Point) float64 {
+	dx, dy := float64(b.X-a.X), float64(b.Y-a.Y)
+	px, py := float64(p.X-a.X), float64(p.Y-a.Y)
+	if l := dx*dx + dy*dy; l > 0 {
+		t := math.Max(0, math.Min(1, (px*dx+py*dy)/l))
+		px, py = px-t*dx, py-t*dy
+	}
+	return math.Hypot(px, py)
+}
+
+// flatten calls fn for each line segment of the path, approximating the curves
+// with segments within the given tolerance. If fill is true, each sub-path
+// is closed, as required to fill it, otherwise only OpClose closes sub-paths.
+func (p Path) flatten(tolerance float64, fill bool, fn func(a, b f32.Point)) {
+	var start, last f32.Point
+	var open bool
+	closePath := func() {
+		if open && last != start {
+			fn(last, start)
+		}
+		last, open = start, false
+	}
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			if fill {
+				closePath()
+			}
+			start, last, open = f32.Point(op), f32.Point(op), true
+		case OpLineTo:
+			fn(last, f32.Point(op))
+			last, open = f32.Point(op), true
+		case OpQuadTo:
+			flattenCubic(last, last.Add(op[0].Sub(last).Mul(2.0/3.0)), op[1].Add(op[0].Sub(op[1]).Mul(2.0/3.0)), op[1], tolerance, fn)
+			last, open = op[1], true
+		case OpCubicTo:
+			flattenCubic(last, op[0], op[1], op[2], tolerance, fn)
+			last, open = op[2], true
+		case OpClose:
+			open = true
+			closePath()
+		}
+	}
+	if fill {
+		closePath()
+	}
+}
+
+// flattenCubic approximates the cubic bezier curve with segments, using
+// a number of segments based on the distance of the control points.
+func flattenCubic(a, b, c, d f32.Point, tolerance float64, fn func(a, b f32.Point)) {
+	dd := math.Max(
+		math.Hypot(float64(a.X-2*b.X+c.X), float64(a.Y-2*b.Y+c.Y)),
+		math.Hypot(float64(b.X-2*c.X+d.X), float64(b.Y-2*c.Y+d.Y)),
+	)
+	segs := int(math.Ceil(math.Sqrt(dd * 3 / (4 * tolerance))))
+	if segs < 1 {
+		segs = 1
+	}
+	if segs > 1000 || math.IsNaN(dd) {
+		segs = 1000
+	}
+	last := a
+	for i := 1; i <= segs; i++ {
+		t := float32(i) / float32(segs)
+		mt := 1 - t
+		p := a.Mul(mt * mt * mt).Add(b.Mul(3 * mt * mt * t)).Add(c.Mul(3 * mt * t * t)).Add(d.Mul(t * t * t))
+		fn(last, p)
+		last = p
+	}
+}
+
+// PathBounds returns a rectangle which contains the SvgPath at the given index,
+// in the coordinates of the DrawOptions.Transform. The rectangle is conservative:
+// it contains all control points and half of the stroke width. It returns an
+// empty rectangle if the SvgPath is hidden or empty. See TightPathBounds for
+// the exact bounds.
+func (s *SVGRender) PathBounds(index int, o DrawOptions) f32.Rectangle {
+	override := DefaultOverride
+	if o.Overrides != nil {
+		override = o.Overrides[index]
+	}
+	path, style := s.SVGPaths[index].resolved(override)
+	if override.Hidden || len(path) == 0 {
+		return f32.Rectangle{}
+	}
+
+	m := o.Transform.Mult(override.Transform).Mult(style.Transform)
+	var width float64
+	if style.LinerColor != nil {
+		width = style.LineWidth / 2 * math.Sqrt(math.Abs(m.A*m.D-m.B*m.C))
+	}
+
+	r := f32.Rectangle{Min: f32.Pt(float32(math.Inf(1)), float32(math.Inf(1))), Max: f32.Pt(float32(math.Inf(-1)), float32(math.Inf(-1)))}
+	add := func(p f32.Point) {
+		x, y := m.Transform(float64(p.X), float64(p.Y))
+		r.Min.X, r.Min.Y = float32(math.Min(float64(r.Min.X), x-width)), float32(math.Min(float64(r.Min.Y), y-width))
+		r.Max.X, r.Max.Y = float32(math.Max(float64(r.Max.X), x+width)), float32(math.Max(float64(r.Max.Y), y+width))
+	}
+	for _, op := range path {
+		switch op := op.(type) {
+		case OpMoveTo:
+			add(f32.Point(op))
+		case OpLineTo:
+			add(f32.Point(op))
+		case OpQuadTo:
+			add(op[0])
+			add(op[1])
+		case OpCubicTo:
+			add(op[0])
+			add(op[1])
+			add(op[2])
+		}
+	}
+	return r
+}
@@ -0,0 +1,28 @@
+package svgparser
+
+import (
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+func TestPathBounds(t *testing.T) {
+	icon, err := ReadIcon(strings.NewReader(`<svg viewBox="0 0 24 24">
+		<rect x="4" y="4" width="16" height="16" stroke-width="4"/>
+		<rect x="4" y="4" width="16" height="16" stroke="red" stroke-width="4"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The color of the Override doesn't add a stroke to the paths without one.
+	override := DefaultOverride
+	override.LinerColor = NewPlainColor(0, 0, 0xff, 0xff)
+	o := DrawOptions{Transform: Identity, Overrides: []Override{override, override}}
+	for i, expected := range []f32.Rectangle{f32.Rect(4, 4, 20, 20), f32.Rect(2, 2, 22, 22)} {
+		if got := icon.PathBounds(i, o); got != expected {
+			t.Errorf("path %d: expected %v, got %v", i, expected, got)
+		}
+	}
+}
@@ -0,0 +1,460 @@
+package svgparser
+
+import (
+	"errors"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+
+	"gioui.org/f32"
+	"golang.org/x/exp/shiny/iconvg"
+	imagef32 "golang.org/x/image/math/f32"
+)
+
+// iconVGCursor holds the state of ReadIconVG, it implements
+// iconvg.Destination.
+type iconVGCursor struct {
+	icon     *SVGRender
+	metadata iconvg.Metadata
+	err      error
+
+	// probe is the palette with another color at the index zero, which is
+	// the color of widget.Icon. The colors which differ between cReg
+	// and probeReg are the CurrentColor.
+	probe    iconvg.Palette
+	cReg     [64]color.RGBA
+	probeReg [64]color.RGBA
+	nReg     [64]float32
+	cSel     uint8
+	nSel     uint8
+	lod0     float32
+	lod1     float32
+
+	skip  bool            // skip is true if the current path isn't drawn
+	style PathStyle       // style is the style of the current path
+	data  strings.Builder // data is the current path, in the SVG syntax
+}
+
+// ReadIconVG reads the IconVG graphic, such as the icons of
+// golang.org/x/exp/shiny/materialdesign/icons.
+//
+// The colors of the palette index zero, which is the color of Gio's
+// widget.Icon, are read as CurrentColor. Only the paths of the highest level
+// of detail are read.
+func ReadIconVG(stream io.Reader) (*SVGRender, error) {
+	data, err := ioutil.ReadAll(stream)
+	if err != nil {
+		return nil, err
+	}
+	c := &iconVGCursor{
+		icon: &SVGRender{defs: make(map[string][]definition), grads: make(map[string]*Gradient), Transform: Identity},
+	}
+	if err := iconvg.Decode(c, data, nil); err != nil {
+		return c.icon, err
+	}
+	return c.icon, c.err
+}
+
+func (c *iconVGCursor) Reset(m iconvg.Metadata) {
+	c.metadata = m
+	c.probe = m.Palette
+	c.probe[0] = color.RGBA{R: 0x80, G: 0x40, B: 0x20, A: 0xff}
+	if c.probe[0] == m.Palette[0] {
+		c.probe[0].R++
+	}
+	c.cReg, c.probeReg = m.Palette, c.probe
+	c.nReg = [64]float32{}
+	c.cSel, c.nSel = 0, 0
+	c.lod0, c.lod1 = 0, float32(math.Inf(1))
+
+	c.icon.ViewBox = Bounds{
+		X: float64(m.ViewBox.Min[0]),
+		Y: float64(m.ViewBox.Min[1]),
+		W: float64(m.ViewBox.Max[0] - m.ViewBox.Min[0]),
+		H: float64(m.ViewBox.Max[1] - m.ViewBox.Min[1]),
+	}
+}
+
+func (c *iconVGCursor) SetCSel(cSel uint8) { c.cSel = cSel & 0x3f }
+func (c *iconVGCursor) SetNSel(nSel uint8) { c.nSel = nSel & 0x3f }
+
+func (c *iconVGCursor) SetCReg(adj uint8, incr bool, clr iconvg.Color) {
+	c.cReg[(c.cSel-adj)&0x3f] = clr.Resolve(&c.metadata.Palette, &c.cReg)
+	c.probeReg[(c.cSel-adj)&0x3f] = clr.Resolve(&c.probe, &c.probeReg)
+	if incr {
+		c.cSel++
+	}
+}
+
+func (c *iconVGCursor) SetNReg(adj uint8, incr bool, f float32) {
+	c.nReg[(c.nSel-adj)&0x3f] = f
+	if incr {
+		c.nSel++
+	}
+}
+
+func (c *iconVGCursor) SetLOD(lod0, lod1 float32) {
+	c.lod0, c.lod1 = lod0, lod1
+}
+
+func (c *iconVGCursor) StartPath(adj uint8, x, y float32) {
+	c.data.Reset()
+	c.style = DefaultStyle
+	c.style.FillerColor = nil
+	c.skip = !math.IsInf(float64(c.lod1), 1)
+
+	i := (c.cSel - adj) & 0x3f
+	rgba := c.cReg[i]
+	switch {
+	case validPremultipliedColor(rgba):
+		if rgba.A == 0 {
+			c.skip = true
+			break
+		}
+		c.style.FillOpacity = float64(rgba.A) / 0xff
+		if rgba != c.probeReg[i] {
+			c.style.FillerColor = CurrentColor{}
+		} else {
+			c.style.FillerColor = PlainColor{NRGBA: unpremultiply(rgba)}
+		}
+	case rgba.A == 0 && rgba.B&0x80 != 0:
+		g, ok := c.gradient(rgba)
+		if !ok {
+			c.skip = true
+			break
+		}
+		c.style.FillerColor = g
+	default:
+		c.skip = true
+	}
+
+	c.draw('M', x, y)
+}
+
+// gradient returns the Gradient described by the color register.
+func (c *iconVGCursor) gradient(rgba color.RGBA) (Gradient, bool) {
+	nStops, cBase, nBase := int(rgba.R&0x3f), int(rgba.G&0x3f), int(rgba.B&0x3f)
+	g := Gradient{Units: UserSpaceOnUse, Matrix: Identity}
+	switch iconvg.GradientSpread(rgba.G >> 6) {
+	case iconvg.GradientSpreadReflect:
+		g.Spread = ReflectSpread
+	case iconvg.GradientSpreadRepeat:
+		g.Spread = RepeatSpread
+	}
+	prev := math.Inf(-1)
+	for i := 0; i < nStops; i++ {
+		stop := c.cReg[(cBase+i)&0x3f]
+		offset := float64(c.nReg[(nBase+i)&0x3f])
+		if !validPremultipliedColor(stop) || !(0 <= offset && offset <= 1) || !(offset > prev) {
+			return g, false
+		}
+		prev = offset
+		clr := unpremultiply(stop)
+		g.Stops = append(g.Stops, GradStop{
+			StopColor: PlainColor{NRGBA: color.NRGBA{R: clr.R, G: clr.G, B: clr.B, A: 0xff}},
+			Offset:    offset,
+			Opacity:   float64(stop.A) / 0xff,
+		})
+	}
+
+	// The transform goes from the coordinates of the graphic to the
+	// coordinates of the gradient.
+	var m [6]float64
+	for i := range m {
+		m[i] = float64(c.nReg[(nBase-6+i)&0x3f])
+	}
+	if (rgba.B>>6)&0x01 != 0 {
+		g.Direction = Radial{0, 0, 0, 0, 1, 0}
+		g.Matrix = Matrix2D{A: m[0], B: m[3], C: m[1], D: m[4], E: m[2], F: m[5]}.Invert()
+		return g, true
+	}
+	dd := m[0]*m[0] + m[1]*m[1]
+	if dd == 0 {
+		return g, false
+	}
+	x1, y1 := -m[2]*m[0]/dd, -m[2]*m[1]/dd
+	g.Direction = Linear{x1, y1, x1 + m[0]/dd, y1 + m[1]/dd}
+	return g, true
+}
+
+// draw appends the command to the path data.
+func (c *iconVGCursor) draw(command byte, args ...float32) {
+	c.data.WriteByte(command)
+	for i, v := range args {
+		if i > 0 {
+			c.data.WriteByte(' ')
+		}
+		c.data.WriteString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	}
+	c.data.WriteByte(' ')
+}
+
+func (c *iconVGCursor) ClosePathEndPath() {
+	c.draw('Z')
+	if c.skip || c.err != nil {
+		return
+	}
+	var cursor pathCursor
+	if err := cursor.compilePath(c.data.String()); err != nil {
+		c.err = err
+		return
+	}
+	c.icon.SVGPaths = append(c.icon.SVGPaths, SvgPath{Path: append(Path(nil), cursor.path...), Style: c.style})
+}
+
+func (c *iconVGCursor) ClosePathAbsMoveTo(x, y float32) {
+	c.draw('Z')
+	c.draw('M', x, y)
+}
+
+func (c *iconVGCursor) ClosePathRelMoveTo(x, y float32) {
+	c.draw('Z')
+	c.draw('m', x, y)
+}
+
+func (c *iconVGCursor) AbsHLineTo(x float32)                   { c.draw('H', x) }
+func (c *iconVGCursor) RelHLineTo(x float32)                   { c.draw('h', x) }
+func (c *iconVGCursor) AbsVLineTo(y float32)                   { c.draw('V', y) }
+func (c *iconVGCursor) RelVLineTo(y float32)                   { c.draw('v', y) }
+func (c *iconVGCursor) AbsLineTo(x, y float32)                 { c.draw('L', x, y) }
+func (c *iconVGCursor) RelLineTo(x, y float32)                 { c.draw('l', x, y) }
+func (c *iconVGCursor) AbsSmoothQuadTo(x, y float32)           { c.draw('T', x, y) }
+func (c *iconVGCursor) RelSmoothQuadTo(x, y float32)           { c.draw('t', x, y) }
+func (c *iconVGCursor) AbsQuadTo(x1, y1, x, y float32)         { c.draw('Q', x1, y1, x, y) }
+func (c *iconVGCursor) RelQuadTo(x1, y1, x, y float32)         { c.draw('q', x1, y1, x, y) }
+func (c *iconVGCursor) AbsSmoothCubeTo(x2, y2, x, y float32)   { c.draw('S', x2, y2, x, y) }
+func (c *iconVGCursor) RelSmoothCubeTo(x2, y2, x, y float32)   { c.draw('s', x2, y2, x, y) }
+func (c *iconVGCursor) AbsCubeTo(x1, y1, x2, y2, x, y float32) { c.draw('C', x1, y1, x2, y2, x, y) }
+func (c *iconVGCursor) RelCubeTo(x1, y1, x2, y2, x, y float32) { c.draw('c', x1, y1, x2, y2, x, y) }
+
+func (c *iconVGCursor) AbsArcTo(rx, ry, xAxisRotation float32, largeArc, sweep bool, x, y float32) {
+	c.draw('A', rx, ry, xAxisRotation*360, flag(largeArc), flag(sweep), x, y)
+}
+
+func (c *iconVGCursor) RelArcTo(rx, ry, xAxisRotation float32, largeArc, sweep bool, x, y float32) {
+	c.draw('a', rx, ry, xAxisRotation*360, flag(largeArc), flag(sweep), x, y)
+}
+
+func flag(v bool) float32 {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+// validPremultipliedColor reports whether the color is a valid
+// alpha-premultiplied color, otherwise it may describe a gradient.
+func validPremultipliedColor(c color.RGBA) bool {
+	return c.R <= c.A && c.G <= c.A && c.B <= c.A
+}
+
+// unpremultiply returns the color, with an alpha of 0xff.
+func unpremultiply(c color.RGBA) color.NRGBA {
+	if c.A == 0 {
+		return color.NRGBA{A: 0xff}
+	}
+	f := func(v uint8) uint8 { return uint8((uint32(v)*0xff + uint32(c.A)/2) / uint32(c.A)) }
+	return color.NRGBA{R: f(c.R), G: f(c.G), B: f(c.B), A: 0xff}
+}
+
+// WriteIconVG writes the SVGRender as IconVG. IconVG only fills paths,
+// using the non-zero winding rule, so the strokes are converted to their
+// outlines and the even-odd paths are re-oriented. The focal point of radial
+// gradients is the center, and the animations are not written.
+//
+// The CurrentColor is written as the palette index zero, which is the
+// color of Gio's widget.Icon, and the Variable as its fallback.
+func (s *SVGRender) WriteIconVG(w io.Writer) error {
+	if s.ViewBox.W <= 0 || s.ViewBox.H <= 0 {
+		return errors.New("iconvg: the viewBox is empty")
+	}
+	var e iconvg.Encoder
+	e.HighResolutionCoordinates = math.Max(s.ViewBox.W, s.ViewBox.H) < 16
+	e.Reset(iconvg.Metadata{
+		ViewBox: iconvg.Rectangle{
+			Min: imagef32.Vec2{float32(s.ViewBox.X), float32(s.ViewBox.Y)},
+			Max: imagef32.Vec2{float32(s.ViewBox.X + s.ViewBox.W), float32(s.ViewBox.Y + s.ViewBox.H)},
+		},
+		Palette: iconvg.DefaultPalette,
+	})
+	// tolerance is the maximum error of the flattened curves, in the
+	// coordinates of the viewBox.
+	tolerance := math.Max(s.ViewBox.W, s.ViewBox.H) / 1024
+
+	for _, svgp := range s.SVGPaths {
+		style := svgp.Style
+		m := style.Transform
+		scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+		if scale == 0 {
+			continue
+		}
+
+		if style.FillerColor != nil {
+			path := svgp.Path
+			if !style.UseNonZeroWinding {
+				path = path.NonZero(tolerance / scale)
+			}
+			writeIconVGPath(&e, path, svgp.Path, m, style.FillerColor, style.FillOpacity)
+		}
+		if style.LinerColor != nil && style.LineWidth > 0 {
+			path := svgp.Path
+			if style.Dash.pattern() != nil {
+				path = path.dash(style.Dash, dashTolerance/scale)
+			}
+			path = path.StrokeOutline(style.LineWidth, style.Join, tolerance/scale)
+			writeIconVGPath(&e, path, svgp.Path, m, style.LinerColor, style.LineOpacity)
+		}
+	}
+
+	data, err := e.Bytes()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// writeIconVGPath writes the path, after applying the transform `m`. The
+// `original` path defines the bounding box of the gradients.
+func writeIconVGPath(e *iconvg.Encoder, path, original Path, m Matrix2D, pattern Pattern, opacity float64) {
+	if v, ok := pattern.(Variable); ok {
+		pattern = v.Fallback
+	}
+	if len(path) == 0 || pattern == nil || opacity <= 0 {
+		return
+	}
+	switch p := pattern.(type) {
+	case PlainColor:
+		e.SetCReg(0, false, iconvg.RGBAColor(premultiply(p.NRGBA, opacity)))
+	case CurrentColor:
+		if opacity >= 1 {
+			e.SetCReg(0, false, iconvg.PaletteIndexColor(0))
+		} else {
+			// The blend of the palette index zero (0x80) and
+			// the transparent color (0x7f).
+			e.SetCReg(0, false, iconvg.BlendColor(uint8(255-math.Round(255*opacity)), 0x80, 0x7f))
+		}
+	case Gradient:
+		if !writeIconVGGradient(e, p, original, m, opacity) {
+			return
+		}
+	default:
+		return
+	}
+
+	started, closed := false, false
+	var start f32.Point
+	for _, op := range path.Transform(m) {
+		if started && closed {
+			if move, ok := op.(OpMoveTo); ok {
+				start = f32.Point(move)
+				e.ClosePathAbsMoveTo(move.X, move.Y)
+				closed = false
+				continue
+			}
+			e.ClosePathAbsMoveTo(start.X, start.Y)
+			closed = false
+		}
+		switch op := op.(type) {
+		case OpMoveTo:
+			start = f32.Point(op)
+			if !started {
+				e.StartPath(0, op.X, op.Y)
+				started = true
+			} else {
+				e.ClosePathAbsMoveTo(op.X, op.Y)
+			}
+		case OpLineTo:
+			e.AbsLineTo(op.X, op.Y)
+		case OpQuadTo:
+			e.AbsQuadTo(op[0].X, op[0].Y, op[1].X, op[1].Y)
+		case OpCubicTo:
+			e.AbsCubeTo(op[0].X, op[0].Y, op[1].X, op[1].Y, op[2].X, op[2].Y)
+		case OpClose:
+			closed = true
+		}
+	}
+	if started {
+		e.ClosePathEndPath()
+	}
+}
+
+// writeIconVGGradient sets the gradient at the color register CSEL,
+// it returns false if the gradient can't be written.
+func writeIconVGGradient(e *iconvg.Encoder, g Gradient, path Path, m Matrix2D, opacity float64) bool {
+	h := g.userTransform(path, m).Invert()
+
+	var radial bool
+	var aff3 imagef32.Aff3
+	switch d := g.Direction.(type) {
+	case Linear:
+		dx, dy := d[2]-d[0], d[3]-d[1]
+		dd := dx*dx + dy*dy
+		if dd == 0 {
+			return false
+		}
+		aff3 = imagef32.Aff3{
+			float32((dx*h.A + dy*h.B) / dd),
+			float32((dx*h.C + dy*h.D) / dd),
+			float32((dx*(h.E-d[0]) + dy*(h.F-d[1])) / dd),
+			0, 0, 0,
+		}
+	case Radial:
+		r := d[4]
+		if r <= 0 {
+			return false
+		}
+		radial = true
+		aff3 = imagef32.Aff3{
+			float32(h.A / r), float32(h.C / r), float32((h.E - d[0]) / r),
+			float32(h.B / r), float32(h.D / r), float32((h.F - d[1]) / r),
+		}
+	default:
+		return false
+	}
+
+	var stops []iconvg.GradientStop
+	prev := -1.0
+	for _, stop := range g.Stops {
+		// The offsets must be strictly increasing.
+		offset := math.Min(math.Max(stop.Offset, 0), 1)
+		if offset <= prev {
+			offset = prev + 1.0/1024
+		}
+		if offset > 1 || len(stops) == 64-len(aff3)-1 {
+			break
+		}
+		prev = offset
+
+		clr := color.NRGBA{A: 0xff}
+		if stop.StopColor != nil {
+			clr = toNRGBA(stop.StopColor)
+		}
+		clr.A = uint8(math.Round(float64(clr.A) * stop.Opacity * math.Min(opacity, 1)))
+		stops = append(stops, iconvg.GradientStop{Offset: float32(offset), Color: clr})
+	}
+	if len(stops) == 0 {
+		return false
+	}
+
+	spread := iconvg.GradientSpreadPad
+	switch g.Spread {
+	case ReflectSpread:
+		spread = iconvg.GradientSpreadReflect
+	case RepeatSpread:
+		spread = iconvg.GradientSpreadRepeat
+	}
+	e.SetGradient(1, uint8(len(aff3)), radial, aff3, spread, stops)
+	return true
+}
+
+// premultiply returns the alpha-premultiplied color, with
+// the given opacity.
+func premultiply(c color.NRGBA, opacity float64) color.RGBA {
+	a := math.Round(float64(c.A) * math.Min(opacity, 1))
+	f := func(v uint8) uint8 { return uint8(math.Round(float64(v) * a / 0xff)) }
+	return color.RGBA{R: f(c.R), G: f(c.G), B: f(c.B), A: uint8(a)}
+}
@@ -0,0 +1,158 @@
+package svgparser
+
+import (
+	"bytes"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+	"golang.org/x/exp/shiny/iconvg"
+	imagef32 "golang.org/x/image/math/f32"
+)
+
+func TestReadIconVG(t *testing.T) {
+	var e iconvg.Encoder
+	e.Reset(iconvg.Metadata{
+		ViewBox: iconvg.Rectangle{Min: imagef32.Vec2{0, 0}, Max: imagef32.Vec2{24, 24}},
+		Palette: iconvg.DefaultPalette,
+	})
+	e.SetCReg(0, false, iconvg.PaletteIndexColor(0))
+	e.StartPath(0, 2, 2)
+	e.AbsHLineTo(22)
+	e.RelVLineTo(4)
+	e.AbsHLineTo(2)
+	e.ClosePathEndPath()
+
+	e.SetCReg(0, false, iconvg.RGBAColor(color.RGBA{R: 0x40, A: 0x80}))
+	e.StartPath(0, 4, 12)
+	e.AbsArcTo(4, 4, 0, false, true, 12, 12)
+	e.ClosePathEndPath()
+
+	e.SetLinearGradient(1, 6, 0, 0, 24, 0, iconvg.GradientSpreadRepeat, []iconvg.GradientStop{
+		{Offset: 0, Color: color.RGBA{R: 0xff, A: 0xff}},
+		{Offset: 1, Color: color.RGBA{B: 0x80, A: 0x80}},
+	})
+	e.StartPath(0, 0, 20)
+	e.AbsLineTo(24, 20)
+	e.AbsLineTo(24, 24)
+	e.ClosePathEndPath()
+
+	e.SetLOD(0, 16)
+	e.SetCReg(0, false, iconvg.PaletteIndexColor(0))
+	e.StartPath(0, 0, 0)
+	e.AbsLineTo(1, 1)
+	e.ClosePathEndPath()
+
+	data, err := e.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	icon, err := ReadIconVG(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if icon.ViewBox != (Bounds{W: 24, H: 24}) {
+		t.Errorf("unexpected viewBox %v", icon.ViewBox)
+	}
+	if len(icon.SVGPaths) != 3 {
+		t.Fatalf("expected 3 paths, without the low level of detail, got %d", len(icon.SVGPaths))
+	}
+
+	if _, ok := icon.SVGPaths[0].Style.FillerColor.(CurrentColor); !ok {
+		t.Errorf("expected the current color, got %v", icon.SVGPaths[0].Style.FillerColor)
+	}
+	if bounds := icon.PathBounds(0, DrawOptions{Transform: Identity}); bounds.Min.X != 2 || bounds.Max.X != 22 || bounds.Max.Y != 6 {
+		t.Errorf("unexpected bounds %v", bounds)
+	}
+
+	half := icon.SVGPaths[1].Style
+	if half.FillerColor != NewPlainColor(0x80, 0, 0, 0xff) || !almostEqual(half.FillOpacity, 0x80/255.0) {
+		t.Errorf("unexpected fill %v with opacity %v", half.FillerColor, half.FillOpacity)
+	}
+	if bounds := icon.PathBounds(1, DrawOptions{Transform: Identity}); math.Abs(float64(bounds.Min.Y)-8) > 0.1 {
+		t.Errorf("expected the arc, got %v", bounds)
+	}
+
+	g, ok := icon.SVGPaths[2].Style.FillerColor.(Gradient)
+	if !ok || g.Spread != RepeatSpread || len(g.Stops) != 2 || !almostEqual(g.Stops[1].Opacity, 0x80/255.0) {
+		t.Fatalf("unexpected gradient %v", icon.SVGPaths[2].Style.FillerColor)
+	}
+	if d, ok := g.Direction.(Linear); !ok || math.Abs(d[0]) > 1e-3 || math.Abs(d[2]-24) > 1e-3 || d[1] != 0 || d[3] != 0 {
+		t.Errorf("unexpected direction %v", g.Direction)
+	}
+
+	if _, err := ReadIconVG(strings.NewReader("<svg></svg>")); err == nil {
+		t.Error("expected an error for SVG")
+	}
+}
+
+func TestWriteIconVG(t *testing.T) {
+	icon, err := ReadIcon(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<defs>
+			<radialGradient id="g" cx="0.5" cy="0.5" r="0.5">
+				<stop offset="0" stop-color="red"/>
+				<stop offset="1" stop-color="blue"/>
+			</radialGradient>
+		</defs>
+		<path d="M2 2H22V22H2Z M6 6H18V18H6Z" fill-rule="evenodd" fill="currentColor" fill-opacity="0.5"/>
+		<path d="M4 12H20" fill="none" stroke="var(--primary, #00ff00)" stroke-width="2"/>
+		<rect x="4" y="4" width="8" height="8" fill="url(#g)" transform="translate(2 2)"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := icon.WriteIconVG(&out); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := ReadIconVG(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ViewBox != icon.ViewBox {
+		t.Errorf("unexpected viewBox %v", decoded.ViewBox)
+	}
+	if len(decoded.SVGPaths) != 3 {
+		t.Fatalf("expected 3 paths, got %d", len(decoded.SVGPaths))
+	}
+
+	frame := decoded.SVGPaths[0]
+	if _, ok := frame.Style.FillerColor.(CurrentColor); !ok || math.Abs(frame.Style.FillOpacity-0.5) > 0.01 {
+		t.Errorf("unexpected fill %v with opacity %v", frame.Style.FillerColor, frame.Style.FillOpacity)
+	}
+	if !frame.Style.UseNonZeroWinding {
+		t.Error("expected the non-zero winding rule")
+	}
+	if decoded.HitTest(f32.Pt(16, 16), DrawOptions{Transform: Identity}) >= 0 {
+		t.Error("expected the hole of the even-odd path")
+	}
+	if decoded.HitTest(f32.Pt(3, 3), DrawOptions{Transform: Identity}) != 0 {
+		t.Error("expected the frame")
+	}
+
+	line := decoded.SVGPaths[1]
+	if line.Style.FillerColor != NewPlainColor(0, 0xff, 0, 0xff) {
+		t.Errorf("expected the fallback of the variable, got %v", line.Style.FillerColor)
+	}
+	if bounds := decoded.PathBounds(1, DrawOptions{Transform: Identity}); bounds.Min.X != 4 || bounds.Max.X != 20 || bounds.Min.Y != 11 || bounds.Max.Y != 13 {
+		t.Errorf("expected the outline of the stroke, got %v", bounds)
+	}
+
+	g, ok := decoded.SVGPaths[2].Style.FillerColor.(Gradient)
+	if !ok {
+		t.Fatalf("expected the gradient, got %v", decoded.SVGPaths[2].Style.FillerColor)
+	}
+	if x, y := g.Matrix.Transform(0, 0); math.Abs(x-10) > 0.01 || math.Abs(y-10) > 0.01 {
+		t.Errorf("expected the center at 10, 10, got %v, %v", x, y)
+	}
+	if x, _ := g.Matrix.Transform(1, 0); math.Abs(x-14) > 0.01 {
+		t.Errorf("expected the radius of 4, got %v", x-10)
+	}
+
+	if err := (&SVGRender{}).WriteIconVG(&out); err == nil {
+		t.Error("expected an error for the empty viewBox")
+	}
+}
@@ -0,0 +1,494 @@
+package svgparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"sort"
+)
+
+// The Lottie (Bodymovin) JSON is converted to SVG with SMIL animations, which
+// is parsed by ReadIcon. The animated properties are sampled at each frame,
+// so the easing, hold keyframes and trim paths are evaluated here.
+//
+// Supported: shape layers, solid layers, null layers (parenting), precomps,
+// groups, transforms, paths, rectangles, ellipses, polystars, fills, strokes,
+// gradients (not animated), trim paths and the layer in and out points.
+// Not supported: masks, mattes, text, images, expressions and time remapping.
+
+type (
+	// lottieAnimation is the root of the Lottie JSON.
+	lottieAnimation struct {
+		FrameRate float64       `json:"fr"`
+		InPoint   float64       `json:"ip"`
+		OutPoint  float64       `json:"op"`
+		Width     float64       `json:"w"`
+		Height    float64       `json:"h"`
+		Layers    []lottieLayer `json:"layers"`
+		Assets    []lottieAsset `json:"assets"`
+	}
+
+	lottieAsset struct {
+		ID     string        `json:"id"`
+		Layers []lottieLayer `json:"layers"`
+	}
+
+	lottieLayer struct {
+		Type      int             `json:"ty"`
+		Index     *int            `json:"ind"`
+		Parent    *int            `json:"parent"`
+		Hidden    bool            `json:"hd"`
+		Matte     int             `json:"td"` // Matte is 1 if the layer is a matte of another layer
+		InPoint   float64         `json:"ip"`
+		OutPoint  float64         `json:"op"`
+		StartTime float64         `json:"st"`
+		Stretch   float64         `json:"sr"`
+		Transform lottieTransform `json:"ks"`
+		Shapes    []lottieShape   `json:"shapes"`
+		RefID     string          `json:"refId"`
+
+		SolidColor  string  `json:"sc"`
+		SolidWidth  float64 `json:"sw"`
+		SolidHeight float64 `json:"sh"`
+	}
+
+	lottieTransform struct {
+		Anchor    *lottieProperty `json:"a"`
+		Position  *lottieProperty `json:"p"`
+		Scale     *lottieProperty `json:"s"`
+		Rotation  *lottieProperty `json:"r"`
+		RotationZ *lottieProperty `json:"rz"`
+		Opacity   *lottieProperty `json:"o"`
+		Skew      *lottieProperty `json:"sk"`
+		SkewAxis  *lottieProperty `json:"sa"`
+	}
+
+	// lottieShape is one item of the shapes, the fields depend on the type,
+	// such as "gr" for groups or "fl" for fills.
+	lottieShape struct {
+		Type   string          `json:"ty"`
+		Hidden bool            `json:"hd"`
+		Items  []lottieShape   `json:"it"`
+		Path   *lottieProperty `json:"ks"`
+
+		A  *lottieProperty `json:"a"`
+		P  *lottieProperty `json:"p"`
+		S  *lottieProperty `json:"s"`
+		E  *lottieProperty `json:"e"`
+		O  *lottieProperty `json:"o"`
+		C  *lottieProperty `json:"c"`
+		W  *lottieProperty `json:"w"`
+		Sk *lottieProperty `json:"sk"`
+		Sa *lottieProperty `json:"sa"`
+		R  json.RawMessage `json:"r"` // R is the fill rule of fills, or a property
+
+		// The polystar properties.
+		StarType       int             `json:"sy"`
+		Points         *lottieProperty `json:"pt"`
+		OuterRadius    *lottieProperty `json:"or"`
+		InnerRadius    *lottieProperty `json:"ir"`
+		GradientType   int             `json:"t"`
+		GradientColors *lottieGradient `json:"g"`
+
+		LineCap    int          `json:"lc"`
+		LineJoin   int          `json:"lj"`
+		MiterLimit float64      `json:"ml"`
+		Dashes     []lottieDash `json:"d"`
+	}
+
+	lottieGradient struct {
+		Count  int             `json:"p"`
+		Colors *lottieProperty `json:"k"`
+	}
+
+	lottieDash struct {
+		Name  string          `json:"n"`
+		Value *lottieProperty `json:"v"`
+	}
+
+	// lottieProperty is a static or animated value. The shapes
+	// are encoded as numbers, see lottieShapeData.
+	lottieProperty struct {
+		static    []float64
+		keyframes []lottieKeyframe
+		split     []*lottieProperty // split holds the x and y of a separated position
+	}
+
+	lottieKeyframe struct {
+		time       float64
+		start, end []float64
+		hold       bool
+		easing     [4]float64 // easing is the keySpline of the out and in tangents
+	}
+
+	// lottieShapeData is the shape of a path, with the vertices and
+	// the tangents relative to the vertices.
+	lottieShapeData struct {
+		Closed   bool        `json:"c"`
+		Vertices [][]float64 `json:"v"`
+		In       [][]float64 `json:"i"`
+		Out      [][]float64 `json:"o"`
+	}
+)
+
+// ReadLottie reads the Lottie (Bodymovin) JSON. The animation
+// repeats indefinitely, see Animate.
+func ReadLottie(stream io.Reader) (*SVGRender, error) {
+	var anim lottieAnimation
+	if err := json.NewDecoder(stream).Decode(&anim); err != nil {
+		return nil, err
+	}
+	if anim.Width <= 0 || anim.Height <= 0 {
+		return nil, errors.New("lottie: invalid size")
+	}
+	return ReadIcon(bytes.NewReader(anim.svg()))
+}
+
+// UnmarshalJSON reads the property, which is either `{"k": ...}` or the
+// separated position `{"s": true, "x": ..., "y": ...}`.
+func (p *lottieProperty) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		K     json.RawMessage `json:"k"`
+		Split bool            `json:"s"`
+		X, Y  json.RawMessage
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Split {
+		for _, v := range []json.RawMessage{raw.X, raw.Y} {
+			var axis lottieProperty
+			if err := json.Unmarshal(v, &axis); err != nil {
+				return err
+			}
+			p.split = append(p.split, &axis)
+		}
+		return nil
+	}
+
+	k := bytes.TrimSpace(raw.K)
+	if len(k) == 0 {
+		return nil
+	}
+	if k[0] == '[' {
+		var list []json.RawMessage
+		if err := json.Unmarshal(k, &list); err != nil {
+			return err
+		}
+		if len(list) > 0 && bytes.HasPrefix(bytes.TrimSpace(list[0]), []byte("{")) {
+			if isKeyframe(list[0]) {
+				return p.readKeyframes(list)
+			}
+		}
+	}
+	var err error
+	p.static, err = lottieValues(k)
+	return err
+}
+
+// isKeyframe reports whether the object is a keyframe, with the time "t".
+func isKeyframe(data json.RawMessage) bool {
+	var keys map[string]json.RawMessage
+	if json.Unmarshal(data, &keys) != nil {
+		return false
+	}
+	_, ok := keys["t"]
+	return ok
+}
+
+func (p *lottieProperty) readKeyframes(list []json.RawMessage) error {
+	for _, data := range list {
+		var raw struct {
+			Time  float64         `json:"t"`
+			Start json.RawMessage `json:"s"`
+			End   json.RawMessage `json:"e"`
+			Hold  json.RawMessage `json:"h"`
+			In    *lottieTangent  `json:"i"`
+			Out   *lottieTangent  `json:"o"`
+		}
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return err
+		}
+		kf := lottieKeyframe{time: raw.Time, easing: [4]float64{0, 0, 1, 1}}
+		kf.hold = string(raw.Hold) == "1" || string(raw.Hold) == "true"
+		if raw.Out != nil && raw.In != nil {
+			kf.easing = [4]float64{raw.Out.X, raw.Out.Y, raw.In.X, raw.In.Y}
+		}
+		var err error
+		if len(raw.Start) > 0 {
+			if kf.start, err = lottieValues(raw.Start); err != nil {
+				return err
+			}
+		}
+		if len(raw.End) > 0 {
+			if kf.end, err = lottieValues(raw.End); err != nil {
+				return err
+			}
+		}
+		p.keyframes = append(p.keyframes, kf)
+	}
+	sort.SliceStable(p.keyframes, func(i, j int) bool { return p.keyframes[i].time < p.keyframes[j].time })
+
+	// The old format has no start value at the last keyframe.
+	for i := range p.keyframes {
+		if p.keyframes[i].start == nil && i > 0 {
+			p.keyframes[i].start = p.keyframes[i-1].end
+		}
+	}
+	return nil
+}
+
+// lottieTangent is the easing tangent of the keyframe, which
+// has one value for each dimension, only the first is used.
+type lottieTangent struct {
+	X, Y float64
+}
+
+func (t *lottieTangent) UnmarshalJSON(data []byte) error {
+	var raw struct{ X, Y json.RawMessage }
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	x, err := lottieValues(raw.X)
+	if err != nil {
+		return err
+	}
+	y, err := lottieValues(raw.Y)
+	if err != nil {
+		return err
+	}
+	if len(x) > 0 && len(y) > 0 {
+		t.X, t.Y = x[0], y[0]
+	}
+	return nil
+}
+
+// lottieValues decodes a number, a list of numbers or a shape, which
+// may be inside a list.
+func lottieValues(data json.RawMessage) ([]float64, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil
+	}
+	switch data[0] {
+	case '{':
+		var shape lottieShapeData
+		if err := json.Unmarshal(data, &shape); err != nil {
+			return nil, err
+		}
+		return shape.encode(), nil
+	case '[':
+		var list []json.RawMessage
+		if err := json.Unmarshal(data, &list); err != nil {
+			return nil, err
+		}
+		if len(list) > 0 && bytes.HasPrefix(bytes.TrimSpace(list[0]), []byte("{")) {
+			return lottieValues(list[0])
+		}
+		values := make([]float64, len(list))
+		for i, v := range list {
+			if err := json.Unmarshal(v, &values[i]); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	default:
+		var v float64
+		if err := json.Unmarshal(data, &v); err != nil {
+			return nil, err
+		}
+		return []float64{v}, nil
+	}
+}
+
+// encode returns the shape as numbers: closed, the number of
+// vertices and the vertices, in and out tangents.
+func (s lottieShapeData) encode() []float64 {
+	values := []float64{0, float64(len(s.Vertices))}
+	if s.Closed {
+		values[0] = 1
+	}
+	for _, list := range [][][]float64{s.Vertices, s.In, s.Out} {
+		for i := range s.Vertices {
+			x, y := 0.0, 0.0
+			if i < len(list) && len(list[i]) >= 2 {
+				x, y = list[i][0], list[i][1]
+			}
+			values = append(values, x, y)
+		}
+	}
+	return values
+}
+
+// at returns the value at the given frame.
+func (p *lottieProperty) at(frame float64) []float64 {
+	if p == nil {
+		return nil
+	}
+	if p.split != nil {
+		var values []float64
+		for _, axis := range p.split {
+			values = append(values, axis.at(frame)...)
+		}
+		return values
+	}
+	if len(p.keyframes) == 0 {
+		return p.static
+	}
+
+	first, last := p.keyframes[0], p.keyframes[len(p.keyframes)-1]
+	if frame <= first.time {
+		return first.start
+	}
+	if frame >= last.time {
+		if last.start == nil {
+			return first.start
+		}
+		return last.start
+	}
+	i := sort.Search(len(p.keyframes), func(i int) bool { return p.keyframes[i].time > frame }) - 1
+	kf, next := p.keyframes[i], p.keyframes[i+1]
+	end := kf.end
+	if end == nil {
+		end = next.start
+	}
+	if kf.hold || next.time <= kf.time || len(end) != len(kf.start) {
+		return kf.start
+	}
+	t := keySpline(kf.easing, (frame-kf.time)/(next.time-kf.time))
+	values := make([]float64, len(kf.start))
+	for j := range values {
+		values[j] = kf.start[j] + (end[j]-kf.start[j])*t
+	}
+	return values
+}
+
+// value returns the value of the property at the given frame,
+// the missing values are taken from def.
+func (p *lottieProperty) value(frame float64, def ...float64) []float64 {
+	values := p.at(frame)
+	if len(values) >= len(def) {
+		return values
+	}
+	return append(append([]float64(nil), values...), def[len(values):]...)
+}
+
+// animated reports whether the property changes over time.
+func (p *lottieProperty) animated() bool {
+	if p == nil {
+		return false
+	}
+	for _, axis := range p.split {
+		if axis.animated() {
+			return true
+		}
+	}
+	return len(p.keyframes) > 1
+}
+
+// lottieRect returns the rectangle, or the ellipse, centered at the position.
+func lottieRect(x, y, w, h, radius float64, ellipse bool) (path Path) {
+	if ellipse {
+		radius = math.Inf(1)
+	}
+	radius = math.Min(radius, math.Min(w, h)/2)
+	left, top, right, bottom := x-w/2, y-h/2, x+w/2, y+h/2
+	if radius <= 0 {
+		path.Start(pt(right, top))
+		path.Line(pt(right, bottom))
+		path.Line(pt(left, bottom))
+		path.Line(pt(left, top))
+		path.Stop(true)
+		return path
+	}
+	rx, ry := radius, radius
+	if ellipse {
+		rx, ry = w/2, h/2
+	}
+	k := 4 * (math.Sqrt2 - 1) / 3 // the distance of the control points of a quarter circle
+	path.Start(pt(right-rx, top))
+	corner := func(cx, cy, fromX, fromY, toX, toY float64) {
+		// The corner goes from (cx+fromX, cy+fromY) to (cx+toX, cy+toY), around (cx, cy).
+		path.CubeBezier(pt(cx+fromX+toX*k, cy+fromY+toY*k), pt(cx+toX+fromX*k, cy+toY+fromY*k), pt(cx+toX, cy+toY))
+	}
+	corner(right-rx, top+ry, 0, -ry, rx, 0)
+	if bottom-ry > top+ry {
+		path.Line(pt(right, bottom-ry))
+	}
+	corner(right-rx, bottom-ry, rx, 0, 0, ry)
+	if left+rx < right-rx {
+		path.Line(pt(left+rx, bottom))
+	}
+	corner(left+rx, bottom-ry, 0, ry, -rx, 0)
+	if top+ry < bottom-ry {
+		path.Line(pt(left, top+ry))
+	}
+	corner(left+rx, top+ry, -rx, 0, 0, -ry)
+	path.Stop(true)
+	return path
+}
+
+// lottieStar returns the polystar, which is a star (1) or a polygon (2).
+func lottieStar(star bool, x, y, points, outer, inner, rotation float64) (path Path) {
+	n := int(math.Round(points))
+	if n < 2 {
+		return nil
+	}
+	vertices := n
+	if star {
+		vertices *= 2
+	}
+	for i := 0; i < vertices; i++ {
+		radius := outer
+		if star && i%2 == 1 {
+			radius = inner
+		}
+		angle := (rotation-90)*math.Pi/180 + float64(i)*2*math.Pi/float64(vertices)
+		p := pt(x+radius*math.Cos(angle), y+radius*math.Sin(angle))
+		if i == 0 {
+			path.Start(p)
+		} else {
+			path.Line(p)
+		}
+	}
+	path.Stop(true)
+	return path
+}
+
+// lottieShapePath returns the path of the shape data, encoded by lottieShapeData.encode.
+func lottieShapePath(values []float64) (path Path) {
+	if len(values) < 2 {
+		return nil
+	}
+	n := int(values[1])
+	if n == 0 || len(values) < 2+6*n {
+		return nil
+	}
+	vertex := func(i int) (v, in, out [2]float64) {
+		v = [2]float64{values[2+2*i], values[3+2*i]}
+		in = [2]float64{values[2+2*n+2*i], values[3+2*n+2*i]}
+		out = [2]float64{values[2+4*n+2*i], values[3+4*n+2*i]}
+		return v, in, out
+	}
+	segment := func(from, to int) {
+		a, _, out := vertex(from)
+		b, in, _ := vertex(to)
+		if out == [2]float64{} && in == [2]float64{} {
+			path.Line(pt(b[0], b[1]))
+			return
+		}
+		path.CubeBezier(pt(a[0]+out[0], a[1]+out[1]), pt(b[0]+in[0], b[1]+in[1]), pt(b[0], b[1]))
+	}
+	v, _, _ := vertex(0)
+	path.Start(pt(v[0], v[1]))
+	for i := 1; i < n; i++ {
+		segment(i-1, i)
+	}
+	if values[0] > 0.5 {
+		segment(n-1, 0)
+		path.Stop(true)
+	}
+	return path
+}
@@ -0,0 +1,600 @@
+package svgparser
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"gioui.org/f32"
+)
+
+// lottieMaxSamples limits the number of frames sampled for each property.
+const lottieMaxSamples = 600
+
+// lottieConverter writes the SVG of the lottieAnimation.
+type lottieConverter struct {
+	anim   *lottieAnimation
+	frames []float64 // frames are the sampled frames of the composition
+	dur    string
+
+	defs, body strings.Builder
+	gradients  int
+}
+
+// lottieElement collects the attributes and the animations of one element.
+type lottieElement struct {
+	tag   string
+	attrs strings.Builder
+	anims strings.Builder
+}
+
+// svg returns the SVG document of the animation.
+func (anim *lottieAnimation) svg() []byte {
+	c := &lottieConverter{anim: anim}
+	if anim.FrameRate <= 0 {
+		anim.FrameRate = 30
+	}
+	frames := anim.OutPoint - anim.InPoint
+	if frames <= 0 {
+		frames = 0
+	}
+	n := int(math.Ceil(frames))
+	if n > lottieMaxSamples {
+		n = lottieMaxSamples
+	}
+	for i := 0; i <= n; i++ {
+		f := anim.InPoint
+		if n > 0 {
+			f += frames * float64(i) / float64(n)
+		}
+		c.frames = append(c.frames, f)
+	}
+	c.dur = formatFloat(frames/anim.FrameRate) + "s"
+
+	c.layers(anim.Layers, c.frames)
+
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %s %s" width="%s" height="%s">`,
+		formatFloat(anim.Width), formatFloat(anim.Height), formatFloat(anim.Width), formatFloat(anim.Height))
+	if c.defs.Len() > 0 {
+		s.WriteString("<defs>")
+		s.WriteString(c.defs.String())
+		s.WriteString("</defs>")
+	}
+	s.WriteString(c.body.String())
+	s.WriteString("</svg>")
+	return []byte(s.String())
+}
+
+// layers writes the layers, the first layer is drawn on top. The times are
+// the frames of the composition, at each sample.
+func (c *lottieConverter) layers(layers []lottieLayer, times []float64) {
+	byIndex := make(map[int]*lottieLayer, len(layers))
+	for i := range layers {
+		if layers[i].Index != nil {
+			byIndex[*layers[i].Index] = &layers[i]
+		}
+	}
+
+	for i := len(layers) - 1; i >= 0; i-- {
+		layer := &layers[i]
+		if layer.Hidden || layer.Matte == 1 {
+			continue
+		}
+		switch layer.Type {
+		case 0, 1, 4: // precomp, solid and shape
+		default:
+			continue
+		}
+		visibility := sample(times, func(f float64) string {
+			if f < layer.InPoint || f >= layer.OutPoint {
+				return "hidden"
+			}
+			return "visible"
+		})
+		// The last sample is the start of the next iteration.
+		visibility[len(visibility)-1] = visibility[0]
+		if constant(visibility) && visibility[0] == "hidden" {
+			continue
+		}
+
+		// The parents only change the transform, the closest parent is the inner group.
+		var parents []*lottieLayer
+		for parent := layer; parent.Parent != nil && len(parents) < len(layers); {
+			next, ok := byIndex[*parent.Parent]
+			if !ok {
+				break
+			}
+			parents = append(parents, next)
+			parent = next
+		}
+		for j := len(parents) - 1; j >= 0; j-- {
+			g := &lottieElement{tag: "g"}
+			c.transform(g, &parents[j].Transform, c.layerTimes(parents[j], times))
+			c.open(g)
+		}
+
+		local := c.layerTimes(layer, times)
+		g := &lottieElement{tag: "g"}
+		c.transform(g, &layer.Transform, local)
+		c.set(g, "opacity", sample(local, func(f float64) string {
+			return formatFloat(layer.Transform.Opacity.value(f, 100)[0] / 100)
+		}))
+		c.setDiscrete(g, "visibility", visibility)
+		c.open(g)
+
+		switch layer.Type {
+		case 0:
+			for _, asset := range c.anim.Assets {
+				if asset.ID == layer.RefID {
+					c.layers(asset.Layers, local)
+					break
+				}
+			}
+		case 1:
+			fmt.Fprintf(&c.body, `<rect width="%s" height="%s" fill="%s"/>`,
+				formatFloat(layer.SolidWidth), formatFloat(layer.SolidHeight), escapeAttr(layer.SolidColor))
+		case 4:
+			c.shapes(layer.Shapes, nil, nil, local)
+		}
+
+		c.body.WriteString("</g>")
+		for range parents {
+			c.body.WriteString("</g>")
+		}
+	}
+}
+
+// layerTimes returns the frames of the layer, at each sample.
+func (c *lottieConverter) layerTimes(layer *lottieLayer, times []float64) []float64 {
+	stretch := layer.Stretch
+	if stretch == 0 {
+		stretch = 1
+	}
+	local := make([]float64, len(times))
+	for i, f := range times {
+		local[i] = (f - layer.StartTime) / stretch
+	}
+	return local
+}
+
+// shapes writes the items of a group. The paints and the trims of the parent
+// groups are also applied to the shapes of this group.
+func (c *lottieConverter) shapes(items []lottieShape, paints []*lottieShape, trims []*lottieShape, times []float64) {
+	var geometry []*lottieShape
+	first := -1
+	var own []*lottieShape
+	for i := range items {
+		item := &items[i]
+		if item.Hidden {
+			continue
+		}
+		switch item.Type {
+		case "sh", "rc", "el", "sr":
+			geometry = append(geometry, item)
+			if first < 0 {
+				first = i
+			}
+		case "fl", "st", "gf", "gs":
+			own = append(own, item)
+		case "tm":
+			trims = append(trims, item)
+		}
+	}
+	// The paints apply to the shapes above them, which are drawn on top of
+	// the next paints.
+	paints = append(append([]*lottieShape(nil), own...), paints...)
+
+	// The items are drawn from the last to the first.
+	for i := len(items) - 1; i >= 0; i-- {
+		item := &items[i]
+		if item.Hidden {
+			continue
+		}
+		switch {
+		case item.Type == "gr":
+			var after []*lottieShape
+			for j := i + 1; j < len(items); j++ {
+				switch items[j].Type {
+				case "fl", "st", "gf", "gs":
+					if !items[j].Hidden {
+						after = append(after, &items[j])
+					}
+				}
+			}
+			after = append(after, paints[len(own):]...)
+
+			g := &lottieElement{tag: "g"}
+			for _, tr := range item.Items {
+				if tr.Type == "tr" {
+					tr := tr
+					c.transform(g, &lottieTransform{Anchor: tr.A, Position: tr.P, Scale: tr.S, Rotation: rawProperty(tr.R), Skew: tr.Sk, SkewAxis: tr.Sa}, times)
+					c.set(g, "opacity", sample(times, func(f float64) string {
+						return formatFloat(tr.O.value(f, 100)[0] / 100)
+					}))
+				}
+			}
+			c.open(g)
+			c.shapes(item.Items, after, trims, times)
+			c.body.WriteString("</g>")
+		case i == first:
+			d := sample(times, func(f float64) string {
+				var path Path
+				for _, shape := range geometry {
+					p := shape.path(f)
+					for _, trim := range trims {
+						start := trim.S.value(f, 0)[0] / 100
+						end := trim.E.value(f, 100)[0] / 100
+						offset := trim.O.value(f, 0)[0] / 360
+						p = trimPath(p, start, end, offset)
+					}
+					path = append(path, p...)
+				}
+				return pathData(path)
+			})
+			for j := len(paints) - 1; j >= 0; j-- {
+				e := &lottieElement{tag: "path"}
+				c.set(e, "d", d)
+				c.paint(e, paints[j], times)
+				c.element(e)
+			}
+		}
+	}
+}
+
+// path returns the path of the shape at the given frame.
+func (s *lottieShape) path(f float64) Path {
+	switch s.Type {
+	case "sh":
+		return lottieShapePath(s.Path.at(f))
+	case "rc", "el":
+		p, size := s.P.value(f, 0, 0), s.S.value(f, 0, 0)
+		var radius float64
+		if r := rawProperty(s.R); r != nil {
+			radius = r.value(f, 0)[0]
+		}
+		return lottieRect(p[0], p[1], size[0], size[1], radius, s.Type == "el")
+	case "sr":
+		p := s.P.value(f, 0, 0)
+		var rotation float64
+		if r := rawProperty(s.R); r != nil {
+			rotation = r.value(f, 0)[0]
+		}
+		return lottieStar(s.StarType != 2, p[0], p[1], s.Points.value(f, 5)[0], s.OuterRadius.value(f, 0)[0], s.InnerRadius.value(f, 0)[0], rotation)
+	}
+	return nil
+}
+
+// paint sets the fill or the stroke of the path.
+func (c *lottieConverter) paint(e *lottieElement, paint *lottieShape, times []float64) {
+	color := func(f float64) string {
+		v := paint.C.value(f, 0, 0, 0)
+		channel := func(x float64) int { return int(math.Round(math.Max(0, math.Min(1, x)) * 255)) }
+		return fmt.Sprintf("rgb(%d,%d,%d)", channel(v[0]), channel(v[1]), channel(v[2]))
+	}
+	opacity := sample(times, func(f float64) string { return formatFloat(paint.O.value(f, 100)[0] / 100) })
+
+	switch paint.Type {
+	case "fl", "gf":
+		if paint.Type == "fl" {
+			c.set(e, "fill", sample(times, color))
+		} else {
+			c.set(e, "fill", []string{c.gradient(paint, times[0])})
+		}
+		c.set(e, "fill-opacity", opacity)
+		c.set(e, "stroke", []string{"none"})
+		if rule, _ := strconv.Atoi(string(paint.R)); rule == 2 {
+			c.set(e, "fill-rule", []string{"evenodd"})
+		}
+	case "st", "gs":
+		c.set(e, "fill", []string{"none"})
+		if paint.Type == "st" {
+			c.set(e, "stroke", sample(times, color))
+		} else {
+			c.set(e, "stroke", []string{c.gradient(paint, times[0])})
+		}
+		c.set(e, "stroke-opacity", opacity)
+		c.set(e, "stroke-width", sample(times, func(f float64) string { return formatFloat(paint.W.value(f, 1)[0]) }))
+		if cap := map[int]string{1: "butt", 2: "round", 3: "square"}[paint.LineCap]; cap != "" {
+			c.set(e, "stroke-linecap", []string{cap})
+		}
+		if join := map[int]string{1: "miter", 2: "round", 3: "bevel"}[paint.LineJoin]; join != "" {
+			c.set(e, "stroke-linejoin", []string{join})
+		}
+		if paint.MiterLimit > 0 {
+			c.set(e, "stroke-miterlimit", []string{formatFloat(paint.MiterLimit)})
+		}
+		if len(paint.Dashes) > 0 {
+			c.set(e, "stroke-dasharray", sample(times, func(f float64) string {
+				var dashes []string
+				for _, d := range paint.Dashes {
+					if d.Name != "o" {
+						dashes = append(dashes, formatFloat(d.Value.value(f, 0)[0]))
+					}
+				}
+				return strings.Join(dashes, " ")
+			}))
+			c.set(e, "stroke-dashoffset", sample(times, func(f float64) string {
+				for _, d := range paint.Dashes {
+					if d.Name == "o" {
+						return formatFloat(d.Value.value(f, 0)[0])
+					}
+				}
+				return "0"
+			}))
+		}
+	}
+}
+
+// gradient writes the gradient of the paint, at the given frame, and
+// returns the reference to it.
+func (c *lottieConverter) gradient(paint *lottieShape, f float64) string {
+	c.gradients++
+	id := "lottie-gradient-" + strconv.Itoa(c.gradients)
+	start, end := paint.S.value(f, 0, 0), paint.E.value(f, 0, 0)
+	if paint.GradientType == 2 {
+		fmt.Fprintf(&c.defs, `<radialGradient id="%s" gradientUnits="userSpaceOnUse" cx="%s" cy="%s" r="%s">`, id,
+			formatFloat(start[0]), formatFloat(start[1]), formatFloat(math.Hypot(end[0]-start[0], end[1]-start[1])))
+	} else {
+		fmt.Fprintf(&c.defs, `<linearGradient id="%s" gradientUnits="userSpaceOnUse" x1="%s" y1="%s" x2="%s" y2="%s">`, id,
+			formatFloat(start[0]), formatFloat(start[1]), formatFloat(end[0]), formatFloat(end[1]))
+	}
+
+	var values []float64
+	count := 0
+	if paint.GradientColors != nil {
+		values, count = paint.GradientColors.Colors.at(f), paint.GradientColors.Count
+	}
+	for i := 0; i < count && 4*i+3 < len(values); i++ {
+		offset, opacity := values[4*i], 1.0
+		// The opacity stops, if any, follow the color stops.
+		for j := 4 * count; j+1 < len(values); j += 2 {
+			if values[j] == offset {
+				opacity = values[j+1]
+			}
+		}
+		fmt.Fprintf(&c.defs, `<stop offset="%s" stop-color="rgb(%d,%d,%d)" stop-opacity="%s"/>`, formatFloat(offset),
+			int(math.Round(values[4*i+1]*255)), int(math.Round(values[4*i+2]*255)), int(math.Round(values[4*i+3]*255)), formatFloat(opacity))
+	}
+	if paint.GradientType == 2 {
+		c.defs.WriteString("</radialGradient>")
+	} else {
+		c.defs.WriteString("</linearGradient>")
+	}
+	return "url(#" + id + ")"
+}
+
+// transform sets the transform of the element, which is animated
+// using one <animateTransform> for each part of the transform.
+func (c *lottieConverter) transform(e *lottieElement, t *lottieTransform, times []float64) {
+	rotation := t.Rotation
+	if rotation == nil {
+		rotation = t.RotationZ
+	}
+	parts := []struct {
+		kind   string
+		values []string
+	}{
+		{"translate", sample(times, func(f float64) string { return formatPoint(t.Position.value(f, 0, 0)) })},
+		{"rotate", sample(times, func(f float64) string { return formatFloat(rotation.value(f, 0)[0]) })},
+		{"rotate", sample(times, func(f float64) string { return formatFloat(-t.SkewAxis.value(f, 0)[0]) })},
+		{"skewX", sample(times, func(f float64) string { return formatFloat(-t.Skew.value(f, 0)[0]) })},
+		{"rotate", sample(times, func(f float64) string { return formatFloat(t.SkewAxis.value(f, 0)[0]) })},
+		{"scale", sample(times, func(f float64) string {
+			s := t.Scale.value(f, 100, 100)
+			return formatPoint([]float64{s[0] / 100, s[1] / 100})
+		})},
+		{"translate", sample(times, func(f float64) string {
+			a := t.Anchor.value(f, 0, 0)
+			return formatPoint([]float64{-a[0], -a[1]})
+		})},
+	}
+
+	var static []string
+	animated := false
+	for _, part := range parts {
+		if !constant(part.values) {
+			animated = true
+		}
+		if identity := map[string]string{"translate": "0 0", "rotate": "0", "skewX": "0", "scale": "1 1"}[part.kind]; part.values[0] != identity {
+			static = append(static, part.kind+"("+part.values[0]+")")
+		}
+	}
+	if len(static) > 0 {
+		fmt.Fprintf(&e.attrs, ` transform="%s"`, strings.Join(static, " "))
+	}
+	if !animated {
+		return
+	}
+	additive := false
+	for _, part := range parts {
+		if constant(part.values) && part.values[0] == map[string]string{"translate": "0 0", "rotate": "0", "skewX": "0", "scale": "1 1"}[part.kind] {
+			continue
+		}
+		fmt.Fprintf(&e.anims, `<animateTransform attributeName="transform" type="%s" values="%s" dur="%s" repeatCount="indefinite"`,
+			part.kind, strings.Join(part.values, ";"), c.dur)
+		if additive {
+			e.anims.WriteString(` additive="sum"`)
+		}
+		e.anims.WriteString("/>")
+		additive = true
+	}
+}
+
+// set sets the attribute, which is animated if the values change.
+func (c *lottieConverter) set(e *lottieElement, name string, values []string) {
+	fmt.Fprintf(&e.attrs, ` %s="%s"`, name, escapeAttr(values[0]))
+	if !constant(values) {
+		fmt.Fprintf(&e.anims, `<animate attributeName="%s" values="%s" dur="%s" repeatCount="indefinite"/>`,
+			name, escapeAttr(strings.Join(values, ";")), c.dur)
+	}
+}
+
+// setDiscrete is like set, but the values are not interpolated, and
+// only the animation is written.
+func (c *lottieConverter) setDiscrete(e *lottieElement, name string, values []string) {
+	if constant(values) {
+		return
+	}
+	keyTimes := make([]string, len(values))
+	for i := range values {
+		keyTimes[i] = formatFloat(float64(i) / float64(len(values)-1))
+	}
+	fmt.Fprintf(&e.anims, `<animate attributeName="%s" values="%s" keyTimes="%s" calcMode="discrete" dur="%s" repeatCount="indefinite"/>`,
+		name, escapeAttr(strings.Join(values, ";")), strings.Join(keyTimes, ";"), c.dur)
+}
+
+// open writes the start of the element, and the animations.
+func (c *lottieConverter) open(e *lottieElement) {
+	fmt.Fprintf(&c.body, "<%s%s>%s", e.tag, e.attrs.String(), e.anims.String())
+}
+
+// element writes the element, and the animations.
+func (c *lottieConverter) element(e *lottieElement) {
+	c.open(e)
+	fmt.Fprintf(&c.body, "</%s>", e.tag)
+}
+
+// rawProperty decodes the property, which may be other value, such as
+// the fill rule. It returns nil if it isn't a property.
+func rawProperty(data []byte) *lottieProperty {
+	if len(data) == 0 || data[0] != '{' {
+		return nil
+	}
+	var p lottieProperty
+	if err := p.UnmarshalJSON(data); err != nil {
+		return nil
+	}
+	return &p
+}
+
+// sample returns the value at each time.
+func sample(times []float64, fn func(f float64) string) []string {
+	values := make([]string, len(times))
+	for i, f := range times {
+		values[i] = fn(f)
+	}
+	return values
+}
+
+func constant(values []string) bool {
+	for _, v := range values[1:] {
+		if v != values[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// trimPath returns the part of the path between start and end, which are
+// fractions of the length of the path, shifted by the offset.
+func trimPath(p Path, start, end, offset float64) Path {
+	if start > end {
+		start, end = end, start
+	}
+	if end-start >= 1 {
+		return p
+	}
+	if end-start <= 0 {
+		return nil
+	}
+
+	type segment struct {
+		a, b   f32.Point
+		length float64
+		first  bool // first is true for the first segment of a sub-path
+	}
+	var segments []segment
+	var total float64
+	var last f32.Point
+	p.flatten(0.05, false, func(a, b f32.Point) {
+		s := segment{a: a, b: b, length: distancePoints(a, b), first: len(segments) == 0 || a != last}
+		segments = append(segments, s)
+		total += s.length
+		last = b
+	})
+
+	from := math.Mod(start+offset, 1)
+	if from < 0 {
+		from++
+	}
+	ranges := [][2]float64{{from * total, (from + end - start) * total}}
+	if ranges[0][1] > total {
+		ranges = [][2]float64{{0, ranges[0][1] - total}, {ranges[0][0], total}}
+	}
+
+	var out Path
+	for _, r := range ranges {
+		var position float64
+		drawing := false
+		for _, s := range segments {
+			if s.first {
+				drawing = false
+			}
+			lo, hi := math.Max(r[0], position), math.Min(r[1], position+s.length)
+			if s.length > 0 && hi > lo {
+				if !drawing {
+					out.Start(lerp(s.a, s.b, (lo-position)/s.length))
+					drawing = true
+				}
+				out.Line(lerp(s.a, s.b, (hi-position)/s.length))
+			} else {
+				drawing = false
+			}
+			position += s.length
+		}
+	}
+	return out
+}
+
+// pathData returns the `d` attribute of the path.
+func pathData(p Path) string {
+	var s strings.Builder
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			fmt.Fprintf(&s, "M%s %s", formatFloat32(op.X), formatFloat32(op.Y))
+		case OpLineTo:
+			fmt.Fprintf(&s, "L%s %s", formatFloat32(op.X), formatFloat32(op.Y))
+		case OpQuadTo:
+			fmt.Fprintf(&s, "Q%s %s %s %s", formatFloat32(op[0].X), formatFloat32(op[0].Y), formatFloat32(op[1].X), formatFloat32(op[1].Y))
+		case OpCubicTo:
+			fmt.Fprintf(&s, "C%s %s %s %s %s %s", formatFloat32(op[0].X), formatFloat32(op[0].Y),
+				formatFloat32(op[1].X), formatFloat32(op[1].Y), formatFloat32(op[2].X), formatFloat32(op[2].Y))
+		case OpClose:
+			s.WriteString("Z")
+		}
+	}
+	return s.String()
+}
+
+func pt(x, y float64) f32.Point {
+	return f32.Pt(float32(x), float32(y))
+}
+
+func formatPoint(v []float64) string {
+	return formatFloat(v[0]) + " " + formatFloat(v[1])
+}
+
+func formatFloat(v float64) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "0"
+	}
+	v = math.Round(v*1000) / 1000
+	if v == 0 {
+		return "0" // avoid "-0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func formatFloat32(v float32) string {
+	return formatFloat(float64(v))
+}
+
+func escapeAttr(v string) string {
+	var s strings.Builder
+	_ = xml.EscapeText(&s, []byte(v))
+	return s.String()
+}
@@ -0,0 +1,116 @@
+package svgparser
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testLottie = `{
+	"v": "5.7.0", "fr": 30, "ip": 0, "op": 60, "w": 100, "h": 100,
+	"layers": [
+		{"ty": 3, "ind": 1, "ip": 0, "op": 60, "st": 0,
+			"ks": {"p": {"a": 0, "k": [50, 50]}}},
+		{"ty": 4, "ind": 2, "parent": 1, "ip": 0, "op": 60, "st": 0,
+			"ks": {
+				"r": {"a": 1, "k": [
+					{"t": 0, "s": [0], "i": {"x": [1], "y": [1]}, "o": {"x": [0], "y": [0]}},
+					{"t": 60, "s": [360]}
+				]},
+				"o": {"a": 0, "k": 50}
+			},
+			"shapes": [
+				{"ty": "gr", "it": [
+					{"ty": "rc", "p": {"a": 0, "k": [0, 0]}, "s": {"a": 0, "k": [20, 10]}, "r": {"a": 0, "k": 0}},
+					{"ty": "fl", "c": {"a": 0, "k": [1, 0, 0, 1]}, "o": {"a": 0, "k": 100}, "r": 1},
+					{"ty": "tr", "p": {"a": 0, "k": [0, 0]}, "a": {"a": 0, "k": [0, 0]}, "s": {"a": 0, "k": [100, 100]}, "r": {"a": 0, "k": 0}, "o": {"a": 0, "k": 100}}
+				]},
+				{"ty": "sh", "ks": {"a": 1, "k": [
+					{"t": 0, "s": [{"c": false, "v": [[0, 0], [10, 0]], "i": [[0, 0], [0, 0]], "o": [[0, 0], [0, 0]]}], "h": 1},
+					{"t": 30, "s": [{"c": false, "v": [[0, 0], [0, 10]], "i": [[0, 0], [0, 0]], "o": [[0, 0], [0, 0]]}]}
+				]}},
+				{"ty": "tm", "s": {"a": 0, "k": 0}, "e": {"a": 0, "k": 50}, "o": {"a": 0, "k": 0}},
+				{"ty": "st", "c": {"a": 0, "k": [0, 0, 1, 1]}, "o": {"a": 0, "k": 100}, "w": {"a": 0, "k": 2}, "lc": 2, "lj": 1}
+			]
+		}
+	]
+}`
+
+func TestReadLottie(t *testing.T) {
+	icon, err := ReadLottie(strings.NewReader(testLottie))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if icon.ViewBox != (Bounds{W: 100, H: 100}) {
+		t.Errorf("unexpected viewBox %v", icon.ViewBox)
+	}
+	if end := icon.AnimationEnd(); end != Indefinite {
+		t.Errorf("expected an indefinite animation, got %v", end)
+	}
+
+	// The stroke of the layer also applies to the rectangle of the group,
+	// below its fill, and the group is drawn on top of the line.
+	if len(icon.SVGPaths) != 3 {
+		t.Fatalf("expected 3 paths, got %d", len(icon.SVGPaths))
+	}
+	line, stroke, fill := icon.SVGPaths[0], icon.SVGPaths[1], icon.SVGPaths[2]
+	if fill.Style.FillerColor != NewPlainColor(0xff, 0, 0, 0xff) || fill.Style.LinerColor != nil {
+		t.Errorf("unexpected fill %v and stroke %v", fill.Style.FillerColor, fill.Style.LinerColor)
+	}
+	if stroke.Style.LinerColor != NewPlainColor(0, 0, 0xff, 0xff) || stroke.Style.LineWidth != 2 || stroke.Style.Join.TrailLineCap != RoundCap {
+		t.Errorf("unexpected stroke %v", stroke.Style)
+	}
+	if !almostEqual(fill.Style.FillOpacity, 0.5) || !almostEqual(line.Style.LineOpacity, 0.5) {
+		t.Errorf("expected the layer opacity 0.5, got %v and %v", fill.Style.FillOpacity, line.Style.LineOpacity)
+	}
+
+	// The trim path keeps half of the line, and half of the rectangle.
+	if bounds := icon.PathBounds(0, DrawOptions{Transform: Identity}); math.Abs(float64(bounds.Dx())-(5+2)) > 1e-3 {
+		t.Errorf("expected the trimmed line, got %v", bounds)
+	}
+	if n := len(stroke.Path); n != 3 {
+		t.Errorf("expected the trimmed rectangle, got %v", stroke.Path)
+	}
+
+	for _, d := range []struct {
+		t        time.Duration
+		x, y     float64 // the point (10, 0) of the rectangle
+		vertical bool
+	}{
+		{t: 0, x: 60, y: 50},
+		{t: time.Second, x: 40, y: 50, vertical: true}, // the rotation eases, 180 degrees at the middle
+	} {
+		overrides := icon.Animate(d.t)
+		m := overrides[2].Transform.Mult(fill.Style.Transform)
+		if x, y := m.Transform(10, 0); math.Abs(x-d.x) > 1e-3 || math.Abs(y-d.y) > 1e-3 {
+			t.Errorf("at %v: expected %v, %v, got %v, %v", d.t, d.x, d.y, x, y)
+		}
+		bounds := icon.PathBounds(0, DrawOptions{Transform: Identity, Overrides: overrides})
+		if vertical := bounds.Dy() > bounds.Dx(); vertical != d.vertical {
+			t.Errorf("at %v: unexpected line %v", d.t, bounds)
+		}
+	}
+}
+
+func TestTrimPath(t *testing.T) {
+	var path Path
+	path.Start(pt(0, 0))
+	path.Line(pt(10, 0))
+	path.Line(pt(10, 10))
+
+	for _, d := range []struct {
+		start, end, offset float64
+		expected           string
+	}{
+		{start: 0, end: 1, expected: "M0 0L10 0L10 10"},
+		{start: 0.25, end: 0.75, expected: "M5 0L10 0L10 5"},
+		{start: 0.75, end: 0.25, expected: "M5 0L10 0L10 5"},
+		{start: 0, end: 0.5, offset: 0.75, expected: "M0 0L5 0M10 5L10 10"},
+		{start: 0.5, end: 0.5, expected: ""},
+	} {
+		if trimmed := pathData(trimPath(path, d.start, d.end, d.offset)); trimmed != d.expected {
+			t.Errorf("for %v-%v+%v: expected %q, got %q", d.start, d.end, d.offset, d.expected, trimmed)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package svgparser
+
+import (
+	"image/color"
+	"math"
+
+	"gioui.org/f32"
+)
+
+// contour is one sub-path normalized into cubic segments, used by Morph.
+type contour struct {
+	start    f32.Point
+	segments [][3]f32.Point
+	closed   bool
+}
+
+// morphPath is one SvgPath with the transform and the Override applied,
+// in the coordinates of the viewBox of the morph.
+type morphPath struct {
+	contours []contour
+	style    PathStyle
+	svgp     *SvgPath
+}
+
+// Morph returns the SVGRender which is the interpolation between a and b,
+// at t between 0 (a) and 1 (b). The Overrides, if not nil, are applied
+// before the interpolation.
+//
+// The SvgPath are matched by index, the paths without match fade in
+// or out. The geometry is converted to cubic segments, with the same
+// number of sub-paths and segments, and the start points of the closed
+// sub-paths are aligned. The colors and the stroke are interpolated,
+// the other properties switch at the middle.
+func Morph(a *SVGRender, oa []Override, b *SVGRender, ob []Override, t float64) *SVGRender {
+	t = math.Max(0, math.Min(1, t))
+	viewBox := Bounds{
+		X: a.ViewBox.X + (b.ViewBox.X-a.ViewBox.X)*t,
+		Y: a.ViewBox.Y + (b.ViewBox.Y-a.ViewBox.Y)*t,
+		W: a.ViewBox.W + (b.ViewBox.W-a.ViewBox.W)*t,
+		H: a.ViewBox.H + (b.ViewBox.H-a.ViewBox.H)*t,
+	}
+	pathsA, pathsB := a.morphPaths(oa, viewBox), b.morphPaths(ob, viewBox)
+
+	s := &SVGRender{
+		ViewBox:   viewBox,
+		Transform: Identity,
+		grads:     make(map[string]*Gradient),
+		defs:      make(map[string][]definition),
+	}
+	if t < 0.5 {
+		s.Titles, s.Descriptions = a.Titles, a.Descriptions
+	} else {
+		s.Titles, s.Descriptions = b.Titles, b.Descriptions
+	}
+
+	n := len(pathsA)
+	if len(pathsB) > n {
+		n = len(pathsB)
+	}
+	for i := 0; i < n; i++ {
+		switch {
+		case i >= len(pathsB) || pathsB[i].svgp == nil:
+			if i < len(pathsA) && pathsA[i].svgp != nil {
+				s.SVGPaths = append(s.SVGPaths, pathsA[i].fade(1-t))
+			}
+		case i >= len(pathsA) || pathsA[i].svgp == nil:
+			s.SVGPaths = append(s.SVGPaths, pathsB[i].fade(t))
+		default:
+			s.SVGPaths = append(s.SVGPaths, morphPair(pathsA[i], pathsB[i], t))
+		}
+	}
+	return s
+}
+
+// morphPaths returns the paths with the Overrides and the transforms applied,
+// and converted to the given viewBox. The hidden paths have no svgp.
+func (s *SVGRender) morphPaths(overrides []Override, viewBox Bounds) []morphPath {
+	scaleX, scaleY := 1.0, 1.0
+	if s.ViewBox.W != 0 && s.ViewBox.H != 0 {
+		scaleX, scaleY = viewBox.W/s.ViewBox.W, viewBox.H/s.ViewBox.H
+	}
+	toViewBox := Identity.Translate(viewBox.X, viewBox.Y).Scale(scaleX, scaleY).Translate(-s.ViewBox.X, -s.ViewBox.Y)
+
+	paths := make([]morphPath, len(s.SVGPaths))
+	for i := range s.SVGPaths {
+		override := DefaultOverride
+		if overrides != nil {
+			override = overrides[i]
+		}
+		path, style := s.SVGPaths[i].resolved(override)
+		if override.Hidden || len(path) == 0 {
+			continue
+		}
+
+		m := toViewBox.Mult(override.Transform).Mult(style.Transform)
+		p := morphPath{style: *style, svgp: &s.SVGPaths[i], contours: contours(path, m)}
+		p.style.Transform = Identity
+		scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+		p.style.LineWidth *= scale
+		p.style.Dash.DashOffset *= scale
+		p.style.Dash.Dash = append([]float64(nil), style.Dash.Dash...)
+		for j := range p.style.Dash.Dash {
+			p.style.Dash.Dash[j] *= scale
+		}
+		p.style.FillerColor, p.style.LinerColor = override.patterns(style)
+		p.style.FillOpacity *= override.Opacity
+		p.style.LineOpacity *= override.Opacity
+		paths[i] = p
+	}
+	return paths
+}
+
+// contours converts the path into contours of cubic segments, transformed by m.
+func contours(path Path, m Matrix2D) (list []contour) {
+	var current *contour
+	var last f32.Point
+	line := func(a, b f32.Point) [3]f32.Point {
+		return [3]f32.Point{lerp(a, b, 1.0/3), lerp(a, b, 2.0/3), b}
+	}
+	for _, op := range path {
+		switch op := op.(type) {
+		case OpMoveTo:
+			last = m.TFixed(f32.Point(op))
+			list = append(list, contour{start: last})
+			current = &list[len(list)-1]
+			continue
+		case OpClose:
+			if current != nil {
+				if last != current.start {
+					current.segments = append(current.segments, line(last, current.start))
+				}
+				current.closed, last = true, current.start
+			}
+			continue
+		}
+		if current == nil {
+			list = append(list, contour{start: last})
+			current = &list[len(list)-1]
+		}
+		switch op := op.(type) {
+		case OpLineTo:
+			p := m.TFixed(f32.Point(op))
+			current.segments = append(current.segments, line(last, p))
+			last = p
+		case OpQuadTo:
+			c, p := m.TFixed(op[0]), m.TFixed(op[1])
+			current.segments = append(current.segments, [3]f32.Point{lerp(last, c, 2.0/3), lerp(p, c, 2.0/3), p})
+			last = p
+		case OpCubicTo:
+			p := [3]f32.Point{m.TFixed(op[0]), m.TFixed(op[1]), m.TFixed(op[2])}
+			current.segments = append(current.segments, p)
+			last = p[2]
+		}
+	}
+	return list
+}
+
+// fade returns the SvgPath with the opacity multiplied by the given value.
+func (p morphPath) fade(opacity float64) SvgPath {
+	svgp := *p.svgp
+	svgp.Path, svgp.Style = contoursPath(p.contours), p.style
+	svgp.Style.FillOpacity *= opacity
+	svgp.Style.LineOpacity *= opacity
+	return svgp
+}
+
+// morphPair interpolates the geometry and the style of the matched paths.
+func morphPair(a, b morphPath, t float64) SvgPath {
+	ca, cb := matchContours(a.contours, b.contours)
+	list := make([]contour, len(ca))
+	for i := range ca {
+		list[i] = morphContour(ca[i], cb[i], t)
+	}
+
+	svgp := *a.svgp
+	if t >= 0.5 {
+		svgp = *b.svgp
+	}
+	svgp.Path, svgp.Style = contoursPath(list), morphStyle(a.style, b.style, t)
+	return svgp
+}
+
+// matchContours returns the contours with the same number of sub-paths. The
+// missing sub-paths are created as a single point, at the center of the
+// matching sub-path.
+func matchContours(a, b []contour) ([]contour, []contour) {
+	point := func(c contour) contour {
+		var center f32.Point
+		n := 1
+		center = c.start
+		for _, s := range c.segments {
+			center = center.Add(s[2])
+			n++
+		}
+		center = center.Mul(1 / float32(n))
+		return contour{start: center, segments: [][3]f32.Point{{center, center, center}}, closed: c.closed}
+	}
+	for len(a) < len(b) {
+		a = append(a, point(b[len(a)]))
+	}
+	for len(b) < len(a) {
+		b = append(b, point(a[len(b)]))
+	}
+	return a, b
+}
+
+// morphContour interpolates the contours, after matching the number of
+// segments and the start point.
+func morphContour(a, b contour, t float64) contour {
+	for len(a.segments) < len(b.segments) {
+		a = a.subdivide()
+	}
+	for len(b.segments) < len(a.segments) {
+		b = b.subdivide()
+	}
+	if a.closed && b.closed {
+		b = b.align(a)
+	}
+
+	c := contour{start: lerp(a.start, b.start, t), segments: make([][3]f32.Point, len(a.segments)), closed: a.closed}
+	if t >= 0.5 {
+		c.closed = b.closed
+	}
+	for i := range a.segments {
+		for j := range a.segments[i] {
+			c.segments[i][j] = lerp(a.segments[i][j], b.segments[i][j], t)
+		}
+	}
+	return c
+}
+
+// subdivide splits the longest segment into two halves.
+func (c contour) subdivide() contour {
+	if len(c.segments) == 0 {
+		return contour{start: c.start, segments: [][3]f32.Point{{c.start, c.start, c.start}}, closed: c.closed}
+	}
+	longest, length := 0, -1.0
+	last := c.start
+	for i, s := range c.segments {
+		if d := distancePoints(last, s[2]); d > length {
+			longest, length = i, d
+		}
+		last = s[2]
+	}
+
+	p0 := c.start
+	if longest > 0 {
+		p0 = c.segments[longest-1][2]
+	}
+	s := c.segments[longest]
+	// The de Casteljau algorithm, at 0.5.
+	p01, p12, p23 := lerp(p0, s[0], 0.5), lerp(s[0], s[1], 0.5), lerp(s[1], s[2], 0.5)
+	p012, p123 := lerp(p01, p12, 0.5), lerp(p12, p23, 0.5)
+	mid := lerp(p012, p123, 0.5)
+
+	segments := make([][3]f32.Point, 0, len(c.segments)+1)
+	segments = append(segments, c.segments[:longest]...)
+	segments = append(segments, [3]f32.Point{p01, p012, mid}, [3]f32.Point{p123, p23, s[2]})
+	segments = append(segments, c.segments[longest+1:]...)
+	return contour{start: c.start, segments: segments, closed: c.closed}
+}
+
+// align rotates the segments of the closed contour, so that the vertices are
+// the closest to the vertices of the other contour, with the same length.
+func (c contour) align(other contour) contour {
+	n := len(c.segments)
+	if n < 2 || c.segments[n-1][2] != c.start {
+		return c
+	}
+	best, bestDistance := 0, math.Inf(1)
+	for shift := 0; shift < n; shift++ {
+		var d float64
+		for i := 0; i < n; i++ {
+			p := c.segments[(i+shift)%n][2]
+			d += sq(distancePoints(p, other.segments[i][2]))
+		}
+		if d < bestDistance {
+			best, bestDistance = shift, d
+		}
+	}
+	if best == 0 {
+		return c
+	}
+	segments := append(append([][3]f32.Point(nil), c.segments[best:]...), c.segments[:best]...)
+	return contour{start: c.segments[best-1][2], segments: segments, closed: true}
+}
+
+// contoursPath converts the contours back to a Path.
+func contoursPath(list []contour) (path Path) {
+	for _, c := range list {
+		path.Start(c.start)
+		for _, s := range c.segments {
+			path.CubeBezier(s[0], s[1], s[2])
+		}
+		if c.closed {
+			path = append(path, OpClose{})
+		}
+	}
+	return path
+}
+
+// morphStyle interpolates the colors, opacities and the stroke width. The
+// other properties, such as the line join, switch at the middle.
+func morphStyle(a, b PathStyle, t float64) PathStyle {
+	style := a
+	if t >= 0.5 {
+		style = b
+	}
+	style.FillerColor, style.FillOpacity = morphPaint(a.FillerColor, a.FillOpacity, b.FillerColor, b.FillOpacity, t)
+	style.LinerColor, style.LineOpacity = morphPaint(a.LinerColor, a.LineOpacity, b.LinerColor, b.LineOpacity, t)
+	style.LineWidth = a.LineWidth + (b.LineWidth-a.LineWidth)*t
+	if len(a.Dash.Dash) == len(b.Dash.Dash) {
+		style.Dash.Dash = make([]float64, len(a.Dash.Dash))
+		for i := range style.Dash.Dash {
+			style.Dash.Dash[i] = a.Dash.Dash[i] + (b.Dash.Dash[i]-a.Dash.Dash[i])*t
+		}
+		style.Dash.DashOffset = a.Dash.DashOffset + (b.Dash.DashOffset-a.Dash.DashOffset)*t
+	}
+	return style
+}
+
+// morphPaint interpolates plain colors. The missing paint, such as
+// `fill="none"`, fades from or to the other paint. Other patterns, such as
+// gradients, switch at the middle.
+func morphPaint(a Pattern, opacityA float64, b Pattern, opacityB float64, t float64) (Pattern, float64) {
+	switch {
+	case a == nil && b == nil:
+		return nil, 1
+	case a == nil:
+		return b, opacityB * t
+	case b == nil:
+		return a, opacityA * (1 - t)
+	}
+	colorA, okA := a.(PlainColor)
+	colorB, okB := b.(PlainColor)
+	if !okA || !okB {
+		if t < 0.5 {
+			return a, opacityA * (1 - t) * 2
+		}
+		return b, opacityB * (t - 0.5) * 2
+	}
+	channel := func(x, y uint8) uint8 {
+		return uint8(math.Round(float64(x) + (float64(y)-float64(x))*t))
+	}
+	return PlainColor{NRGBA: color.NRGBA{
+		R: channel(colorA.R, colorB.R),
+		G: channel(colorA.G, colorB.G),
+		B: channel(colorA.B, colorB.B),
+		A: channel(colorA.A, colorB.A),
+	}}, opacityA + (opacityB-opacityA)*t
+}
+
+func distancePoints(a, b f32.Point) float64 {
+	return math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y))
+}
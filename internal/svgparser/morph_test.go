@@ -0,0 +1,78 @@
+package svgparser
+
+import (
+	"math"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+func TestMorph(t *testing.T) {
+	a := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10">
+		<rect x="0" y="0" width="10" height="10" fill="#ff0000"/>
+		<path d="M0 2 H10" stroke="black"/>
+	</svg>`)
+	b := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 20 20">
+		<path d="M10 0 L20 20 Q10 20 0 20 Z" fill="#0000ff"/>
+	</svg>`)
+
+	for _, d := range []struct {
+		t       float64
+		viewBox Bounds
+		fill    PlainColor
+		bounds  f32.Rectangle
+		opacity float64
+	}{
+		{t: 0, viewBox: Bounds{W: 10, H: 10}, fill: NewPlainColor(0xff, 0, 0, 0xff), bounds: f32.Rect(0, 0, 10, 10), opacity: 1},
+		{t: 0.5, viewBox: Bounds{W: 15, H: 15}, fill: NewPlainColor(0x80, 0, 0x80, 0xff), opacity: 0.5},
+		{t: 1, viewBox: Bounds{W: 20, H: 20}, fill: NewPlainColor(0, 0, 0xff, 0xff), bounds: f32.Rect(0, 0, 20, 20), opacity: 0},
+	} {
+		s := Morph(a, nil, b, nil, d.t)
+		if s.ViewBox != d.viewBox {
+			t.Errorf("at %v: expected viewBox %v, got %v", d.t, d.viewBox, s.ViewBox)
+		}
+		if len(s.SVGPaths) != 2 {
+			t.Fatalf("at %v: expected 2 paths, got %d", d.t, len(s.SVGPaths))
+		}
+		if c := s.SVGPaths[0].Style.FillerColor; c != d.fill {
+			t.Errorf("at %v: expected fill %v, got %v", d.t, d.fill, c)
+		}
+		if bounds := s.PathBounds(0, DrawOptions{Transform: Identity}); d.bounds != (f32.Rectangle{}) && bounds != d.bounds {
+			t.Errorf("at %v: expected bounds %v, got %v", d.t, d.bounds, bounds)
+		}
+		if o := s.SVGPaths[1].Style.LineOpacity; math.Abs(o-d.opacity) > 1e-9 {
+			t.Errorf("at %v: expected the unmatched path with opacity %v, got %v", d.t, d.opacity, o)
+		}
+	}
+
+	// The paths have the same number of segments for any t.
+	var segments int
+	for _, op := range Morph(a, nil, b, nil, 0.25).SVGPaths[0].Path {
+		if _, ok := op.(OpCubicTo); ok {
+			segments++
+		}
+	}
+	if segments != 4 {
+		t.Errorf("expected the rectangle with 4 segments, got %d", segments)
+	}
+}
+
+func TestContourAlign(t *testing.T) {
+	square := func(points ...f32.Point) contour {
+		var path Path
+		path.Start(points[0])
+		for _, p := range points[1:] {
+			path.Line(p)
+		}
+		path = append(path, OpClose{})
+		return contours(path, Identity)[0]
+	}
+	a := square(f32.Pt(0, 0), f32.Pt(10, 0), f32.Pt(10, 10), f32.Pt(0, 10))
+	b := square(f32.Pt(10, 10), f32.Pt(0, 10), f32.Pt(0, 0), f32.Pt(10, 0))
+
+	// The same square, with the start point aligned, is unchanged.
+	c := morphContour(a, b, 0.5)
+	if c.start != a.start || c.segments[0][2] != a.segments[0][2] {
+		t.Errorf("expected the aligned square, got %v", c)
+	}
+}
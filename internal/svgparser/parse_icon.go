@@ -16,9 +16,12 @@ type (
 		pathCursor
 		icon                                    *SVGRender
 		styleStack                              []PathStyle
+		elementStack                            []element // open elements
+		targets                                 map[string]*animationTarget
 		grad                                    *Gradient
 		inTitleText, inDescText, inGrad, inDefs bool
 		currentDef                              []definition
+		styleSheet                              styleSheet
 	}
 
 	// definition is used to store what's given in a def tag
@@ -26,6 +29,18 @@ type (
 		ID, Tag string
 		Attrs   []simplexml.Attr
 	}
+
+	// element is used to keep track of the open elements
+	element struct {
+		tag          string
+		attrs        []simplexml.Attr
+		declarations []simplexml.Attr // declarations are the style declarations, in the cascade order
+		target       *animationTarget // target is created if the element is animated, or has ID
+		id           string
+		link         *Link  // link is inherited from the closest <a>
+		title        string // title is inherited from the closest element with <title>
+		firstPath    int    // firstPath is the index of the first SvgPath created inside the element
+	}
 )
 
 func fToFixed(f float64) float32 {
@@ -78,12 +93,38 @@ func (c *iconCursor) readTransformAttr(m1 Matrix2D, k string) (Matrix2D, error)
 		}
 	case "scale":
 		if ln == 1 {
-			m1 = m1.Scale(c.points[0], 0)
+			m1 = m1.Scale(c.points[0], c.points[0])
 		} else if ln == 2 {
 			m1 = m1.Scale(c.points[0], c.points[1])
 		} else {
 			return m1, errParamMismatch
 		}
+	case "translatex", "translatey", "scalex", "scaley":
+		if ln != 1 {
+			return m1, errParamMismatch
+		}
+		switch k {
+		case "translatex":
+			m1 = m1.Translate(c.points[0], 0)
+		case "translatey":
+			m1 = m1.Translate(0, c.points[0])
+		case "scalex":
+			m1 = m1.Scale(c.points[0], 1)
+		case "scaley":
+			m1 = m1.Scale(1, c.points[0])
+		}
+	case "skew":
+		if ln == 1 {
+			m1 = m1.SkewX(c.points[0] * math.Pi / 180)
+		} else if ln == 2 {
+			m1 = m1.Mult(Matrix2D{
+				A: 1,
+				B: math.Tan(c.points[1] * math.Pi / 180),
+				C: math.Tan(c.points[0] * math.Pi / 180),
+				D: 1})
+		} else {
+			return m1, errParamMismatch
+		}
 	case "matrix":
 		if ln == 6 {
 			m1 = m1.Mult(Matrix2D{
@@ -103,59 +144,143 @@ func (c *iconCursor) readTransformAttr(m1 Matrix2D, k string) (Matrix2D, error)
 }
 
 func (c *iconCursor) parseTransform(v string) (Matrix2D, error) {
-	ts := strings.Split(v, ")")
-	m1 := c.styleStack[len(c.styleStack)-1].Transform
-	for _, t := range ts {
-		t = strings.TrimSpace(t)
-		if len(t) == 0 {
-			continue
-		}
-		d := strings.Split(t, "(")
-		if len(d) != 2 || len(d[1]) < 1 {
+	m, err := c.parseTransformList(v)
+	return c.styleStack[len(c.styleStack)-1].Transform.Mult(m), err
+}
+
+// parseTransformList parses the `transform` attribute, or the CSS `transform`
+// property, such as "rotate(45deg)", without the transform of the parent.
+// The functions are separated by spaces or commas, and the arguments may
+// use the CSS units, such as "deg" and "px".
+func (c *iconCursor) parseTransformList(v string) (Matrix2D, error) {
+	m1 := Identity
+	t := tokenizer{s: v}
+	t.skipCommaSpace()
+	for !t.done() {
+		k := t.name()
+		t.skipSpace()
+		if k == "" || !t.consume('(') {
 			return m1, errParamMismatch // badly formed transformation
 		}
-		err := c.getPoints(d[1])
-		if err != nil {
-			return m1, err
+		c.points = c.points[:0]
+		t.skipSpace()
+		for !t.consume(')') {
+			f, err := t.dimension()
+			if err != nil {
+				return m1, err
+			}
+			c.points = append(c.points, f)
+			t.skipCommaSpace()
 		}
-		m1, err = c.readTransformAttr(m1, strings.ToLower(strings.TrimSpace(d[0])))
+		var err error
+		m1, err = c.readTransformAttr(m1, strings.ToLower(k))
 		if err != nil {
 			return m1, err
 		}
+		t.skipCommaSpace()
 	}
 	return m1, nil
 }
 
+// readPaint reads the value of fill or stroke. The `current` is the
+// inherited value, used by gradients and variables without fallback.
+func (c *iconCursor) readPaint(curStyle *PathStyle, v string, current Pattern) (Pattern, error) {
+	if strings.ToLower(v) == "currentcolor" {
+		return CurrentColor{}, nil
+	}
+	variable, ok, err := c.readVariable(curStyle, v, current)
+	if ok {
+		return variable, err
+	}
+	gradient, ok := c.readGradURL(v, current)
+	if ok {
+		return gradient, nil
+	}
+	optCol, err := parseSVGColor(v)
+	return optCol.asPattern(), err
+}
+
+// readVariable reads a `var(--name, fallback)` value. The fallback is
+// replaced by the value of the custom property, if defined by the SVG itself.
+func (c *iconCursor) readVariable(curStyle *PathStyle, v string, current Pattern) (variable Variable, ok bool, err error) {
+	name, fallback, ok := splitVariable(v)
+	if !ok {
+		return variable, false, nil
+	}
+	if name == "" {
+		return variable, true, errParamMismatch
+	}
+	if def, defined := curStyle.variables[name]; defined {
+		fallback = def
+
+		// The variable is removed to avoid infinite recursion,
+		// such as `--primary: var(--primary)`.
+		style := *curStyle
+		style.variables = make(map[string]string, len(curStyle.variables))
+		for k, v := range curStyle.variables {
+			if k != name {
+				style.variables[k] = v
+			}
+		}
+		curStyle = &style
+	}
+	variable = Variable{Name: name, Fallback: current}
+	if fallback != "" {
+		variable.Fallback, err = c.readPaint(curStyle, fallback, current)
+	}
+	return variable, true, err
+}
+
+// splitVariable splits a `var(--name, fallback)` value into the name,
+// without the `--` prefix, and the fallback. It returns false if `v` is
+// not a variable.
+func splitVariable(v string) (name, fallback string, ok bool) {
+	v = strings.TrimSpace(v)
+	if !strings.HasPrefix(v, "var(") || !strings.HasSuffix(v, ")") {
+		return "", "", false
+	}
+	name = strings.TrimSpace(v[4 : len(v)-1])
+	if i := strings.IndexByte(name, ','); i >= 0 {
+		name, fallback = strings.TrimSpace(name[:i]), strings.TrimSpace(name[i+1:])
+	}
+	if !strings.HasPrefix(name, "--") {
+		return "", fallback, true
+	}
+	return name[2:], fallback, true
+}
+
 func (c *iconCursor) readStyleAttr(curStyle *PathStyle, k, v string) error {
+	if strings.HasPrefix(k, "--") {
+		// Custom properties are inherited, the map is copied to
+		// avoid changing the parent style.
+		variables := make(map[string]string, len(curStyle.variables)+1)
+		for name, value := range curStyle.variables {
+			variables[name] = value
+		}
+		variables[k[2:]] = v
+		curStyle.variables = variables
+		return nil
+	}
 	switch k {
 	case "fill":
-		if strings.ToLower(v) == "currentcolor" {
-			curStyle.FillerColor = CurrentColor{}
-			break
-		}
-		gradient, ok := c.readGradURL(v, curStyle.FillerColor)
-		if ok {
-			curStyle.FillerColor = gradient
-			break
-		}
-		optCol, err := parseSVGColor(v)
-		curStyle.FillerColor = optCol.asPattern()
+		pattern, err := c.readPaint(curStyle, v, curStyle.FillerColor)
+		curStyle.FillerColor = pattern
 		return err
 	case "stroke":
-		if strings.ToLower(v) == "currentcolor" {
-			curStyle.LinerColor = CurrentColor{}
-			break
-		}
-		gradient, ok := c.readGradURL(v, curStyle.LinerColor)
-		if ok {
-			curStyle.LinerColor = gradient
-			break
+		pattern, err := c.readPaint(curStyle, v, curStyle.LinerColor)
+		if err != nil {
+			return err
 		}
-		optCol, errc := parseSVGColor(v)
-		if errc != nil {
-			return errc
+		curStyle.LinerColor = pattern
+	case "fill-rule":
+		switch v {
+		case "nonzero":
+			curStyle.UseNonZeroWinding = true
+		case "evenodd":
+			curStyle.UseNonZeroWinding = false
+		default:
+			return c.handleError("unsupported value '%s' for <fill-rule>", v)
 		}
-		curStyle.LinerColor = optCol.asPattern()
 	case "stroke-linegap":
 		switch v {
 		case "flat":
@@ -259,47 +384,150 @@ func (c *iconCursor) readStyleAttr(curStyle *PathStyle, k, v string) error {
 		if k != "fill-opacity" {
 			curStyle.LineOpacity *= op
 		}
-	case "transform":
-		m, err := c.parseTransform(v)
-		if err != nil {
-			return err
-		}
-		curStyle.Transform = m
+	case "cursor":
+		curStyle.Cursor = strings.ToLower(v)
 	}
 	return nil
 }
 
-// pushStyle parses the style element, and push it on the style stack. Only color and opacity are supported
-// for fill. Note that this parses both the contents of a style attribute plus
-// direct fill and opacity attributes.
-func (c *iconCursor) pushStyle(attrs []simplexml.Attr) error {
-	var pairs []string
-	for _, attr := range attrs {
-		switch strings.ToLower(attr.Name.Local) {
-		case "style":
-			pairs = append(pairs, strings.Split(attr.Value, ";")...)
+// pushStyle parses the declarations of the element, and push the style on the style stack. The
+// declarations are the attributes, the <style> rules and the `style` attribute, see declarations.
+func (c *iconCursor) pushStyle(tag string, declarations []simplexml.Attr) error {
+	// Make a copy of the top style
+	curStyle := c.styleStack[len(c.styleStack)-1]
+	var transform, origin, box string
+	for _, d := range declarations {
+		k := strings.TrimSpace(d.Name.Local)
+		if !strings.HasPrefix(k, "--") { // custom properties are case-sensitive
+			k = strings.ToLower(k)
+		}
+		v := strings.TrimSpace(d.Value)
+		switch k {
+		case "transform":
+			transform = v
+		case "transform-origin":
+			origin = v
+		case "transform-box":
+			box = strings.ToLower(v)
 		default:
-			pairs = append(pairs, attr.Name.Local+":"+attr.Value)
+			if err := c.readStyleAttr(&curStyle, k, v); err != nil {
+				return err
+			}
 		}
 	}
-	// Make a copy of the top style
-	curStyle := c.styleStack[len(c.styleStack)-1]
-	for _, pair := range pairs {
-		kv := strings.Split(pair, ":")
-		if len(kv) >= 2 {
-			k := strings.ToLower(kv[0])
-			k = strings.TrimSpace(k)
-			v := strings.TrimSpace(kv[1])
-			err := c.readStyleAttr(&curStyle, k, v)
+	if transform != "" && transform != "none" {
+		m, err := c.parseTransformList(transform)
+		if err != nil {
+			return err
+		}
+		if origin != "" || box == "fill-box" {
+			x, y, err := c.transformOrigin(tag, declarations, origin, box)
 			if err != nil {
 				return err
 			}
+			m = Identity.Translate(x, y).Mult(m).Translate(-x, -y)
 		}
+		curStyle.Transform = curStyle.Transform.Mult(m)
 	}
 	c.styleStack = append(c.styleStack, curStyle) // Push style onto stack
 	return nil
 }
 
+// pushElement keeps track of the ID and the link of the element, it must be
+// removed from the elementStack at the end of the element.
+func (c *iconCursor) pushElement(se simplexml.StartElement, declarations []simplexml.Attr) {
+	e := element{tag: se.Name.Local, attrs: se.Attr, declarations: declarations, firstPath: len(c.icon.SVGPaths)}
+	if len(c.elementStack) > 0 {
+		parent := c.elementStack[len(c.elementStack)-1]
+		e.link, e.title = parent.link, parent.title
+	}
+	var link Link
+	for _, attr := range se.Attr {
+		switch attr.Name.Local {
+		case "id":
+			e.id = attr.Value
+		case "href":
+			link.Href = attr.Value
+		case "title", "aria-label":
+			link.Title = attr.Value
+		}
+	}
+	if se.Name.Local == "a" && link.Href != "" && !c.inDefs {
+		e.link = &link
+		c.icon.Links = append(c.icon.Links, e.link)
+	}
+	c.elementStack = append(c.elementStack, e)
+
+	if e.id != "" && !c.inDefs {
+		// The element may be the target of animations with `href`.
+		if c.targets == nil {
+			c.targets = make(map[string]*animationTarget)
+		}
+		c.targets[e.id] = c.elementTarget(len(c.elementStack) - 1)
+	}
+	c.readCSSAnimations(declarations)
+}
+
+// popElement removes the element from the elementStack, it must be called
+// before removing the style of the element.
+func (c *iconCursor) popElement() {
+	e := c.elementStack[len(c.elementStack)-1]
+	c.elementStack = c.elementStack[:len(c.elementStack)-1]
+	if e.target == nil {
+		return
+	}
+
+	if _, shape := shapeAttributes[e.tag]; shape && e.target.animated && e.firstPath == len(c.icon.SVGPaths) && !c.inDefs {
+		// The shape is empty, such as `r="0"`, but the animation may change it.
+		var parents []string
+		for i := len(c.elementStack) - 1; i >= 0; i-- {
+			if c.elementStack[i].id != "" {
+				parents = append(parents, c.elementStack[i].id)
+			}
+		}
+		c.icon.SVGPaths = append(c.icon.SVGPaths,
+			SvgPath{Style: c.styleStack[len(c.styleStack)-1], ID: e.id, Parents: parents, Link: e.link, Title: e.title})
+	}
+	for i := e.firstPath; i < len(c.icon.SVGPaths); i++ {
+		e.target.paths = append(e.target.paths, i)
+	}
+}
+
+// currentElement returns the current element and the non-empty IDs
+// of the ancestors, the closest first.
+func (c *iconCursor) currentElement() (e element, parents []string) {
+	if len(c.elementStack) == 0 {
+		return e, nil
+	}
+	e = c.elementStack[len(c.elementStack)-1]
+	for i := len(c.elementStack) - 2; i >= 0; i-- {
+		if c.elementStack[i].id != "" {
+			parents = append(parents, c.elementStack[i].id)
+		}
+	}
+	return e, parents
+}
+
+// setTitle sets the title of the current element, which is the parent of
+// the <title>. It's applied to the SvgPath already created inside the element,
+// unless they have their own title, and inherited by the next ones.
+//
+// The <title> of the root element is the title of the document, and
+// isn't applied to the elements.
+func (c *iconCursor) setTitle(title string) {
+	if len(c.elementStack) < 2 || title == "" {
+		return
+	}
+	e := &c.elementStack[len(c.elementStack)-1]
+	inherited := e.title
+	e.title = title
+	for i := e.firstPath; i < len(c.icon.SVGPaths); i++ {
+		if c.icon.SVGPaths[i].Title == inherited {
+			c.icon.SVGPaths[i].Title = title
+		}
+	}
+}
+
 // splitOnCommaOrSpace returns a list of strings after splitting the input on comma and space delimiters
 func splitOnCommaOrSpace(s string) []string {
 	return strings.FieldsFunc(s,
@@ -310,7 +538,7 @@ func splitOnCommaOrSpace(s string) []string {
 
 func (c *iconCursor) readStartElement(se simplexml.StartElement) (err error) {
 	var skipDef bool
-	if se.Name.Local == "radialGradient" || se.Name.Local == "linearGradient" || c.inGrad {
+	if se.Name.Local == "radialGradient" || se.Name.Local == "linearGradient" || se.Name.Local == "style" || c.inGrad {
 		skipDef = true
 	}
 	if c.inDefs && !skipDef {
@@ -342,13 +570,19 @@ func (c *iconCursor) readStartElement(se simplexml.StartElement) (err error) {
 		return nil
 	}
 	err = df(c, se.Attr)
+	c.addPath()
+	return
+}
 
+// addPath adds the path parsed from the xml element, if any, using the
+// style on the top of the stack.
+func (c *iconCursor) addPath() {
 	if len(c.path) > 0 {
 		//The cursor parsed a path from the xml element
 		pathCopy := append(Path{}, c.path...)
+		e, parents := c.currentElement()
 		c.icon.SVGPaths = append(c.icon.SVGPaths,
-			SvgPath{Path: pathCopy, Style: c.styleStack[len(c.styleStack)-1]})
+			SvgPath{Path: pathCopy, Style: c.styleStack[len(c.styleStack)-1], ID: e.id, Parents: parents, Link: e.link, Title: e.title})
 		c.path = c.path[:0]
 	}
-	return
 }
@@ -7,7 +7,6 @@ import (
 	"gioui.org/f32"
 	"log"
 	"math"
-	"unicode"
 )
 
 // ErrorMode is the for setting how the parser reacts to unparsed elements
@@ -51,24 +50,104 @@ func (c *pathCursor) init() {
 }
 
 // compilePath translates the svgPath description string into a path.
-// The resulting path element is stored in the pathCursor.
+// The resulting path element is stored in the pathCursor. As required by
+// the SVG specification, the path is kept up to the first command with
+// an error, and the error is returned.
 func (c *pathCursor) compilePath(svgPath string) error {
 	c.init()
-	lastIndex := -1
-	for i, v := range svgPath {
-		if unicode.IsLetter(v) && v != 'e' {
-			if lastIndex != -1 {
-				if err := c.addSeg(svgPath[lastIndex:i]); err != nil {
-					return err
-				}
+	t := tokenizer{s: svgPath}
+	t.skipSpace()
+	for first := true; !t.done(); first = false {
+		k := t.next()
+		if !isPathCommand(k) || first && k != 'M' && k != 'm' {
+			return errParamMismatch
+		}
+		args, ok := pathArgs[k]
+		if !ok {
+			if err := c.unknownCommand(k); err != nil {
+				return err
+			}
+			// The arguments of the unknown command are skipped.
+			for !t.done() && !isPathCommand(t.peek()) {
+				t.i++
+			}
+			continue
+		}
+		// The command is repeated while numbers follow, and each complete
+		// set of arguments is added before the next one is read.
+		for {
+			if err := c.readArgs(&t, args); err != nil {
+				return err
+			}
+			if err := c.addSeg(k); err != nil {
+				return err
+			}
+			if args == 0 || t.done() || !isNumberStart(t.peek()) {
+				break
+			}
+			// The implicit commands after a move are lines.
+			switch k {
+			case 'M':
+				k = 'L'
+			case 'm':
+				k = 'l'
 			}
-			lastIndex = i
 		}
 	}
-	if lastIndex != -1 {
-		if err := c.addSeg(svgPath[lastIndex:]); err != nil {
+	return nil
+}
+
+// pathArgs is the number of arguments of each command of the path data.
+var pathArgs = map[byte]int{
+	'M': 2, 'm': 2, 'L': 2, 'l': 2, 'H': 1, 'h': 1, 'V': 1, 'v': 1,
+	'C': 6, 'c': 6, 'S': 4, 's': 4, 'Q': 4, 'q': 4, 'T': 2, 't': 2,
+	'A': 7, 'a': 7, 'Z': 0, 'z': 0,
+}
+
+func isPathCommand(b byte) bool {
+	return b >= 'A' && b <= 'Z' && b != 'E' || b >= 'a' && b <= 'z' && b != 'e'
+}
+
+// readArgs reads one set of arguments of a command into the cursor's
+// points slice. The flags of the arcs are single digits, so they may be
+// written without separators, such as "a1 1 0 00 1 1".
+func (c *pathCursor) readArgs(t *tokenizer, args int) error {
+	c.points = c.points[:0]
+	t.skipSpace()
+	if args == 0 {
+		return nil
+	}
+	for i := 0; i < args; i++ {
+		if i > 0 {
+			t.skipCommaSpace()
+		}
+		var (
+			f   float64
+			err error
+		)
+		if args == 7 && (i == 3 || i == 4) {
+			f, err = t.flag()
+		} else {
+			f, err = t.number()
+		}
+		if err != nil {
 			return err
 		}
+		c.points = append(c.points, f)
+	}
+	t.skipCommaSpace()
+	return nil
+}
+
+func isNumberStart(b byte) bool { return isDigit(b) || b == '.' || b == '+' || b == '-' }
+
+// unknownCommand reports the command according to the errorMode.
+func (c *pathCursor) unknownCommand(k byte) error {
+	if c.errorMode == StrictErrorMode {
+		return errCommandUnknown
+	}
+	if c.errorMode == WarnErrorMode {
+		log.Println("Ignoring svg command " + string(k))
 	}
 	return nil
 }
@@ -107,59 +186,20 @@ func (c *pathCursor) hasSetsOrMore(sz int, rel bool) bool {
 	return true
 }
 
-// readFloat reads a floating point value and adds it to the cursor's points slice.
-func (c *pathCursor) readFloat(numStr string) error {
-	last := 0
-	isFirst := true
-	for i, n := range numStr {
-		if n == '.' {
-			if isFirst {
-				isFirst = false
-				continue
-			}
-			f, err := parseBasicFloat(numStr[last:i])
-			if err != nil {
-				return err
-			}
-			c.points = append(c.points, f)
-			last = i
-		}
-	}
-	f, err := parseBasicFloat(numStr[last:])
-	if err != nil {
-		return err
-	}
-	c.points = append(c.points, f)
-	return nil
-}
-
-// getPoints reads a set of floating point values from the SVG format number string,
-// and add them to the cursor's points slice.
+// getPoints reads the numbers of the SVG list, such as the `points` of
+// <polygon> or the `viewBox`, separated by commas or spaces, into the
+// cursor's points slice.
 func (c *pathCursor) getPoints(dataPoints string) error {
-	lastIndex := -1
-	c.points = c.points[0:0]
-	lr := ' '
-	for i, r := range dataPoints {
-		if !unicode.IsNumber(r) && r != '.' && !(r == '-' && lr == 'e') && r != 'e' {
-			if lastIndex != -1 {
-				if err := c.readFloat(dataPoints[lastIndex:i]); err != nil {
-					return err
-				}
-			}
-			if r == '-' {
-				lastIndex = i
-			} else {
-				lastIndex = -1
-			}
-		} else if lastIndex == -1 {
-			lastIndex = i
-		}
-		lr = r
-	}
-	if lastIndex != -1 && lastIndex != len(dataPoints) {
-		if err := c.readFloat(dataPoints[lastIndex:]); err != nil {
+	c.points = c.points[:0]
+	t := tokenizer{s: dataPoints}
+	t.skipSpace()
+	for !t.done() {
+		f, err := t.number()
+		if err != nil {
 			return err
 		}
+		c.points = append(c.points, f)
+		t.skipCommaSpace()
 	}
 	return nil
 }
@@ -182,15 +222,10 @@ func (c *pathCursor) reflectControlCube() {
 	}
 }
 
-// addSeg decodes an SVG seqment string into equivalent raster path commands saved
-// in the cursor's Path
-func (c *pathCursor) addSeg(segString string) error {
-	// Parse the string describing the numeric points in SVG format
-	if err := c.getPoints(segString[1:]); err != nil {
-		return err
-	}
+// addSeg adds the command, with the arguments in the cursor's points
+// slice, as the equivalent raster path commands saved in the cursor's Path
+func (c *pathCursor) addSeg(k byte) error {
 	l := len(c.points)
-	k := segString[0]
 	rel := false
 	switch k {
 	case 'z':
@@ -371,12 +406,7 @@ func (c *pathCursor) addSeg(segString string) error {
 			c.addArcFromA(c.points[i:])
 		}
 	default:
-		if c.errorMode == StrictErrorMode {
-			return errCommandUnknown
-		}
-		if c.errorMode == WarnErrorMode {
-			log.Println("Ignoring svg command " + string(k))
-		}
+		return c.unknownCommand(k)
 	}
 	// So we know how to extend some segment types
 	c.lastKey = k
@@ -386,20 +416,13 @@ func (c *pathCursor) addSeg(segString string) error {
 // ellipseAt adds a path of an elipse centered at cx, cy of radius rx and ry
 // to the pathCursor
 func (c *pathCursor) ellipseAt(cx, cy, rx, ry float64) {
+	c.path.Ellipse(cx, cy, rx, ry)
 	c.placeX, c.placeY = cx+rx, cy
-	c.points = c.points[0:0]
-	c.points = append(c.points, rx, ry, 0.0, 1.0, 0.0, c.placeX, c.placeY)
-	c.path.Start(f32.Point{
-		X: float32(c.placeX),
-		Y: float32(c.placeY),
-	})
-	c.placeX, c.placeY = c.path.addArc(c.points, cx, cy, c.placeX, c.placeY)
-	c.path.Stop(true)
 }
 
 // addArcFromA adds a path of an arc element to the cursor path to the pathCursor
 func (c *pathCursor) addArcFromA(points []float64) {
 	cx, cy := findEllipseCenter(&points[0], &points[1], points[2]*math.Pi/180, c.placeX,
 		c.placeY, points[5], points[6], points[4] == 0, points[3] == 0)
-	c.placeX, c.placeY = c.path.addArc(c.points, cx+c.curX, cy+c.curY, c.placeX+c.curX, c.placeY+c.curY)
+	c.placeX, c.placeY = c.path.addArc(points, cx+c.curX, cy+c.curY, c.placeX+c.curX, c.placeY+c.curY)
 }
@@ -0,0 +1,181 @@
+package svgparser
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestCompilePath(t *testing.T) {
+	for _, c := range []struct {
+		d        string
+		expected string
+		err      bool
+	}{
+		{d: "", expected: ""},
+		{d: "M0 0h10v10H0z", expected: "M0 0 L10 0 L10 10 L0 10 Z"},
+		{d: "M1E1 0L2e+1 0 3e-0 1", expected: "M10 0 L20 0 L3 1"},
+		{d: "M1e1.5L1-1", expected: "M10 0.5 L1 -1"},
+		{d: "M.5.5L-.5-.5", expected: "M0.5 0.5 L-0.5 -0.5"},
+		{d: "M1-2-3-4", expected: "M1 -2 L-3 -4"},
+		{d: "M0,0,10,0", expected: "M0 0 L10 0"},
+		{d: "  M 0 , 0 \n\t L 10 , 0  ", expected: "M0 0 L10 0"},
+		{d: "m1 1 2 2", expected: "M1 1 L3 3"},
+		{d: "m1 1zm2 2", expected: "M1 1 Z M3 3"},
+		{d: "M0 0 Q5 5 10 0 T20 0", expected: "M0 0 Q5 5 10 0 Q15 -5 20 0"},
+		{d: "M0 0 C1 1 2 1 3 0 S5 -1 6 0", expected: "M0 0 C1 1 2 1 3 0 C4 -1 5 -1 6 0"},
+		{d: "M0 0 B1 1 L2 2", expected: "M0 0 L2 2"}, // unknown commands are ignored
+
+		// The path is kept up to the command with the error.
+		{d: "L10 10", err: true},
+		{d: "10 10", err: true},
+		{d: "M0 0 L10 10 L20", expected: "M0 0 L10 10", err: true},
+		{d: "M0 0 L10 10 20", expected: "M0 0 L10 10", err: true},
+		{d: "M0 0 10 10 20", expected: "M0 0 L10 10", err: true},
+		{d: "M0 0 Q5 5 10 0 T20 0 30", expected: "M0 0 Q5 5 10 0 Q15 -5 20 0", err: true},
+		{d: "M0 0 L10 10 Z 1", expected: "M0 0 L10 10 Z", err: true},
+		{d: "M0 0 L1e 1", expected: "M0", err: true},
+		{d: "M0 0 L10 0 #", expected: "M0 0 L10 0", err: true},
+		{d: "M0 0 L10 0,,10", expected: "M0 0 L10 0", err: true},
+		{d: "M0 0 a1 1 0 2 0 2 0", expected: "M0 0", err: true},
+	} {
+		var cursor pathCursor
+		err := cursor.compilePath(c.d)
+		if (err != nil) != c.err {
+			t.Errorf("%q: unexpected error %v", c.d, err)
+		}
+		if got := cursor.path.String(); !strings.HasPrefix(got, c.expected) || !c.err && got != c.expected {
+			t.Errorf("%q: expected %q, got %q", c.d, c.expected, got)
+		}
+	}
+}
+
+func TestCompilePathArcFlags(t *testing.T) {
+	var expected pathCursor
+	if err := expected.compilePath("M0 0 a1 1 0 0 1 2 0 a1 1 0 1 0 2 0"); err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []string{
+		"M0 0a1,1,0,0,1,2,0a1,1,0,1,0,2,0",
+		"M0 0a1 1 0 012 0 1 1 0 102 0",
+		"M0 0a1 1 0 01 2 0a1 1 0 10 2 0",
+	} {
+		var cursor pathCursor
+		if err := cursor.compilePath(d); err != nil {
+			t.Fatalf("%q: %v", d, err)
+		}
+		if got := cursor.path.String(); got != expected.path.String() {
+			t.Errorf("%q: expected %q, got %q", d, expected.path.String(), got)
+		}
+	}
+}
+
+func TestGetPoints(t *testing.T) {
+	for _, c := range []struct {
+		s        string
+		expected []float64
+		err      bool
+	}{
+		{s: "0 0 24 24", expected: []float64{0, 0, 24, 24}},
+		{s: " 0,0, 24 ,24 ", expected: []float64{0, 0, 24, 24}},
+		{s: "-1-2.5.5e1 1E2", expected: []float64{-1, -2.5, 5, 100}},
+		{s: "+1 -.5", expected: []float64{1, -0.5}},
+		{s: "1 two", err: true},
+		{s: "1,,2", err: true},
+	} {
+		var cursor pathCursor
+		err := cursor.getPoints(c.s)
+		if (err != nil) != c.err {
+			t.Errorf("%q: unexpected error %v", c.s, err)
+		}
+		if c.err {
+			continue
+		}
+		if len(cursor.points) != len(c.expected) {
+			t.Fatalf("%q: expected %v, got %v", c.s, c.expected, cursor.points)
+		}
+		for i := range c.expected {
+			if !almostEqual(cursor.points[i], c.expected[i]) {
+				t.Errorf("%q: expected %v, got %v", c.s, c.expected, cursor.points)
+			}
+		}
+	}
+}
+
+func TestParseTransformList(t *testing.T) {
+	for _, c := range []struct {
+		v        string
+		expected Matrix2D
+		err      bool
+	}{
+		{v: "", expected: Identity},
+		{v: "translate(10)", expected: Identity.Translate(10, 0)},
+		{v: "translate(10 20)", expected: Identity.Translate(10, 20)},
+		{v: "scale(2)", expected: Identity.Scale(2, 2)},
+		{v: "scale(2,-1)", expected: Identity.Scale(2, -1)},
+		{v: "rotate(90)", expected: Identity.Rotate(math.Pi / 2)},
+		{v: "rotate(90 5 5)", expected: Identity.Translate(5, 5).Rotate(math.Pi/2).Translate(-5, -5)},
+		{v: "skewX(45)", expected: Identity.SkewX(math.Pi / 4)},
+		{v: "skewY(45)", expected: Identity.SkewY(math.Pi / 4)},
+		{v: "matrix(1 0 0 1 1e1 2E1)", expected: Identity.Translate(10, 20)},
+		{v: "translate(10,20) scale(2)", expected: Identity.Translate(10, 20).Scale(2, 2)},
+		{v: "translate(10,20),scale(2)", expected: Identity.Translate(10, 20).Scale(2, 2)},
+		{v: "translate(10 20)scale(2)", expected: Identity.Translate(10, 20).Scale(2, 2)},
+		{v: " translate ( 10 , 20 ) , scale ( 2 ) ", expected: Identity.Translate(10, 20).Scale(2, 2)},
+		{v: "translate(-.5.5)", expected: Identity.Translate(-0.5, 0.5)},
+
+		// The CSS functions and units.
+		{v: "rotate(90deg)", expected: Identity.Rotate(math.Pi / 2)},
+		{v: "rotate(0.25turn)", expected: Identity.Rotate(math.Pi / 2)},
+		{v: "rotate(100grad)", expected: Identity.Rotate(math.Pi / 2)},
+		{v: "rotate(1.5707963267948966rad)", expected: Identity.Rotate(math.Pi / 2)},
+		{v: "translate(1px, 1em)", expected: Identity.Translate(1, 16)},
+		{v: "translateX(5px) translateY(6px)", expected: Identity.Translate(5, 6)},
+		{v: "scaleX(2) scaleY(3)", expected: Identity.Scale(2, 3)},
+		{v: "skew(45deg, 0)", expected: Identity.SkewX(math.Pi / 4)},
+
+		{v: "translate(10", err: true},
+		{v: "translate 10", err: true},
+		{v: "(10)", err: true},
+		{v: "move(10)", err: true},
+		{v: "rotate(1 2)", err: true},
+		{v: "rotate(45%)", err: true},
+		{v: "rotate(45foo)", err: true},
+		{v: "translate(10) )", err: true},
+		{v: "translate(10),,scale(2)", err: true},
+	} {
+		var cursor iconCursor
+		m, err := cursor.parseTransformList(c.v)
+		if (err != nil) != c.err {
+			t.Errorf("%q: unexpected error %v", c.v, err)
+		}
+		if c.err {
+			continue
+		}
+		for _, v := range [...][2]float64{{m.A, c.expected.A}, {m.B, c.expected.B}, {m.C, c.expected.C}, {m.D, c.expected.D}, {m.E, c.expected.E}, {m.F, c.expected.F}} {
+			if math.Abs(v[0]-v[1]) > 1e-9 {
+				t.Errorf("%q: expected %v, got %v", c.v, c.expected, m)
+				break
+			}
+		}
+	}
+}
+
+func TestTransformOrigin(t *testing.T) {
+	for _, origin := range []string{
+		`transform-origin="5 5"`,
+		`transform-origin="50% 5px"`,
+		`transform-origin="center"`,
+		`transform-origin="center center 10px"`,
+		`style="transform-origin: 0.3125em 50%"`,
+	} {
+		icon, err := ReadIcon(strings.NewReader(`<svg viewBox="0 0 10 10"><rect width="10" height="4" transform="rotate(90)" ` + origin + `/></svg>`))
+		if err != nil {
+			t.Fatalf("%s: %v", origin, err)
+		}
+		m := icon.SVGPaths[0].Style.Transform
+		if x, y := m.Transform(5, 5); math.Abs(x-5) > 1e-9 || math.Abs(y-5) > 1e-9 {
+			t.Errorf("%s: expected the rotation around the center, got %v", origin, m)
+		}
+	}
+}
@@ -61,21 +61,22 @@ func (op OpClose) drawTo(d Drawer, _ Matrix2D) {
 }
 
 func (op OpMoveTo) String() string {
-	return fmt.Sprintf("M%4.3f,%4.3f", float32(op.X)/64, float32(op.Y)/64)
+	return "M" + formatFloat32(op.X) + " " + formatFloat32(op.Y)
 }
 
 func (op OpLineTo) String() string {
-	return fmt.Sprintf("L%4.3f,%4.3f", float32(op.X)/64, float32(op.Y)/64)
+	return "L" + formatFloat32(op.X) + " " + formatFloat32(op.Y)
 }
 
 func (op OpQuadTo) String() string {
-	return fmt.Sprintf("Q%4.3f,%4.3f,%4.3f,%4.3f", float32(op[0].X)/64, float32(op[0].Y)/64,
-		float32(op[1].X)/64, float32(op[1].Y)/64)
+	return "Q" + formatFloat32(op[0].X) + " " + formatFloat32(op[0].Y) + " " +
+		formatFloat32(op[1].X) + " " + formatFloat32(op[1].Y)
 }
 
 func (op OpCubicTo) String() string {
-	return "C" + fmt.Sprintf("C%4.3f,%4.3f,%4.3f,%4.3f,%4.3f,%4.3f", float32(op[0].X)/64, float32(op[0].Y)/64,
-		float32(op[1].X)/64, float32(op[1].Y)/64, float32(op[2].X)/64, float32(op[2].Y)/64)
+	return "C" + formatFloat32(op[0].X) + " " + formatFloat32(op[0].Y) + " " +
+		formatFloat32(op[1].X) + " " + formatFloat32(op[1].Y) + " " +
+		formatFloat32(op[2].X) + " " + formatFloat32(op[2].Y)
 }
 
 func (op OpClose) String() string {
@@ -86,7 +87,8 @@ func (op OpClose) String() string {
 // Higher-level shapes may be reduced to a path.
 type Path []Operation
 
-// ToSVGPath returns a string representation of the path
+// ToSVGPath returns the path data, such as "M0 0 L10 0 Z", using the absolute
+// commands and 3 decimals. See WriteSVG to control the precision.
 func (p Path) ToSVGPath() string {
 	chunks := make([]string, len(p))
 	for i, op := range p {
@@ -131,3 +133,83 @@ func (p *Path) Stop(closeLoop bool) {
 		*p = append(*p, OpClose{})
 	}
 }
+
+// Transform returns the path with the transform applied to all points.
+func (p Path) Transform(m Matrix2D) Path {
+	out := make(Path, len(p))
+	for i, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			out[i] = OpMoveTo(m.TFixed(f32.Point(op)))
+		case OpLineTo:
+			out[i] = OpLineTo(m.TFixed(f32.Point(op)))
+		case OpQuadTo:
+			out[i] = OpQuadTo{m.TFixed(op[0]), m.TFixed(op[1])}
+		case OpCubicTo:
+			out[i] = OpCubicTo{m.TFixed(op[0]), m.TFixed(op[1]), m.TFixed(op[2])}
+		default:
+			out[i] = op
+		}
+	}
+	return out
+}
+
+// Segment is a line or a curve of a Path, see Path.Segments.
+type Segment struct {
+	// Points holds the start point, the control points and the end point:
+	// two points for lines, three for quadratic and four for cubic curves.
+	Points []f32.Point
+
+	// Closing is true for the line which closes the sub-path, added by OpClose.
+	Closing bool
+}
+
+// Segments returns the lines and curves of the path, with the start point
+// of each one. The sub-paths are not joined: the OpMoveTo don't create
+// segments, and OpClose only creates a line if the current point isn't
+// already the start of the sub-path.
+func (p Path) Segments() (segments []Segment) {
+	var start, last f32.Point
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			start, last = f32.Point(op), f32.Point(op)
+		case OpLineTo:
+			segments = append(segments, Segment{Points: []f32.Point{last, f32.Point(op)}})
+			last = f32.Point(op)
+		case OpQuadTo:
+			segments = append(segments, Segment{Points: []f32.Point{last, op[0], op[1]}})
+			last = op[1]
+		case OpCubicTo:
+			segments = append(segments, Segment{Points: []f32.Point{last, op[0], op[1], op[2]}})
+			last = op[2]
+		case OpClose:
+			if last != start {
+				segments = append(segments, Segment{Points: []f32.Point{last, start}, Closing: true})
+			}
+			last = start
+		}
+	}
+	return segments
+}
+
+// currentPoint returns the end of the path, which is the start of the
+// next segment.
+func (p Path) currentPoint() (current f32.Point) {
+	var start f32.Point
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			start, current = f32.Point(op), f32.Point(op)
+		case OpLineTo:
+			current = f32.Point(op)
+		case OpQuadTo:
+			current = op[1]
+		case OpCubicTo:
+			current = op[2]
+		case OpClose:
+			current = start
+		}
+	}
+	return current
+}
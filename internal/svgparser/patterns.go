@@ -25,6 +25,13 @@ type PlainColor struct {
 	color.NRGBA
 }
 
+// Variable is a CSS custom property, such as `var(--primary, red)`.
+// The value is defined when drawing, otherwise the Fallback is used.
+type Variable struct {
+	Name     string  // Name without the `--` prefix
+	Fallback Pattern // Fallback is used when the variable is not defined
+}
+
 func NewPlainColor(r, g, b, a uint8) PlainColor {
 	return PlainColor{NRGBA: color.NRGBA{r, g, b, a}}
 }
@@ -32,6 +39,7 @@ func NewPlainColor(r, g, b, a uint8) PlainColor {
 func (PlainColor) isPattern()   {}
 func (Gradient) isPattern()     {}
 func (CurrentColor) isPattern() {}
+func (Variable) isPattern()     {}
 
 // enables to differentiate between black and nil color
 type optionnalColor struct {
@@ -104,6 +112,20 @@ func parseSVGColor(colorStr string) (optionnalColor, error) {
 	return optionnalColor{}, errParamMismatch
 }
 
+// ParseColor parses the SVG color, such as `red`, `#f00` or
+// `rgb(255, 0, 0)`. The color `none` is transparent.
+func ParseColor(v string) (color.NRGBA, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return color.NRGBA{}, errParamMismatch
+	}
+	c, err := parseSVGColor(v)
+	if err != nil || !c.valid {
+		return color.NRGBA{}, err
+	}
+	return c.color.NRGBA, nil
+}
+
 func parseColorValue(v string) (uint8, error) {
 	if v[len(v)-1] == '%' {
 		n, err := strconv.Atoi(strings.TrimSpace(v[:len(v)-1]))
@@ -168,6 +190,7 @@ type GradStop struct {
 	StopColor color.Color
 	Offset    float64
 	Opacity   float64
+	Variable  string // Variable replaces the StopColor, if defined when drawing
 }
 
 // Gradient holds a description of an SVG 2.0 gradient
@@ -200,6 +223,31 @@ func (g *Gradient) ApplyPathExtent(extent fixed.Rectangle26_6) Matrix2D {
 	return g.Matrix
 }
 
+// userTransform returns the transform from the coordinates of the Direction
+// to the coordinates of `m`, which is the transform of the path. Unlike
+// ApplyPathExtent, it doesn't modify the Gradient.
+func (g *Gradient) userTransform(path Path, m Matrix2D) Matrix2D {
+	if g.Units != ObjectBoundingBox {
+		return m.Mult(g.Matrix)
+	}
+	// The bounding box is the tight bounds of the geometry, without the stroke.
+	bounds := path.Bounds(Identity)
+	return m.Translate(float64(bounds.Min.X), float64(bounds.Min.Y)).
+		Scale(float64(bounds.Dx()), float64(bounds.Dy())).Mult(g.Matrix)
+}
+
+// transformPattern returns the pattern in the coordinates of the Drawer,
+// which receives the points of the path after the transform `m`.
+func transformPattern(p Pattern, path Path, m Matrix2D) Pattern {
+	g, ok := p.(Gradient)
+	if !ok {
+		return p
+	}
+	g.Matrix = g.userTransform(path, m)
+	g.Units = UserSpaceOnUse
+	return g
+}
+
 // radial or linear
 type gradientDirecter interface {
 	isRadial() bool
@@ -295,3 +343,133 @@ func (c *iconCursor) readGradAttr(attr simplexml.Attr) (err error) {
 	}
 	return
 }
+
+// ColorMap replaces the colors of a Pattern, including
+// the colors of the gradient stops.
+type ColorMap map[color.NRGBA]color.NRGBA
+
+// Pattern returns the pattern with the colors replaced.
+// CurrentColor and nil patterns are returned unchanged.
+func (m ColorMap) Pattern(p Pattern) Pattern {
+	if len(m) == 0 {
+		return p
+	}
+	switch c := p.(type) {
+	case PlainColor:
+		if n, ok := m[c.NRGBA]; ok {
+			return PlainColor{NRGBA: n}
+		}
+	case Gradient:
+		stops := make([]GradStop, len(c.Stops))
+		for i, s := range c.Stops {
+			stops[i] = s
+			if s.StopColor == nil {
+				continue
+			}
+			if n, ok := m[toNRGBA(s.StopColor)]; ok {
+				stops[i].StopColor = PlainColor{NRGBA: n}
+			}
+		}
+		c.Stops = stops
+		return c
+	}
+	return p
+}
+
+// toNRGBA converts any color.Color to color.NRGBA.
+func toNRGBA(c color.Color) color.NRGBA {
+	if p, ok := c.(PlainColor); ok {
+		return p.NRGBA
+	}
+	return color.NRGBAModel.Convert(c).(color.NRGBA)
+}
+
+// Palette returns the distinct colors used by the SVG, in the order
+// they are drawn. It includes the colors of gradient stops, but not CurrentColor.
+func (s *SVGRender) Palette() []color.NRGBA {
+	var palette []color.NRGBA
+	seen := make(map[color.NRGBA]struct{})
+	add := func(c color.NRGBA) {
+		if _, ok := seen[c]; !ok {
+			seen[c] = struct{}{}
+			palette = append(palette, c)
+		}
+	}
+	for _, svgp := range s.SVGPaths {
+		for _, p := range [...]Pattern{svgp.Style.FillerColor, svgp.Style.LinerColor} {
+			for {
+				v, ok := p.(Variable)
+				if !ok {
+					break
+				}
+				p = v.Fallback
+			}
+			switch c := p.(type) {
+			case PlainColor:
+				add(c.NRGBA)
+			case Gradient:
+				for _, s := range c.Stops {
+					if s.StopColor != nil {
+						add(toNRGBA(s.StopColor))
+					}
+				}
+			}
+		}
+	}
+	return palette
+}
+
+// Variables returns the names of the CSS custom properties used
+// by the SVG, in the order they are drawn.
+func (s *SVGRender) Variables() []string {
+	var names []string
+	seen := make(map[string]struct{})
+	add := func(name string) {
+		if _, ok := seen[name]; !ok && name != "" {
+			seen[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+	for _, svgp := range s.SVGPaths {
+		for _, p := range [...]Pattern{svgp.Style.FillerColor, svgp.Style.LinerColor} {
+			switch c := p.(type) {
+			case Variable:
+				for ok := true; ok; c, ok = c.Fallback.(Variable) {
+					add(c.Name)
+				}
+			case Gradient:
+				for _, s := range c.Stops {
+					add(s.Variable)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// resolve returns the pattern with the variables and colors
+// replaced by the DrawOptions.
+func (o DrawOptions) resolve(p Pattern) Pattern {
+	switch c := p.(type) {
+	case Variable:
+		if n, ok := o.Variables[c.Name]; ok {
+			return PlainColor{NRGBA: n}
+		}
+		return o.resolve(c.Fallback)
+	case Gradient:
+		g := o.Colors.Pattern(c).(Gradient)
+		if len(o.Variables) == 0 {
+			return g
+		}
+		stops := make([]GradStop, len(g.Stops))
+		for i, s := range g.Stops {
+			stops[i] = s
+			if n, ok := o.Variables[s.Variable]; ok && s.Variable != "" {
+				stops[i].StopColor = PlainColor{NRGBA: n}
+			}
+		}
+		g.Stops = stops
+		return g
+	}
+	return o.Colors.Pattern(p)
+}
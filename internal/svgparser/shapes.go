@@ -80,8 +80,8 @@ func addArc(p *matrixAdder, a, s1, s2 f32.Point, clockwise bool, trimStart,
 	segs := int(math.Abs(deltaTheta)/(math.Pi/cubicsPerHalfCircle)) + 1
 	dTheta := deltaTheta / float64(segs)
 	tde := math.Tan(dTheta / 2)
-	alpha := float32(math.Sin(dTheta) * (math.Sqrt(4+3*tde*tde) - 1) * (64.0 / 3.0)) // Math is fun!
-	r := float64(length(s1.Sub(a)))                                                  // Note r is *64
+	alpha := float32(math.Sin(dTheta) * (math.Sqrt(4+3*tde*tde) - 1) / 3) // Math is fun!
+	r := float64(length(s1.Sub(a)))
 	ldp := f32.Point{X: -float32(r * math.Sin(theta1)), Y: float32(r * math.Cos(theta1))}
 	ds1 = ldp
 	ps1 = f32.Point{X: a.X + ldp.Y, Y: a.Y - ldp.X}
@@ -269,3 +269,43 @@ func findEllipseCenter(ra, rb *float64, rotX, startX, startY, endX, endY float64
 	//Reverse rotate and translate back to original coordinates
 	return cx*cos - cy*sin + startX, cx*sin + cy*cos + startY
 }
+
+// ArcTo adds an elliptical arc from the current point to (x, y), like the
+// `A` command of the SVG paths, approximated by cubic bezier curves. The
+// rotation of the x axis is in degrees. As in SVG, the radii are increased if
+// the arc can't reach (x, y), and a line is added if one radius is zero.
+func (p *Path) ArcTo(rx, ry, rotation float64, largeArc, sweep bool, x, y float64) {
+	current := p.currentPoint()
+	px, py := float64(current.X), float64(current.Y)
+	if px == x && py == y {
+		return
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		p.Line(toFixedP(x, y))
+		return
+	}
+	flag := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	cx, cy := findEllipseCenter(&rx, &ry, rotation*math.Pi/180, px, py, x, y, !sweep, !largeArc)
+	p.addArc([]float64{rx, ry, rotation, flag(largeArc), flag(sweep), x, y}, cx, cy, px, py)
+}
+
+// Ellipse adds a closed sub-path of the ellipse centered at (cx, cy),
+// approximated by cubic bezier curves.
+func (p *Path) Ellipse(cx, cy, rx, ry float64) {
+	p.Start(toFixedP(cx+rx, cy))
+	p.addArc([]float64{rx, ry, 0, 1, 0, cx + rx, cy}, cx, cy, cx+rx, cy)
+	p.Stop(true)
+}
+
+// RoundRect adds a closed sub-path of the rectangle, with the corners
+// rounded by the radius rx in the x axis and ry in the y axis. The
+// corners aren't rounded if one radius is zero.
+func (p *Path) RoundRect(minX, minY, maxX, maxY, rx, ry float64) {
+	p.addRoundRect(minX, minY, maxX, maxY, rx, ry, 0)
+}
@@ -33,8 +33,12 @@ type EndElement struct {
 	Name Name
 }
 
+// A CharData represents the text of an XML element, such
+// as the text of a <title>.
+type CharData []byte
+
 // A Token is an interface holding one of the token types:
-// StartElement, EndElement.
+// StartElement, EndElement, CharData.
 type Token interface{}
 
 type Decoder interface {
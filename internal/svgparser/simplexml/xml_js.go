@@ -24,12 +24,13 @@ var (
 	_Reflect    = js.Global().Get("Reflect")
 	_ReflectGet = _Reflect.Get("get").Call("bind").Invoke
 
-	_length     = js.ValueOf("length")
-	_tagName    = js.ValueOf("tagName")
-	_attributes = js.ValueOf("attributes")
-	_children   = js.ValueOf("children")
-	_value      = js.ValueOf("value")
-	_name       = js.ValueOf("name")
+	_length      = js.ValueOf("length")
+	_tagName     = js.ValueOf("tagName")
+	_attributes  = js.ValueOf("attributes")
+	_children    = js.ValueOf("children")
+	_value       = js.ValueOf("value")
+	_name        = js.ValueOf("name")
+	_textContent = js.ValueOf("textContent")
 )
 
 func newDecoder(r io.Reader) Decoder {
@@ -69,6 +70,9 @@ func (d *decoder) decode(children js.Value) {
 
 		if c := _ReflectGet(elem, _children); c.Truthy() && _ReflectGet(c, _length).Int() > 0 {
 			d.decode(c)
+		} else if text := _ReflectGet(elem, _textContent); text.Truthy() {
+			// Only the text of elements without children is used, such as <title>.
+			d.tokens = append(d.tokens, CharData(text.String()))
 		}
 
 		d.tokens = append(d.tokens, end)
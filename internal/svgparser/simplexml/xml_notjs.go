@@ -33,6 +33,9 @@ func (d *decoder) Token() (Token, error) {
 	case xml.EndElement:
 		t.Name.Space = ""
 		return *(*EndElement)(unsafe.Pointer(&t)), nil
+	case xml.CharData:
+		// The xml.CharData is only valid until the next call to Token.
+		return CharData(t.Copy()), nil
 	default:
 		// Unsupported operation.
 		return nil, nil
@@ -0,0 +1,354 @@
+package svgparser
+
+import (
+	"math"
+
+	"gioui.org/f32"
+)
+
+// circleKappa is the distance of the control points of the cubic bezier
+// curves that approximate a quarter of a circle.
+const circleKappa = 0.5522847498
+
+// polyline is a flattened sub-path.
+type polyline struct {
+	points []f32.Point
+	closed bool
+}
+
+// polylines flattens the path, within the given tolerance. The consecutive
+// duplicated points are removed.
+func (p Path) polylines(tolerance float64) (list []polyline) {
+	var current *polyline
+	add := func(a, b f32.Point) {
+		if current == nil {
+			list = append(list, polyline{points: []f32.Point{a}})
+			current = &list[len(list)-1]
+		}
+		if b != current.points[len(current.points)-1] {
+			current.points = append(current.points, b)
+		}
+	}
+	var start, last f32.Point
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			start, last = f32.Point(op), f32.Point(op)
+			list = append(list, polyline{points: []f32.Point{start}})
+			current = &list[len(list)-1]
+		case OpLineTo:
+			add(last, f32.Point(op))
+			last = f32.Point(op)
+		case OpQuadTo:
+			flattenCubic(last, last.Add(op[0].Sub(last).Mul(2.0/3.0)), op[1].Add(op[0].Sub(op[1]).Mul(2.0/3.0)), op[1], tolerance, add)
+			last = op[1]
+		case OpCubicTo:
+			flattenCubic(last, op[0], op[1], op[2], tolerance, add)
+			last = op[2]
+		case OpClose:
+			if current != nil {
+				if n := len(current.points); n > 1 && current.points[n-1] == start {
+					current.points = current.points[:n-1]
+				}
+				current.closed = true
+			}
+			// The next segment, if any, starts a new sub-path at the start.
+			current, last = nil, start
+		}
+	}
+	return list
+}
+
+// Polygons returns the flattened sub-paths, within the given tolerance.
+// The consecutive duplicated points are removed.
+func (p Path) Polygons(tolerance float64) [][]f32.Point {
+	lines := p.polylines(tolerance)
+	polygons := make([][]f32.Point, len(lines))
+	for i, line := range lines {
+		polygons[i] = line.points
+	}
+	return polygons
+}
+
+// Flatten returns the path with the curves replaced by lines, within the
+// given tolerance. The consecutive duplicated points are removed.
+func (p Path) Flatten(tolerance float64) (out Path) {
+	for _, line := range p.polylines(tolerance) {
+		out.Start(line.points[0])
+		for _, point := range line.points[1:] {
+			out.Line(point)
+		}
+		out.Stop(line.closed)
+	}
+	return out
+}
+
+// StrokeOutline returns the outline of the stroke of the path, which has
+// the same area when filled using the non-zero winding rule. All polygons
+// have the same orientation, so they don't cancel each other.
+func (p Path) StrokeOutline(width float64, join JoinOptions, tolerance float64) (out Path) {
+	r := width / 2
+	if r <= 0 {
+		return nil
+	}
+	polygon := func(points ...f32.Point) {
+		if polygonArea(points) < 0 {
+			for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+				points[i], points[j] = points[j], points[i]
+			}
+		}
+		out.Start(points[0])
+		for _, p := range points[1:] {
+			out.Line(p)
+		}
+		out.Stop(true)
+	}
+	circle := func(c f32.Point) {
+		out.addCircle(c, r)
+	}
+	normal := func(a, b f32.Point) f32.Point {
+		d := b.Sub(a)
+		l := math.Hypot(float64(d.X), float64(d.Y))
+		return f32.Pt(float32(-float64(d.Y)*r/l), float32(float64(d.X)*r/l))
+	}
+	lineCap := join.TrailLineCap
+	if lineCap == NilCap {
+		lineCap = join.LeadLineCap
+	}
+
+	for _, line := range p.polylines(tolerance) {
+		points := line.points
+		if len(points) == 1 {
+			if line.closed || lineCap == RoundCap {
+				circle(points[0])
+			} else if lineCap == SquareCap {
+				c, d := points[0], float32(r)
+				polygon(f32.Pt(c.X-d, c.Y-d), f32.Pt(c.X+d, c.Y-d), f32.Pt(c.X+d, c.Y+d), f32.Pt(c.X-d, c.Y+d))
+			}
+			continue
+		}
+
+		segments := len(points) - 1
+		if line.closed {
+			segments++
+		}
+		for i := 0; i < segments; i++ {
+			a, b := points[i], points[(i+1)%len(points)]
+			n := normal(a, b)
+			polygon(a.Add(n), b.Add(n), b.Sub(n), a.Sub(n))
+		}
+
+		// The joins are between the segment i-1 and the segment i.
+		first, last := 1, len(points)-1
+		if line.closed {
+			first, last = 0, len(points)
+		}
+		for i := first; i < last; i++ {
+			v := points[i]
+			prev, next := points[(i+len(points)-1)%len(points)], points[(i+1)%len(points)]
+			n0, n1 := normal(prev, v), normal(v, next)
+			// The outer side of the join is the opposite of the turn.
+			d0, d1 := v.Sub(prev), next.Sub(v)
+			if float64(d0.X)*float64(d1.Y)-float64(d0.Y)*float64(d1.X) > 0 {
+				n0, n1 = n0.Mul(-1), n1.Mul(-1)
+			}
+
+			switch join.LineJoin {
+			case Round, ArcClip, Arc:
+				circle(v)
+			case Bevel:
+				polygon(v, v.Add(n0), v.Add(n1))
+			default:
+				u := n0.Add(n1)
+				uu := float64(u.X)*float64(u.X) + float64(u.Y)*float64(u.Y)
+				if uu == 0 || 2*r/math.Sqrt(uu) > float64(join.MiterLimit) {
+					polygon(v, v.Add(n0), v.Add(n1))
+					continue
+				}
+				polygon(v, v.Add(n0), v.Add(u.Mul(float32(2*r*r/uu))), v.Add(n1))
+			}
+		}
+
+		if line.closed {
+			continue
+		}
+		for _, end := range [][2]f32.Point{{points[0], points[1]}, {points[len(points)-1], points[len(points)-2]}} {
+			switch lineCap {
+			case RoundCap:
+				circle(end[0])
+			case SquareCap:
+				n := normal(end[1], end[0])
+				d := f32.Pt(n.Y, -n.X)
+				if dot := float64(d.X)*float64(end[0].X-end[1].X) + float64(d.Y)*float64(end[0].Y-end[1].Y); dot < 0 {
+					d = d.Mul(-1)
+				}
+				polygon(end[0].Add(n), end[0].Add(n).Add(d), end[0].Sub(n).Add(d), end[0].Sub(n))
+			}
+		}
+	}
+	return out
+}
+
+// addCircle adds the circle, using four cubic bezier curves, with
+// a positive orientation.
+func (p *Path) addCircle(c f32.Point, r float64) {
+	k := float32(r * circleKappa)
+	rr := float32(r)
+	p.Start(f32.Pt(c.X+rr, c.Y))
+	p.CubeBezier(f32.Pt(c.X+rr, c.Y+k), f32.Pt(c.X+k, c.Y+rr), f32.Pt(c.X, c.Y+rr))
+	p.CubeBezier(f32.Pt(c.X-k, c.Y+rr), f32.Pt(c.X-rr, c.Y+k), f32.Pt(c.X-rr, c.Y))
+	p.CubeBezier(f32.Pt(c.X-rr, c.Y-k), f32.Pt(c.X-k, c.Y-rr), f32.Pt(c.X, c.Y-rr))
+	p.CubeBezier(f32.Pt(c.X+k, c.Y-rr), f32.Pt(c.X+rr, c.Y-k), f32.Pt(c.X+rr, c.Y))
+	p.Stop(true)
+}
+
+// NonZero returns the path which has the same area, filled using the
+// non-zero winding rule, as the path filled using the even-odd rule. The
+// sub-paths are oriented by the number of sub-paths around them, which
+// is exact if the sub-paths don't intersect.
+func (p Path) NonZero(tolerance float64) Path {
+	type subPath struct {
+		ops     Path
+		polygon []f32.Point
+	}
+	var subs []subPath
+	for _, op := range p {
+		if _, ok := op.(OpMoveTo); ok || len(subs) == 0 {
+			subs = append(subs, subPath{})
+		}
+		subs[len(subs)-1].ops = append(subs[len(subs)-1].ops, op)
+	}
+	for i := range subs {
+		for _, line := range subs[i].ops.polylines(tolerance) {
+			subs[i].polygon = append(subs[i].polygon, line.points...)
+		}
+	}
+
+	var out Path
+	for i, sub := range subs {
+		if len(sub.polygon) < 3 {
+			out = append(out, sub.ops...)
+			continue
+		}
+		depth := 0
+		for j, other := range subs {
+			if j != i && len(other.polygon) >= 3 && polygonContains(other.polygon, sub.polygon[0]) {
+				depth++
+			}
+		}
+		positive := polygonArea(sub.polygon) >= 0
+		if positive == (depth%2 == 0) {
+			out = append(out, sub.ops...)
+		} else {
+			out = append(out, sub.ops.reverse()...)
+		}
+	}
+	return out
+}
+
+// Reverse returns the path in the opposite direction: the sub-paths are in
+// the reverse order, and each one is reversed. The path draws the same area,
+// but the winding of each sub-path is inverted.
+func (p Path) Reverse() Path {
+	var (
+		subpaths []Path
+		start    f32.Point
+		closed   = true
+	)
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			start, closed = f32.Point(op), false
+			subpaths = append(subpaths, Path{op})
+			continue
+		case OpClose:
+			if closed {
+				continue
+			}
+			subpaths[len(subpaths)-1] = append(subpaths[len(subpaths)-1], op)
+			closed = true
+			continue
+		}
+		if closed {
+			// The segment after OpClose starts a new sub-path at the start.
+			subpaths = append(subpaths, Path{OpMoveTo(start)})
+			closed = false
+		}
+		subpaths[len(subpaths)-1] = append(subpaths[len(subpaths)-1], op)
+	}
+
+	out := make(Path, 0, len(p))
+	for i := len(subpaths) - 1; i >= 0; i-- {
+		out = append(out, subpaths[i].reverse()...)
+	}
+	return out
+}
+
+// reverse returns the sub-path in the opposite direction. The path must
+// start with OpMoveTo, and have no other OpMoveTo.
+func (p Path) reverse() Path {
+	if len(p) == 0 {
+		return nil
+	}
+	var points []f32.Point // points holds the end of each operation
+	closed := false
+	for _, op := range p {
+		switch op := op.(type) {
+		case OpMoveTo:
+			points = append(points, f32.Point(op))
+		case OpLineTo:
+			points = append(points, f32.Point(op))
+		case OpQuadTo:
+			points = append(points, op[1])
+		case OpCubicTo:
+			points = append(points, op[2])
+		case OpClose:
+			closed = true
+		}
+	}
+
+	out := Path{OpMoveTo(points[len(points)-1])}
+	ops := make(Path, 0, len(p))
+	for _, op := range p {
+		if _, ok := op.(OpClose); !ok {
+			ops = append(ops, op)
+		}
+	}
+	for i := len(ops) - 1; i > 0; i-- {
+		start := points[i-1]
+		switch op := ops[i].(type) {
+		case OpLineTo:
+			out = append(out, OpLineTo(start))
+		case OpQuadTo:
+			out = append(out, OpQuadTo{op[0], start})
+		case OpCubicTo:
+			out = append(out, OpCubicTo{op[1], op[0], start})
+		}
+	}
+	if closed {
+		out = append(out, OpClose{})
+	}
+	return out
+}
+
+// polygonArea returns the signed area of the polygon, multiplied by two.
+func polygonArea(points []f32.Point) (area float64) {
+	for i, a := range points {
+		b := points[(i+1)%len(points)]
+		area += float64(a.X)*float64(b.Y) - float64(b.X)*float64(a.Y)
+	}
+	return area
+}
+
+// polygonContains reports whether the point is inside of the polygon,
+// using the even-odd rule.
+func polygonContains(points []f32.Point, p f32.Point) bool {
+	inside := false
+	for i, a := range points {
+		b := points[(i+1)%len(points)]
+		if (a.Y > p.Y) != (b.Y > p.Y) && float64(p.X) < float64(b.X-a.X)*float64(p.Y-a.Y)/float64(b.Y-a.Y)+float64(a.X) {
+			inside = !inside
+		}
+	}
+	return inside
+}
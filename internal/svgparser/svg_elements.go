@@ -4,6 +4,7 @@ import (
 	"errors"
 	"gioui.org/f32"
 	"github.com/inkeliz/giosvg/internal/svgparser/simplexml"
+	"image/color"
 	"strings"
 )
 
@@ -11,6 +12,10 @@ func init() {
 	// avoids cyclical static declaration
 	// called on package initialization
 	drawFuncs["use"] = useF
+	for name, kind := range animationElements {
+		drawFuncs[name] = animationF(kind)
+	}
+	drawFuncs["mpath"] = mpathF
 }
 
 type svgFunc func(c *iconCursor, attrs []simplexml.Attr) error
@@ -18,6 +23,7 @@ type svgFunc func(c *iconCursor, attrs []simplexml.Attr) error
 var drawFuncs = map[string]svgFunc{
 	"svg":            svgF,
 	"g":              gF,
+	"a":              gF, // a is a group, the link is kept by the iconCursor
 	"line":           lineF,
 	"stop":           stopF,
 	"rect":           rectF,
@@ -29,6 +35,7 @@ var drawFuncs = map[string]svgFunc{
 	"desc":           descF,
 	"defs":           defsF,
 	"title":          titleF,
+	"style":          styleF,
 	"linearGradient": linearGradientF,
 	"radialGradient": radialGradientF,
 }
@@ -39,6 +46,7 @@ func svgF(c *iconCursor, attrs []simplexml.Attr) error {
 	c.icon.ViewBox.W = 0
 	c.icon.ViewBox.H = 0
 	var width, height float64
+	var widthPerc, heightPerc bool
 	var err error
 	for _, attr := range attrs {
 		switch attr.Name.Local {
@@ -52,22 +60,41 @@ func svgF(c *iconCursor, attrs []simplexml.Attr) error {
 			c.icon.ViewBox.W = c.points[2]
 			c.icon.ViewBox.H = c.points[3]
 		case "width":
-			width, err = parseBasicFloat(attr.Value)
+			width, widthPerc, err = parseUnit(attr.Value)
 		case "height":
-			height, err = parseBasicFloat(attr.Value)
+			height, heightPerc, err = parseUnit(attr.Value)
 		}
 		if err != nil {
 			return err
 		}
 	}
-	if c.icon.ViewBox.W == 0 {
-		c.icon.ViewBox.W = width
+	if c.icon.ViewBox.W > 0 && c.icon.ViewBox.H > 0 {
+		return nil
+	}
+	// The percentages are relative to the container, which is unknown, so
+	// the viewBox is inferred from the content, see inferViewBox.
+	if width > 0 && height > 0 && !widthPerc && !heightPerc {
+		c.icon.ViewBox = Bounds{W: width, H: height}
+	} else {
+		c.icon.ViewBox = Bounds{}
+	}
+	return nil
+}
+
+// inferViewBox sets the viewBox to the area drawn, if the root <svg> has
+// no viewBox and no absolute width and height.
+func (s *SVGRender) inferViewBox() error {
+	if s.ViewBox.W > 0 && s.ViewBox.H > 0 {
+		return nil
 	}
-	if c.icon.ViewBox.H == 0 {
-		c.icon.ViewBox.H = height
+	b := s.TightBounds(DrawOptions{Transform: Identity})
+	if b.Dx() <= 0 || b.Dy() <= 0 {
+		return errors.New("the size is unknown: the svg has no viewBox, width and height, and nothing is drawn")
 	}
+	s.ViewBox = Bounds{X: float64(b.Min.X), Y: float64(b.Min.Y), W: float64(b.Dx()), H: float64(b.Dy())}
 	return nil
 }
+
 func gF(*iconCursor, []simplexml.Attr) error { return nil } // g does nothing but push the style
 func rectF(c *iconCursor, attrs []simplexml.Attr) error {
 	var x, y, w, h, rx, ry float64
@@ -192,7 +219,11 @@ func pathF(c *iconCursor, attrs []simplexml.Attr) error {
 	for _, attr := range attrs {
 		switch attr.Name.Local {
 		case "d":
-			err = c.compilePath(attr.Value)
+			// The path is drawn up to the error, as required by the
+			// SVG specification, unless the errorMode is strict.
+			if err = c.compilePath(attr.Value); err != nil {
+				err = c.handleError("invalid path data %q: %v", attr.Value, err)
+			}
 		}
 		if err != nil {
 			return err
@@ -277,7 +308,7 @@ func radialGradientF(c *iconCursor, attrs []simplexml.Attr) error {
 	c.grad = &Gradient{Bounds: c.icon.ViewBox, Matrix: Identity}
 	var setFx, setFy bool
 	var err error
-	directionStrings := [6]string{"50%", "50%", "50%", "50%", "50%", "50%"} // default values
+	directionStrings := [6]string{"50%", "50%", "50%", "50%", "50%", "0%"} // default values
 	for _, attr := range attrs {
 		switch attr.Name.Local {
 		case "id":
@@ -359,9 +390,9 @@ func stopF(c *iconCursor, attrs []simplexml.Attr) error {
 				stop.Offset, err = readFraction(attr.Value)
 			case "stop-color":
 				//todo: add current color inherit
-				var optColor optionnalColor
-				optColor, err = parseSVGColor(attr.Value)
-				stop.StopColor = optColor.asColor()
+				var pattern Pattern
+				pattern, err = c.readPaint(&c.styleStack[len(c.styleStack)-1], attr.Value, NewPlainColor(0, 0, 0, 0xff))
+				stop.StopColor, stop.Variable = stopColor(pattern)
 			case "stop-opacity":
 				stop.Opacity, err = parseBasicFloat(attr.Value)
 			}
@@ -373,6 +404,29 @@ func stopF(c *iconCursor, attrs []simplexml.Attr) error {
 	}
 	return nil
 }
+
+// stopColor returns the color of the stop-color, read by readPaint, and the
+// name of its variable. The nested fallbacks are resolved to their color.
+func stopColor(p Pattern) (c color.Color, variable string) {
+	if v, ok := p.(Variable); ok {
+		variable = v.Name
+	}
+	for {
+		v, ok := p.(Variable)
+		if !ok {
+			break
+		}
+		p = v.Fallback
+	}
+	switch p := p.(type) {
+	case nil:
+		return nil, variable
+	case PlainColor:
+		return p, variable
+	}
+	return NewPlainColor(0, 0, 0, 0xff), variable
+}
+
 func useF(c *iconCursor, attrs []simplexml.Attr) error {
 	var (
 		href string
@@ -412,7 +466,11 @@ func useF(c *iconCursor, attrs []simplexml.Attr) error {
 			c.styleStack = c.styleStack[:len(c.styleStack)-1]
 			continue
 		}
-		if err = c.pushStyle(def.Attrs); err != nil {
+		if _, ok := animationElements[def.Tag]; ok {
+			// Animations are not supported inside <use>.
+			continue
+		}
+		if err = c.pushStyle(def.Tag, c.declarations(def.Tag, def.Attrs)); err != nil {
 			return err
 		}
 		df, ok := drawFuncs[def.Tag]
@@ -423,6 +481,8 @@ func useF(c *iconCursor, attrs []simplexml.Attr) error {
 		if err := df(c, def.Attrs); err != nil {
 			return err
 		}
+		// Each element uses its own style, not the style of <use>.
+		c.addPath()
 		if def.Tag != "g" {
 			// pop style
 			c.styleStack = c.styleStack[:len(c.styleStack)-1]
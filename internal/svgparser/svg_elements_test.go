@@ -0,0 +1,39 @@
+package svgparser
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUse(t *testing.T) {
+	icon := readAnimatedIcon(t, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<defs>
+			<path id="arrow" d="M0 -8 L12 0 L0 8 Z" fill="blue"/>
+			<g id="pair">
+				<circle cx="0" cy="0" r="6" fill="red"/>
+				<circle cx="14" cy="0" r="6" fill="lime"/>
+			</g>
+		</defs>
+		<use href="#arrow" x="10" y="20"/>
+		<use href="#pair" x="20" y="55"/>
+	</svg>`)
+
+	// Each element of the <use> is one path, with its own style.
+	expected := []PlainColor{
+		NewPlainColor(0, 0, 0xff, 0xff),
+		NewPlainColor(0xff, 0, 0, 0xff),
+		NewPlainColor(0, 0xff, 0, 0xff),
+	}
+	if len(icon.SVGPaths) != len(expected) {
+		t.Fatalf("expected %d paths, got %d", len(expected), len(icon.SVGPaths))
+	}
+	for i, fill := range expected {
+		if c := icon.SVGPaths[i].Style.FillerColor; c != fill {
+			t.Errorf("path %d: expected fill %v, got %v", i, fill, c)
+		}
+	}
+	r := icon.PathBounds(2, DrawOptions{Transform: Identity})
+	if c := r.Min.Add(r.Max).Mul(0.5); math.Abs(float64(c.X-34)) > 0.1 || math.Abs(float64(c.Y-55)) > 0.1 {
+		t.Errorf("expected the circle centered at the offset of the <use>, got %v", r)
+	}
+}
@@ -1,8 +1,11 @@
 package svgparser
 
 import (
+	"bytes"
 	"github.com/inkeliz/giosvg/internal/svgparser/simplexml"
 	"io"
+	"strings"
+	"time"
 )
 
 // PathStyle holds the state of the SVG style
@@ -16,12 +19,27 @@ type PathStyle struct {
 	FillerColor, LinerColor Pattern // either PlainColor or Gradient
 
 	Transform Matrix2D // current transform
+	Cursor    string   // CSS cursor, such as "pointer"
+
+	variables map[string]string // custom properties, such as `--primary: red`
 }
 
 // SvgPath binds a style to a path
 type SvgPath struct {
 	Path  Path
 	Style PathStyle
+
+	ID      string   // ID of the element, if any
+	Parents []string // Parents holds the IDs of the ancestors, the closest first
+	Link    *Link    // Link is the closest <a> ancestor, if any
+	Title   string   // Title is the <title> of the element, or of the closest ancestor
+}
+
+// Link is a hyperlink defined by the <a> element. All
+// SvgPath inside the same <a> share the same *Link.
+type Link struct {
+	Href  string
+	Title string // Title is the `title` or `aria-label` attribute, if any
 }
 
 // Bounds defines a bounding box, such as a viewport
@@ -35,8 +53,13 @@ type SVGRender struct {
 	Titles       []string // Title elements collect here
 	Descriptions []string // Description elements collect here
 	SVGPaths     []SvgPath
+	Links        []*Link     // Links holds all <a> elements, in the document order
+	Animations   []Animation // Animations holds the SMIL animations, see Animate
 	Transform    Matrix2D
 
+	animationEnd time.Duration
+	begins       beginTimeline
+
 	grads map[string]*Gradient
 	defs  map[string][]definition
 }
@@ -47,8 +70,12 @@ type SVGRender struct {
 // if it does not handle an element found in the icon file.
 func ReadIcon(stream io.Reader) (*SVGRender, error) {
 	icon := &SVGRender{defs: make(map[string][]definition), grads: make(map[string]*Gradient), Transform: Identity}
-	cursor := &iconCursor{styleStack: []PathStyle{DefaultStyle}, icon: icon}
-	decoder := simplexml.NewDecoder(stream)
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return icon, err
+	}
+	cursor := &iconCursor{styleStack: []PathStyle{DefaultStyle}, icon: icon, styleSheet: readStyleSheet(data)}
+	decoder := simplexml.NewDecoder(bytes.NewReader(data))
 	for {
 		t, err := decoder.Token()
 		if err != nil {
@@ -62,16 +89,22 @@ func ReadIcon(stream io.Reader) (*SVGRender, error) {
 		case simplexml.StartElement:
 			// Reads all recognized style attributes from the start element
 			// and places it on top of the styleStack
-			err = cursor.pushStyle(se.Attr)
-			if err != nil {
+			var declarations []simplexml.Attr
+			if _, ok := animationElements[se.Name.Local]; !ok {
+				// Animations have attributes such as `fill="freeze"`, which aren't styles.
+				declarations = cursor.declarations(se.Name.Local, se.Attr)
+			}
+			if err = cursor.pushStyle(se.Name.Local, declarations); err != nil {
 				return icon, err
 			}
+			cursor.pushElement(se, declarations)
 			err = cursor.readStartElement(se)
 			if err != nil {
 				return icon, err
 			}
 		case simplexml.EndElement:
 			// pop style
+			cursor.popElement()
 			cursor.styleStack = cursor.styleStack[:len(cursor.styleStack)-1]
 			switch se.Name.Local {
 			case "g":
@@ -81,6 +114,9 @@ func ReadIcon(stream io.Reader) (*SVGRender, error) {
 					})
 				}
 			case "title":
+				if cursor.inTitleText {
+					cursor.setTitle(strings.TrimSpace(icon.Titles[len(icon.Titles)-1]))
+				}
 				cursor.inTitleText = false
 			case "desc":
 				cursor.inDescText = false
@@ -93,7 +129,15 @@ func ReadIcon(stream io.Reader) (*SVGRender, error) {
 			case "radialGradient", "linearGradient":
 				cursor.inGrad = false
 			}
+		case simplexml.CharData:
+			if cursor.inTitleText {
+				icon.Titles[len(icon.Titles)-1] += string(se)
+			}
+			if cursor.inDescText {
+				icon.Descriptions[len(icon.Descriptions)-1] += string(se)
+			}
 		}
 	}
-	return icon, nil
+	cursor.resolveAnimations()
+	return icon, icon.inferViewBox()
 }
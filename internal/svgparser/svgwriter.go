@@ -0,0 +1,606 @@
+package svgparser
+
+import (
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"gioui.org/f32"
+)
+
+// SVGOptions controls how WriteSVG serializes the SVGRender.
+type SVGOptions struct {
+	// Precision is the number of decimals of the coordinates, such as 3.
+	// The transforms, and the gradients of the objectBoundingBox, use 3
+	// more decimals, since they are multiplied by the coordinates.
+	Precision int
+
+	// Relative uses the relative commands of the path data, such as `l`,
+	// whenever they are shorter than the absolute ones.
+	Relative bool
+
+	// Merge removes the paths which draw nothing, and joins the adjacent
+	// paths with the same style, if they don't overlap.
+	Merge bool
+}
+
+// WriteSVG writes the SVGRender as SVG. Each SvgPath is one <path>, the groups
+// are flattened and the style is written as attributes, except the values equal
+// to the DefaultStyle, and the non-standard stroke-leadlinecap and stroke-linegap.
+// The gradients are written once, in the <defs>, and the
+// animations are not written.
+func (s *SVGRender) WriteSVG(w io.Writer, options SVGOptions) error {
+	if options.Precision < 0 {
+		options.Precision = 0
+	}
+	sw := &svgWriter{options: options, gradients: make(map[string]string), ids: make(map[string]struct{})}
+	for _, svgp := range s.SVGPaths {
+		if svgp.ID != "" {
+			sw.ids[svgp.ID] = struct{}{}
+		}
+	}
+
+	var body strings.Builder
+	var link *Link
+	paths := s.SVGPaths
+	if options.Merge {
+		paths = sw.merge(paths)
+	}
+	for _, svgp := range paths {
+		if svgp.Link != link {
+			if link != nil {
+				body.WriteString("</a>")
+			}
+			if link = svgp.Link; link != nil {
+				fmt.Fprintf(&body, `<a href="%s"`, escapeAttr(link.Href))
+				if link.Title != "" {
+					fmt.Fprintf(&body, ` title="%s"`, escapeAttr(link.Title))
+				}
+				body.WriteString(">")
+			}
+		}
+		body.WriteString("<path")
+		if svgp.ID != "" {
+			fmt.Fprintf(&body, ` id="%s"`, escapeAttr(svgp.ID))
+		}
+		fmt.Fprintf(&body, ` d="%s"%s`, sw.pathData(svgp.Path), sw.attributes(svgp.Style))
+		if svgp.Title != "" {
+			fmt.Fprintf(&body, "><title>%s</title></path>", escapeAttr(svgp.Title))
+		} else {
+			body.WriteString("/>")
+		}
+	}
+	if link != nil {
+		body.WriteString("</a>")
+	}
+
+	var out strings.Builder
+	out.WriteString(`<svg xmlns="http://www.w3.org/2000/svg"`)
+	if s.ViewBox.W > 0 && s.ViewBox.H > 0 {
+		fmt.Fprintf(&out, ` viewBox="%s %s %s %s"`, sw.number(s.ViewBox.X, 0), sw.number(s.ViewBox.Y, 0),
+			sw.number(s.ViewBox.W, 0), sw.number(s.ViewBox.H, 0))
+	}
+	out.WriteString(">")
+
+	// The titles of the elements are written with the elements, the
+	// others are the titles of the document.
+	titles := make(map[string]struct{})
+	for _, svgp := range s.SVGPaths {
+		titles[svgp.Title] = struct{}{}
+	}
+	for _, title := range s.Titles {
+		if _, ok := titles[title]; !ok && title != "" {
+			fmt.Fprintf(&out, "<title>%s</title>", escapeAttr(title))
+		}
+	}
+	for _, desc := range s.Descriptions {
+		if desc != "" {
+			fmt.Fprintf(&out, "<desc>%s</desc>", escapeAttr(desc))
+		}
+	}
+	if sw.defs.Len() > 0 {
+		fmt.Fprintf(&out, "<defs>%s</defs>", sw.defs.String())
+	}
+	out.WriteString(body.String())
+	out.WriteString("</svg>")
+
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// svgWriter holds the state of WriteSVG.
+type svgWriter struct {
+	options   SVGOptions
+	defs      strings.Builder
+	gradients map[string]string   // gradients maps the <linearGradient> or <radialGradient>, without ID, to the ID
+	ids       map[string]struct{} // ids holds the IDs of the paths, which the gradients can't use
+}
+
+// merge returns the paths without those which draw nothing, unless they
+// have an ID or a Link, and joins the adjacent paths with the same style.
+// The paths are joined only if their bounds don't overlap, so the fill-rule
+// and the opacity give the same result.
+func (sw *svgWriter) merge(paths []SvgPath) []SvgPath {
+	var (
+		out    []SvgPath
+		attrs  string
+		bounds []f32.Rectangle // bounds of each path joined into the last path
+	)
+	for _, svgp := range paths {
+		style := svgp.Style
+		if style.FillOpacity <= 0 || isTransparent(style.FillerColor) {
+			style.FillerColor = nil
+		}
+		if style.LineOpacity <= 0 || style.LineWidth <= 0 || isTransparent(style.LinerColor) {
+			style.LinerColor = nil
+		}
+		svgp.Style = style
+
+		drawn := false
+		for _, op := range svgp.Path {
+			if _, ok := op.(OpMoveTo); !ok {
+				drawn = true
+			}
+		}
+		named := svgp.ID != "" || svgp.Link != nil
+		if !named && (!drawn || (style.FillerColor == nil && style.LinerColor == nil)) {
+			continue
+		}
+
+		// The gradients of the objectBoundingBox depend on the bounds of
+		// the path, they are never joined.
+		b := svgp.Path.drawnBounds(Identity, &style, style.FillerColor, style.LinerColor)
+		a := sw.attributes(style)
+		if len(out) > 0 && !named && !usesGradient(style) && a == attrs {
+			last := &out[len(out)-1]
+			overlap := false
+			for _, r := range bounds {
+				if r.Min.X < b.Max.X && b.Min.X < r.Max.X && r.Min.Y < b.Max.Y && b.Min.Y < r.Max.Y {
+					overlap = true
+					break
+				}
+			}
+			if !overlap && last.ID == "" && last.Link == nil && last.Title == svgp.Title {
+				last.Path = append(last.Path[:len(last.Path):len(last.Path)], svgp.Path...)
+				bounds = append(bounds, b)
+				continue
+			}
+		}
+		out = append(out, svgp)
+		attrs, bounds = a, []f32.Rectangle{b}
+	}
+	return out
+}
+
+// isTransparent reports if the pattern is a PlainColor without alpha.
+func isTransparent(p Pattern) bool {
+	c, ok := p.(PlainColor)
+	return ok && c.A == 0
+}
+
+// usesGradient reports if the fill or the stroke is a Gradient, or
+// a Variable which falls back to a Gradient.
+func usesGradient(style PathStyle) bool {
+	for _, p := range [...]Pattern{style.FillerColor, style.LinerColor} {
+		for {
+			v, ok := p.(Variable)
+			if !ok {
+				break
+			}
+			p = v.Fallback
+		}
+		if _, ok := p.(Gradient); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// attributes returns the attributes of the style, each one prefixed
+// by a space.
+func (sw *svgWriter) attributes(style PathStyle) string {
+	var s strings.Builder
+	attr := func(name, value string) {
+		fmt.Fprintf(&s, ` %s="%s"`, name, escapeAttr(value))
+	}
+	if style.FillerColor == nil {
+		attr("fill", "none")
+	} else {
+		paint, alpha := sw.paint(style.FillerColor)
+		if paint != "#000" {
+			attr("fill", paint)
+		}
+		if opacity := style.FillOpacity * alpha; opacity != 1 {
+			attr("fill-opacity", sw.number(opacity, 3))
+		}
+		if !style.UseNonZeroWinding {
+			attr("fill-rule", "evenodd")
+		}
+	}
+
+	if style.LinerColor != nil {
+		paint, alpha := sw.paint(style.LinerColor)
+		attr("stroke", paint)
+		if opacity := style.LineOpacity * alpha; opacity != 1 {
+			attr("stroke-opacity", sw.number(opacity, 3))
+		}
+		if style.LineWidth != DefaultStyle.LineWidth {
+			attr("stroke-width", sw.number(style.LineWidth, 0))
+		}
+		if v := capName(style.Join.TrailLineCap); v != "" && style.Join.TrailLineCap != DefaultStyle.Join.TrailLineCap {
+			attr("stroke-linecap", v)
+		}
+		// The join is omitted only if it's the default of both SVG, which is
+		// miter, and the DefaultStyle, so other renderers draw the same joins.
+		// The lead cap and the gap aren't written, since they aren't SVG.
+		if v := joinName(style.Join.LineJoin); v != "" && (style.Join.LineJoin != Miter || DefaultStyle.Join.LineJoin != Miter) {
+			attr("stroke-linejoin", v)
+		}
+		if style.Join.MiterLimit != DefaultStyle.Join.MiterLimit {
+			attr("stroke-miterlimit", sw.number(float64(style.Join.MiterLimit), 0))
+		}
+		if dash := style.Dash.pattern(); dash != nil {
+			values := make([]string, len(style.Dash.Dash))
+			for i, v := range style.Dash.Dash {
+				values[i] = sw.number(v, 0)
+			}
+			attr("stroke-dasharray", strings.Join(values, " "))
+			if style.Dash.DashOffset != 0 {
+				attr("stroke-dashoffset", sw.number(style.Dash.DashOffset, 0))
+			}
+		}
+	}
+
+	if style.Transform != Identity {
+		attr("transform", sw.transform(style.Transform))
+	}
+	if style.Cursor != "" {
+		attr("cursor", style.Cursor)
+	}
+	return s.String()
+}
+
+// paint returns the value of the `fill` or the `stroke`, and the alpha
+// of the color, which is written as the opacity.
+func (sw *svgWriter) paint(p Pattern) (string, float64) {
+	switch p := p.(type) {
+	case PlainColor:
+		return formatColor(p.NRGBA), float64(p.A) / 0xff
+	case CurrentColor:
+		return "currentColor", 1
+	case Variable:
+		if p.Fallback == nil {
+			return "var(--" + p.Name + ", none)", 1
+		}
+		fallback, _ := sw.paint(p.Fallback)
+		return "var(--" + p.Name + ", " + fallback + ")", 1
+	case Gradient:
+		return "url(#" + sw.gradient(p) + ")", 1
+	}
+	return "none", 1
+}
+
+// gradient adds the gradient to the <defs>, unless an equal gradient was
+// added before, and returns its ID.
+func (sw *svgWriter) gradient(g Gradient) string {
+	// The values of the objectBoundingBox are fractions of the bounds.
+	extra := 0
+	if g.Units == ObjectBoundingBox {
+		extra = 3
+	}
+
+	var s strings.Builder
+	attr := func(name, value string) {
+		fmt.Fprintf(&s, ` %s="%s"`, name, escapeAttr(value))
+	}
+	tag := "linearGradient"
+	switch d := g.Direction.(type) {
+	case Linear:
+		for i, name := range [...]string{"x1", "y1", "x2", "y2"} {
+			if g.Units == ObjectBoundingBox && d[i] == [...]float64{0, 0, 1, 0}[i] {
+				continue
+			}
+			attr(name, sw.number(d[i], extra))
+		}
+	case Radial:
+		tag = "radialGradient"
+		for i, name := range [...]string{"cx", "cy", "fx", "fy", "r", "fr"} {
+			switch {
+			case g.Units == ObjectBoundingBox && (i < 2 || i == 4) && d[i] == 0.5:
+			case i == 2 && d[2] == d[0], i == 3 && d[3] == d[1], i == 5 && d[5] == 0:
+			default:
+				attr(name, sw.number(d[i], extra))
+			}
+		}
+	}
+	if g.Units == UserSpaceOnUse {
+		attr("gradientUnits", "userSpaceOnUse")
+	}
+	if g.Matrix != Identity {
+		attr("gradientTransform", sw.transform(g.Matrix))
+	}
+	switch g.Spread {
+	case ReflectSpread:
+		attr("spreadMethod", "reflect")
+	case RepeatSpread:
+		attr("spreadMethod", "repeat")
+	}
+	s.WriteString(">")
+	for _, stop := range g.Stops {
+		s.WriteString("<stop")
+		attr("offset", sw.number(stop.Offset, 3))
+		opacity := stop.Opacity
+		if stop.StopColor != nil {
+			c := toNRGBA(stop.StopColor)
+			value := formatColor(c)
+			if stop.Variable != "" {
+				value = "var(--" + stop.Variable + ", " + value + ")"
+			}
+			attr("stop-color", value)
+			opacity *= float64(c.A) / 0xff
+		}
+		if opacity != 1 {
+			attr("stop-opacity", sw.number(opacity, 3))
+		}
+		s.WriteString("/>")
+	}
+
+	key := tag + s.String()
+	if id, ok := sw.gradients[key]; ok {
+		return id
+	}
+	id := ""
+	for i := len(sw.gradients); ; i++ {
+		id = "g" + strconv.Itoa(i)
+		if _, ok := sw.ids[id]; !ok {
+			break
+		}
+	}
+	sw.gradients[key] = id
+	fmt.Fprintf(&sw.defs, `<%s id="%s"%s</%s>`, tag, id, s.String(), tag)
+	return id
+}
+
+// transform returns the value of the `transform` attribute.
+func (sw *svgWriter) transform(m Matrix2D) string {
+	e, f := sw.number(m.E, 0), sw.number(m.F, 0)
+	if m.B == 0 && m.C == 0 {
+		switch {
+		case m.A == 1 && m.D == 1 && m.F == 0:
+			return "translate(" + e + ")"
+		case m.A == 1 && m.D == 1:
+			return "translate(" + e + " " + f + ")"
+		case m.E == 0 && m.F == 0 && m.A == m.D:
+			return "scale(" + sw.number(m.A, 3) + ")"
+		case m.E == 0 && m.F == 0:
+			return "scale(" + sw.number(m.A, 3) + " " + sw.number(m.D, 3) + ")"
+		}
+	}
+	return "matrix(" + sw.number(m.A, 3) + " " + sw.number(m.B, 3) + " " + sw.number(m.C, 3) + " " +
+		sw.number(m.D, 3) + " " + e + " " + f + ")"
+}
+
+// number returns the value with the Precision plus the extra decimals,
+// without the leading zero, such as ".5".
+func (sw *svgWriter) number(v float64, extra int) string {
+	return formatNumber(v, sw.options.Precision+extra)
+}
+
+// pathData returns the path data, using the shortest of the absolute
+// and the relative commands, if Relative is set.
+func (sw *svgWriter) pathData(p Path) string {
+	e := pathEncoder{precision: sw.options.Precision, relative: sw.options.Relative}
+	for _, op := range p {
+		e.operation(op)
+	}
+	return e.out.String()
+}
+
+// pathEncoder writes the path data. The current point, the start of the
+// sub-path and the control point are rounded, as read by the parser, so
+// the error of the relative commands doesn't accumulate.
+type pathEncoder struct {
+	precision int
+	relative  bool
+
+	out     strings.Builder
+	last    byte // last is the last command written
+	number  bool // number is true if the last token is a number
+	dot     bool // dot is true if the last number has a decimal point
+	current [2]float64
+	start   [2]float64
+	control [2]float64 // control is the last control point of the curve
+	curve   byte       // curve is 'Q' or 'C' if the last command is a curve
+}
+
+func (e *pathEncoder) operation(op Operation) {
+	switch op := op.(type) {
+	case OpMoveTo:
+		p := e.point(f32.Point(op))
+		e.command('M', p[0], p[1])
+		e.start, e.curve = p, 0
+	case OpLineTo:
+		p := e.point(f32.Point(op))
+		switch {
+		case p[1] == e.current[1]:
+			e.command('H', p[0])
+		case p[0] == e.current[0]:
+			e.command('V', p[1])
+		default:
+			e.command('L', p[0], p[1])
+		}
+		e.curve = 0
+	case OpQuadTo:
+		c, p := e.point(op[0]), e.point(op[1])
+		if e.reflected('Q', c) {
+			e.command('T', p[0], p[1])
+		} else {
+			e.command('Q', c[0], c[1], p[0], p[1])
+		}
+		e.control, e.curve = c, 'Q'
+	case OpCubicTo:
+		c1, c2, p := e.point(op[0]), e.point(op[1]), e.point(op[2])
+		if e.reflected('C', c1) {
+			e.command('S', c2[0], c2[1], p[0], p[1])
+		} else {
+			e.command('C', c1[0], c1[1], c2[0], c2[1], p[0], p[1])
+		}
+		e.control, e.curve = c2, 'C'
+	case OpClose:
+		e.command('Z')
+		e.curve = 0
+	}
+}
+
+// reflected reports if the control point is the reflection of the previous
+// control point, which the `S` and `T` commands use.
+func (e *pathEncoder) reflected(curve byte, c [2]float64) bool {
+	r := e.current
+	if e.curve == curve {
+		r = [2]float64{2*e.current[0] - e.control[0], 2*e.current[1] - e.control[1]}
+	}
+	half := 0.5 / math.Pow10(e.precision)
+	return math.Abs(r[0]-c[0]) < half && math.Abs(r[1]-c[1]) < half
+}
+
+func (e *pathEncoder) point(p f32.Point) [2]float64 {
+	return [2]float64{roundTo(float64(p.X), e.precision), roundTo(float64(p.Y), e.precision)}
+}
+
+// command writes the command with the absolute values, or the relative
+// command if shorter, and updates the current point.
+func (e *pathEncoder) command(cmd byte, values ...float64) {
+	s, dot := e.encode(cmd, values)
+	if e.relative && cmd != 'Z' {
+		relative := make([]float64, len(values))
+		for i, v := range values {
+			if cmd == 'V' || cmd != 'H' && i%2 == 1 {
+				relative[i] = v - e.current[1]
+			} else {
+				relative[i] = v - e.current[0]
+			}
+		}
+		if r, rdot := e.encode(cmd+'a'-'A', relative); len(r) < len(s) {
+			s, dot, cmd = r, rdot, cmd+'a'-'A'
+		}
+	}
+	e.out.WriteString(s)
+	e.last, e.number, e.dot = cmd, len(values) > 0, dot
+
+	switch cmd &^ 0x20 { // upper case
+	case 'H':
+		e.current[0] = values[0]
+	case 'V':
+		e.current[1] = values[0]
+	case 'Z':
+		e.current = e.start
+	default:
+		e.current = [2]float64{values[len(values)-2], values[len(values)-1]}
+	}
+}
+
+// encode returns the command and the values, without the command if it
+// repeats the last one, and without the separators which aren't needed.
+// It also reports if the last number has a decimal point.
+func (e *pathEncoder) encode(cmd byte, values []float64) (string, bool) {
+	var s strings.Builder
+	number, dot := e.number, e.dot
+	implicit := cmd == e.last && cmd != 'M' && cmd != 'm' && cmd != 'Z' && cmd != 'z' ||
+		e.last == 'M' && cmd == 'L' || e.last == 'm' && cmd == 'l'
+	if !implicit {
+		s.WriteByte(cmd)
+		number = false
+	}
+	for _, v := range values {
+		n := formatNumber(v, e.precision)
+		if number && n[0] != '-' && !(n[0] == '.' && dot) {
+			s.WriteByte(' ')
+		}
+		s.WriteString(n)
+		number, dot = true, strings.Contains(n, ".")
+	}
+	return s.String(), dot
+}
+
+// roundTo rounds the value to the given number of decimals.
+func roundTo(v float64, decimals int) float64 {
+	p := math.Pow10(decimals)
+	return math.Round(v*p) / p
+}
+
+// formatNumber returns the value with, at most, the given number of
+// decimals, and without the leading zero, such as "-.5".
+func formatNumber(v float64, decimals int) string {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return "0"
+	}
+	s := strconv.FormatFloat(roundTo(v, decimals), 'f', -1, 64)
+	switch {
+	case s == "-0":
+		return "0"
+	case strings.HasPrefix(s, "0."):
+		return s[1:]
+	case strings.HasPrefix(s, "-0."):
+		return "-" + s[2:]
+	}
+	return s
+}
+
+// formatColor returns the color as `#rgb`, if possible, or `#rrggbb`.
+func formatColor(c color.NRGBA) string {
+	if c.R>>4 == c.R&0xf && c.G>>4 == c.G&0xf && c.B>>4 == c.B&0xf {
+		return fmt.Sprintf("#%x%x%x", c.R&0xf, c.G&0xf, c.B&0xf)
+	}
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+func capName(c CapMode) string {
+	switch c {
+	case ButtCap:
+		return "butt"
+	case RoundCap:
+		return "round"
+	case SquareCap:
+		return "square"
+	case CubicCap:
+		return "cubic"
+	case QuadraticCap:
+		return "quadratic"
+	}
+	return ""
+}
+
+func joinName(j JoinMode) string {
+	switch j {
+	case Arc:
+		return "arc"
+	case Round:
+		return "round"
+	case Bevel:
+		return "bevel"
+	case Miter:
+		return "miter"
+	case MiterClip:
+		return "miter-clip"
+	case ArcClip:
+		return "arc-clip"
+	}
+	return ""
+}
+
+func gapName(g GapMode) string {
+	switch g {
+	case FlatGap:
+		return "flat"
+	case RoundGap:
+		return "round"
+	case CubicGap:
+		return "cubic"
+	case QuadraticGap:
+		return "quadratic"
+	}
+	return ""
+}
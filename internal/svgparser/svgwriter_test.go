@@ -0,0 +1,232 @@
+package svgparser
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+func TestPathData(t *testing.T) {
+	for _, c := range []struct {
+		path     string
+		options  SVGOptions
+		expected string
+	}{
+		{"M10 10 L20 10 L20 20 L10 10 Z", SVGOptions{Precision: 3}, "M10 10H20V20L10 10Z"},
+		{"M10 10 L20 10 L20 20 L10 10 Z", SVGOptions{Precision: 3, Relative: true}, "M10 10H20V20L10 10Z"},
+		{"M100 100 l5 5 l5 5", SVGOptions{Precision: 3, Relative: true}, "M100 100l5 5 5 5"},
+		{"M0.5 -0.25 L1.125 0.5", SVGOptions{Precision: 3}, "M.5-.25 1.125.5"},
+		{"M0.12345 0 L0.5 0.5", SVGOptions{Precision: 2}, "M.12 0 .5.5"},
+		{"M0 0 Q10 10 20 0 T40 0", SVGOptions{Precision: 3}, "M0 0Q10 10 20 0T40 0"},
+		{"M0 0 C0 10 10 10 10 0 S20 -10 20 0", SVGOptions{Precision: 3}, "M0 0C0 10 10 10 10 0S20-10 20 0"},
+		{"M0 0 H10 Z M20 0 H30 Z", SVGOptions{Precision: 3}, "M0 0H10ZM20 0H30Z"},
+		{"M50 50 h10 z m10 0 h10 z", SVGOptions{Precision: 3, Relative: true}, "M50 50H60Zm10 0H70Z"},
+	} {
+		icon, err := ReadIcon(strings.NewReader(`<svg viewBox="0 0 100 100"><path d="` + c.path + `"/></svg>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sw := &svgWriter{options: c.options}
+		if got := sw.pathData(icon.SVGPaths[0].Path); got != c.expected {
+			t.Errorf("expected %q for %q, got %q", c.expected, c.path, got)
+		}
+	}
+}
+
+func TestToSVGPath(t *testing.T) {
+	p := Path{OpMoveTo{X: 1.5, Y: 2}, OpLineTo{X: 3}, OpQuadTo{{X: 4}, {X: 5, Y: 6}}, OpCubicTo{{X: 1}, {X: 2}, {X: 3, Y: -0.25}}, OpClose{}}
+	if got, expected := p.ToSVGPath(), "M1.5 2 L3 0 Q4 0 5 6 C1 0 2 0 3 -0.25 Z"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	icon, err := ReadIcon(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<title>Icon</title>
+		<defs>
+			<linearGradient id="g" x1="0" y1="0" x2="1" y2="1" spreadMethod="reflect">
+				<stop offset="0" stop-color="var(--primary, red)"/>
+				<stop offset="1" stop-color="blue" stop-opacity="0.5"/>
+			</linearGradient>
+		</defs>
+		<g fill="#2196F3">
+			<rect x="0" y="0" width="4" height="4"/>
+			<rect x="10" y="0" width="4" height="4"/>
+			<rect x="2" y="2" width="4" height="4"/>
+		</g>
+		<path d="M0 10h10" stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-dasharray="1 2" fill="none"/>
+		<path d="M0 12h10" fill="none" stroke="none"/>
+		<g transform="translate(12 12) rotate(45)">
+			<circle id="dot" r="2" fill="url(#g)" fill-rule="evenodd" opacity="0.5"/>
+		</g>
+		<a href="https://example.com"><rect x="20" y="20" width="2" height="2" fill="var(--accent, green)"><title>Link</title></rect></a>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := icon.WriteSVG(&out, SVGOptions{Precision: 3, Relative: true, Merge: true}); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><title>Icon</title>`,
+		`<linearGradient id="g0" y2="1" spreadMethod="reflect">`,
+		`<stop offset="0" stop-color="var(--primary, #f00)"/><stop offset="1" stop-color="#00f" stop-opacity=".5"/>`,
+		`<path d="M0 0H4V4H0ZM10 0h4V4H10Z" fill="#2196f3"/><path d="M2 2H6V6H2Z" fill="#2196f3"/>`,
+		`stroke="currentColor" stroke-width="2" stroke-linecap="round" stroke-linejoin="bevel" stroke-dasharray="1 2"`,
+		`<path id="dot" d="`,
+		`fill="url(#g0)" fill-opacity=".5" fill-rule="evenodd" transform="matrix(.707107 .707107 -.707107 .707107 12 12)"/>`,
+		`<a href="https://example.com"><path d="M20 20h2v2H20Z" fill="var(--accent, #008000)"><title>Link</title></path></a>`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %s in:\n%s", expected, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "M0 12") {
+		t.Errorf("expected the invisible path to be removed, in:\n%s", out.String())
+	}
+
+	// The paths read from the output are the same, except the merged
+	// and the removed paths.
+	read, err := ReadIcon(bytes.NewReader(out.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read.SVGPaths) != 5 {
+		t.Fatalf("expected 5 paths, got %d in:\n%s", len(read.SVGPaths), out.String())
+	}
+	for _, pair := range [][2]int{{2, 3}, {3, 5}, {4, 6}} {
+		a, b := icon.SVGPaths[pair[1]], read.SVGPaths[pair[0]]
+		if !equalPaths(a.Path, b.Path, 1e-3) {
+			t.Errorf("expected the path %v, got %v", a.Path, b.Path)
+		}
+		if a.ID != b.ID || a.Title != b.Title || (a.Link == nil) != (b.Link == nil) {
+			t.Errorf("expected %q, %q and %v, got %q, %q and %v", a.ID, a.Title, a.Link, b.ID, b.Title, b.Link)
+		}
+		as, bs := a.Style, b.Style
+		if as.LineWidth != bs.LineWidth || as.Join != bs.Join || as.UseNonZeroWinding != bs.UseNonZeroWinding ||
+			!almostEqual(as.FillOpacity, bs.FillOpacity) || as.LinerColor != nil && !almostEqual(as.LineOpacity, bs.LineOpacity) {
+			t.Errorf("expected the style %+v, got %+v", as, bs)
+		}
+		for _, v := range [...][2]float64{{as.Transform.A, bs.Transform.A}, {as.Transform.B, bs.Transform.B}, {as.Transform.E, bs.Transform.E}} {
+			if math.Abs(v[0]-v[1]) > 1e-6 {
+				t.Errorf("expected the transform %v, got %v", as.Transform, bs.Transform)
+			}
+		}
+	}
+	if g, ok := read.SVGPaths[3].Style.FillerColor.(Gradient); !ok || g.Spread != ReflectSpread || len(g.Stops) != 2 || g.Stops[0].Variable != "primary" {
+		t.Errorf("unexpected gradient %+v", read.SVGPaths[3].Style.FillerColor)
+	}
+	if v, ok := read.SVGPaths[4].Style.FillerColor.(Variable); !ok || v.Name != "accent" || v.Fallback != NewPlainColor(0, 0x80, 0, 0xff) {
+		t.Errorf("unexpected variable %+v", read.SVGPaths[4].Style.FillerColor)
+	}
+}
+
+func TestWriteSVGStrokeJoin(t *testing.T) {
+	for _, c := range []struct {
+		attrs    string
+		expected string
+	}{
+		{``, `stroke-linejoin="bevel"`},
+		{`stroke-linejoin="miter"`, `stroke-linejoin="miter"`},
+		{`stroke-linejoin="round"`, `stroke-linejoin="round"`},
+		{`stroke-linejoin="arc" stroke-leadlinecap="round" stroke-linegap="round"`, `stroke-linejoin="arc"`},
+	} {
+		icon, err := ReadIcon(strings.NewReader(`<svg viewBox="0 0 24 24"><path d="M0 0L10 10L20 0" fill="none" stroke="red" ` + c.attrs + `/></svg>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		if err := icon.WriteSVG(&out, SVGOptions{Precision: 3}); err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(out.String(), c.expected) {
+			t.Errorf("expected %s in:\n%s", c.expected, out.String())
+		}
+		if strings.Contains(out.String(), "leadlinecap") || strings.Contains(out.String(), "linegap") {
+			t.Errorf("unexpected non-standard attribute in:\n%s", out.String())
+		}
+
+		read, err := ReadIcon(&out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := read.SVGPaths[0].Style.Join.LineJoin; got != icon.SVGPaths[0].Style.Join.LineJoin {
+			t.Errorf("expected the join %v for %s, got %v", icon.SVGPaths[0].Style.Join.LineJoin, c.attrs, got)
+		}
+	}
+}
+
+func TestWriteSVGMerge(t *testing.T) {
+	// The control points of the curve overlap the first rect, but not the
+	// curve itself. The strokes of the lines overlap, but not the lines.
+	icon, err := ReadIcon(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24">
+		<path d="M0 0C0 10 10 10 10 0Z"/>
+		<rect x="0" y="8" width="10" height="2"/>
+		<path d="M0 20H10" stroke="red" stroke-width="2"/>
+		<path d="M0 21.5H10" stroke="red" stroke-width="2"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := icon.WriteSVG(&out, SVGOptions{Precision: 3, Merge: true}); err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(out.String(), "<path"); n != 3 {
+		t.Errorf("expected the fills merged and the strokes apart, got %d paths in:\n%s", n, out.String())
+	}
+	if !strings.Contains(out.String(), `<path d="M0 0C0 10 10 10 10 0ZM0 8H10V10H0Z"/>`) {
+		t.Errorf("expected the curve and the rect merged, in:\n%s", out.String())
+	}
+}
+
+// equalPaths reports if the paths have the same operations, with
+// the points within the tolerance.
+func equalPaths(a, b Path, tolerance float32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	near := func(p, q []f32.Point) bool {
+		for i := range p {
+			if d := p[i].Sub(q[i]); d.X > tolerance || d.X < -tolerance || d.Y > tolerance || d.Y < -tolerance {
+				return false
+			}
+		}
+		return true
+	}
+	for i := range a {
+		switch op := a[i].(type) {
+		case OpMoveTo:
+			o, ok := b[i].(OpMoveTo)
+			if !ok || !near([]f32.Point{f32.Point(op)}, []f32.Point{f32.Point(o)}) {
+				return false
+			}
+		case OpLineTo:
+			o, ok := b[i].(OpLineTo)
+			if !ok || !near([]f32.Point{f32.Point(op)}, []f32.Point{f32.Point(o)}) {
+				return false
+			}
+		case OpQuadTo:
+			o, ok := b[i].(OpQuadTo)
+			if !ok || !near(op[:], o[:]) {
+				return false
+			}
+		case OpCubicTo:
+			o, ok := b[i].(OpCubicTo)
+			if !ok || !near(op[:], o[:]) {
+				return false
+			}
+		case OpClose:
+			if _, ok := b[i].(OpClose); !ok {
+				return false
+			}
+		}
+	}
+	return true
+}
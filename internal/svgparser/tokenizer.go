@@ -0,0 +1,150 @@
+package svgparser
+
+// this file implements the tokens shared by the path data, the lists of
+// numbers and the transform lists, following the SVG grammar.
+
+import (
+	"math"
+	"strconv"
+)
+
+// tokenizer reads the tokens of an attribute, such as the `d` of <path>.
+type tokenizer struct {
+	s string
+	i int
+}
+
+func (t *tokenizer) done() bool { return t.i >= len(t.s) }
+
+// peek returns the current byte, without consuming it. It must not be
+// called when done.
+func (t *tokenizer) peek() byte { return t.s[t.i] }
+
+// next returns the current byte, and consumes it. It must not be called
+// when done.
+func (t *tokenizer) next() byte {
+	b := t.s[t.i]
+	t.i++
+	return b
+}
+
+// consume consumes the byte, if it's the current byte.
+func (t *tokenizer) consume(b byte) bool {
+	if !t.done() && t.s[t.i] == b {
+		t.i++
+		return true
+	}
+	return false
+}
+
+func isSpace(b byte) bool { return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f' }
+
+func isDigit(b byte) bool { return b >= '0' && b <= '9' }
+
+func (t *tokenizer) skipSpace() {
+	for !t.done() && isSpace(t.s[t.i]) {
+		t.i++
+	}
+}
+
+// skipCommaSpace skips the separator between numbers: the spaces, with at
+// most one comma.
+func (t *tokenizer) skipCommaSpace() {
+	t.skipSpace()
+	if t.consume(',') {
+		t.skipSpace()
+	}
+}
+
+// digits consumes the digits, and reports if there's any.
+func (t *tokenizer) digits() bool {
+	start := t.i
+	for !t.done() && isDigit(t.s[t.i]) {
+		t.i++
+	}
+	return t.i > start
+}
+
+// number reads a number, such as "-1.5e-3". The number ends at the first
+// byte which can't continue it, so "1-2" and ".5.5" are two numbers each.
+func (t *tokenizer) number() (float64, error) {
+	start := t.i
+	if !t.consume('+') {
+		t.consume('-')
+	}
+	integer := t.digits()
+	fraction := false
+	if t.consume('.') {
+		fraction = t.digits()
+	}
+	if !integer && !fraction {
+		t.i = start
+		return 0, errParamMismatch
+	}
+	// The exponent requires digits, otherwise the "e" isn't part of
+	// the number, such as "1em".
+	if mantissa := t.i; t.consume('e') || t.consume('E') {
+		if !t.consume('+') {
+			t.consume('-')
+		}
+		if !t.digits() {
+			t.i = mantissa
+		}
+	}
+	f, err := strconv.ParseFloat(t.s[start:t.i], 64)
+	if err != nil {
+		return 0, errParamMismatch
+	}
+	return f, nil
+}
+
+// flag reads a flag of the arcs, which is a single "0" or "1".
+func (t *tokenizer) flag() (float64, error) {
+	switch {
+	case t.consume('0'):
+		return 0, nil
+	case t.consume('1'):
+		return 1, nil
+	default:
+		return 0, errParamMismatch
+	}
+}
+
+// name reads the name of a function, such as "translateX".
+func (t *tokenizer) name() string {
+	start := t.i
+	for !t.done() {
+		b := t.s[t.i]
+		if !(b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z') {
+			break
+		}
+		t.i++
+	}
+	return t.s[start:t.i]
+}
+
+// angleUnits converts the CSS angles to degrees.
+var angleUnits = map[string]float64{"deg": 1, "grad": 0.9, "rad": 180 / math.Pi, "turn": 360}
+
+// dimension reads a number followed by an optional CSS unit, such as
+// "45deg" or "2px". The angles are converted to degrees, and the lengths
+// to pixels, see toPx.
+func (t *tokenizer) dimension() (float64, error) {
+	f, err := t.number()
+	if err != nil {
+		return 0, err
+	}
+	unit := t.name()
+	if unit == "" {
+		return f, nil
+	}
+	if scale, ok := angleUnits[unit]; ok {
+		return f * scale, nil
+	}
+	for u, suffix := range absoluteUnits {
+		if suffix == unit && unite(u) != Perc {
+			return f * toPx[u], nil
+		}
+	}
+	return 0, errParamMismatch
+}
@@ -20,11 +20,18 @@ const (
 	Q
 	Pc
 	Perc // Special case : percentage (%) relative to the viewbox
+
+	// Font relative units, using the default font size of 16px, since
+	// the font size isn't known. Rem is before Em, so "1rem" isn't
+	// read as "1r" with the unit "em".
+	Rem
+	Em
+	Ex
 )
 
-var absoluteUnits = [...]string{Px: "px", Cm: "cm", Mm: "mm", Pt: "pt", In: "in", Q: "Q", Pc: "pc", Perc: "%"}
+var absoluteUnits = [...]string{Px: "px", Cm: "cm", Mm: "mm", Pt: "pt", In: "in", Q: "Q", Pc: "pc", Perc: "%", Rem: "rem", Em: "em", Ex: "ex"}
 
-var toPx = [...]float64{Px: 1, Cm: 96. / 2.54, Mm: 9.6 / 2.54, Pt: 96. / 72., In: 96., Q: 96. / 40. / 2.54, Pc: 96. / 6., Perc: 1}
+var toPx = [...]float64{Px: 1, Cm: 96. / 2.54, Mm: 9.6 / 2.54, Pt: 96. / 72., In: 96., Q: 96. / 40. / 2.54, Pc: 96. / 6., Perc: 1, Rem: 16, Em: 16, Ex: 8}
 
 // look for an absolute unit, or nothing (considered as pixels)
 // % is also supported
@@ -2,6 +2,7 @@ package svgparser
 
 import (
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -26,6 +27,9 @@ func TestUnits(t *testing.T) {
 		{s: "10 in", val: 960},
 		{s: "10 pt", val: 13.3333333333},
 		{s: "10 pc", val: 160},
+		{s: "2em", val: 32},
+		{s: "1.5rem", val: 24},
+		{s: "2ex", val: 16},
 	}
 	for i, d := range data {
 		value, isPerc, err := parseUnit(d.s)
@@ -40,3 +44,29 @@ func TestUnits(t *testing.T) {
 		}
 	}
 }
+
+func TestReadIconViewBox(t *testing.T) {
+	for _, c := range []struct {
+		svg      string
+		expected Bounds
+	}{
+		{`<svg viewBox="0 0 24 24" width="100%"><rect width="4" height="4"/></svg>`, Bounds{W: 24, H: 24}},
+		{`<svg width="2em" height="1em"></svg>`, Bounds{W: 32, H: 16}},
+		{`<svg width="10mm" height="100%"><rect x="10" y="20" width="30" height="40"/></svg>`, Bounds{X: 10, Y: 20, W: 30, H: 40}},
+		{`<svg><circle cx="5" cy="5" r="5" stroke="red" stroke-width="2"/></svg>`, Bounds{X: -1, Y: -1, W: 12, H: 12}},
+	} {
+		icon, err := ReadIcon(strings.NewReader(c.svg))
+		if err != nil {
+			t.Fatalf("%s: %v", c.svg, err)
+		}
+		v := icon.ViewBox
+		// The bounds of the stroke are within the tolerance of the flattening.
+		if math.Abs(v.X-c.expected.X) > 1e-3 || math.Abs(v.Y-c.expected.Y) > 1e-3 || math.Abs(v.W-c.expected.W) > 2e-3 || math.Abs(v.H-c.expected.H) > 2e-3 {
+			t.Errorf("%s: expected %+v, got %+v", c.svg, c.expected, v)
+		}
+	}
+
+	if _, err := ReadIcon(strings.NewReader(`<svg width="100%"><path d="M0 0 L10 0"/></svg>`)); err == nil {
+		t.Error("expected an error for an unknown size")
+	}
+}
@@ -0,0 +1,582 @@
+package svgparser
+
+import (
+	"errors"
+	"fmt"
+	"image/color"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/inkeliz/giosvg/internal/svgparser/simplexml"
+	"golang.org/x/image/colornames"
+)
+
+// vectorDrawableCursor holds the state of ReadVectorDrawable.
+type vectorDrawableCursor struct {
+	icon   *SVGRender
+	groups []vectorDrawableGroup
+	alpha  float64
+	tint   Pattern // tint replaces the colors, if any
+	tintA  float64
+
+	path     *SvgPath // path is the current <path>, until its end
+	trim     [3]float64
+	attr     string    // attr is the `name` of the current <aapt:attr>
+	gradient *Gradient // gradient is the current <gradient>, inside the <aapt:attr>
+	colors   [3]optionnalColor
+}
+
+// vectorDrawableGroup is a <group>, the first is the <vector>.
+type vectorDrawableGroup struct {
+	name      string
+	transform Matrix2D
+	clip      Path // clip is the area of the <clip-path>, in the coordinates of the viewport
+	clipped   bool // clipped is true if the group, or a parent, has a <clip-path>
+}
+
+// clipTolerance is the tolerance of the <clip-path>, in the coordinates
+// of the viewport.
+const clipTolerance = 0.01
+
+// ReadVectorDrawable reads the Android VectorDrawable XML, such as:
+//
+//	<vector android:viewportWidth="24" android:viewportHeight="24" ...>
+//	    <group android:rotation="45" android:pivotX="12" android:pivotY="12">
+//	        <path android:pathData="M4,4 H20 V20 H4 Z" android:fillColor="#FF2196F3"/>
+//	    </group>
+//	</vector>
+//
+// The colors which references a resource, such as `@color/primary`, are
+// read as the Variable `primary`, and the theme attributes, such as
+// `?attr/colorControlNormal`, are read as CurrentColor.
+//
+// The <clip-path> clips the paths after it, in the same group and its
+// children. The clipped paths are intersected with the clip, so their
+// fill and stroke become filled paths, see Intersection.
+func ReadVectorDrawable(stream io.Reader) (*SVGRender, error) {
+	c := &vectorDrawableCursor{
+		icon:  &SVGRender{defs: make(map[string][]definition), grads: make(map[string]*Gradient), Transform: Identity},
+		alpha: 1,
+	}
+	decoder := simplexml.NewDecoder(stream)
+	vector := false
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return c.icon, err
+		}
+		switch se := t.(type) {
+		case simplexml.StartElement:
+			if !vector && se.Name.Local != "vector" {
+				return c.icon, errors.New("vectordrawable: expected <vector>")
+			}
+			vector = true
+			if err := c.start(se); err != nil {
+				return c.icon, err
+			}
+		case simplexml.EndElement:
+			if err := c.end(se); err != nil {
+				return c.icon, err
+			}
+		}
+	}
+	if !vector {
+		return c.icon, errors.New("vectordrawable: expected <vector>")
+	}
+	return c.icon, nil
+}
+
+func (c *vectorDrawableCursor) start(se simplexml.StartElement) (err error) {
+	attrs := make(map[string]string, len(se.Attr))
+	for _, attr := range se.Attr {
+		attrs[attr.Name.Local] = strings.TrimSpace(attr.Value)
+	}
+	number := func(name string, def float64) float64 {
+		if v, ok := attrs[name]; ok {
+			if n, e := parseBasicFloat(strings.TrimSuffix(strings.TrimSuffix(v, "dp"), "px")); e != nil {
+				err = e
+			} else {
+				return n
+			}
+		}
+		return def
+	}
+
+	switch se.Name.Local {
+	case "vector":
+		w, h := number("width", 0), number("height", 0)
+		c.icon.ViewBox = Bounds{W: number("viewportWidth", w), H: number("viewportHeight", h)}
+		c.alpha = number("alpha", 1)
+		if v, ok := attrs["tint"]; ok {
+			var tint optionnalColor
+			c.tint, tint, err = parseVectorDrawableColor(v)
+			c.tintA = 1
+			if tint.valid {
+				c.tintA = float64(tint.color.A) / 0xff
+				c.tint = NewPlainColor(tint.color.R, tint.color.G, tint.color.B, 0xff)
+			}
+		}
+		c.groups = []vectorDrawableGroup{{transform: Identity}}
+	case "group":
+		px, py := number("pivotX", 0), number("pivotY", 0)
+		parent := c.groups[len(c.groups)-1]
+		c.groups = append(c.groups, vectorDrawableGroup{
+			name: attrs["name"],
+			transform: parent.transform.Translate(number("translateX", 0)+px, number("translateY", 0)+py).
+				Rotate(number("rotation", 0)*math.Pi/180).
+				Scale(number("scaleX", 1), number("scaleY", 1)).
+				Translate(-px, -py),
+			clip:    parent.clip,
+			clipped: parent.clipped,
+		})
+	case "clip-path":
+		var cursor pathCursor
+		if err := cursor.compilePath(attrs["pathData"]); err != nil {
+			return err
+		}
+		group := &c.groups[len(c.groups)-1]
+		clip := cursor.path.Transform(group.transform)
+		if group.clipped {
+			clip = group.clip.Intersection(clip, clipTolerance)
+		}
+		group.clip, group.clipped = clip, true
+	case "path":
+		var cursor pathCursor
+		if err := cursor.compilePath(attrs["pathData"]); err != nil {
+			return err
+		}
+		style := DefaultStyle
+		style.Transform = c.groups[len(c.groups)-1].transform
+		style.FillerColor = nil
+		style.FillOpacity = number("fillAlpha", 1) * c.alpha
+		style.LineOpacity = number("strokeAlpha", 1) * c.alpha
+		style.LineWidth = number("strokeWidth", 0)
+		style.Join.LineJoin = Miter
+		style.Join.MiterLimit = float32(number("strokeMiterLimit", 4))
+		style.UseNonZeroWinding = attrs["fillType"] != "evenOdd"
+		switch attrs["strokeLineCap"] {
+		case "round":
+			style.Join.TrailLineCap = RoundCap
+		case "square":
+			style.Join.TrailLineCap = SquareCap
+		}
+		switch attrs["strokeLineJoin"] {
+		case "round":
+			style.Join.LineJoin = Round
+		case "bevel":
+			style.Join.LineJoin = Bevel
+		}
+		for _, paint := range []struct {
+			name    string
+			pattern *Pattern
+			opacity *float64
+		}{
+			{"fillColor", &style.FillerColor, &style.FillOpacity},
+			{"strokeColor", &style.LinerColor, &style.LineOpacity},
+		} {
+			if v, ok := attrs[paint.name]; ok {
+				pattern, plain, err := parseVectorDrawableColor(v)
+				if err != nil {
+					return err
+				}
+				if plain.valid {
+					*paint.opacity *= float64(plain.color.A) / 0xff
+					pattern = NewPlainColor(plain.color.R, plain.color.G, plain.color.B, 0xff)
+				}
+				*paint.pattern = pattern
+			}
+		}
+
+		c.path = &SvgPath{Path: append(Path(nil), cursor.path...), Style: style, ID: attrs["name"]}
+		for i := len(c.groups) - 1; i > 0; i-- {
+			if c.groups[i].name != "" {
+				c.path.Parents = append(c.path.Parents, c.groups[i].name)
+			}
+		}
+		c.trim = [3]float64{number("trimPathStart", 0), number("trimPathEnd", 1), number("trimPathOffset", 0)}
+	case "attr":
+		c.attr = strings.TrimPrefix(attrs["name"], "android:")
+	case "gradient":
+		if c.path == nil {
+			return nil
+		}
+		c.gradient = &Gradient{Matrix: Identity, Units: UserSpaceOnUse}
+		switch attrs["type"] {
+		case "radial":
+			cx, cy := number("centerX", 0), number("centerY", 0)
+			c.gradient.Direction = Radial{cx, cy, cx, cy, number("gradientRadius", 0), 0}
+		case "sweep":
+			// The Direction is nil, see the end of the <gradient>.
+		default:
+			c.gradient.Direction = Linear{number("startX", 0), number("startY", 0), number("endX", 0), number("endY", 0)}
+		}
+		switch attrs["tileMode"] {
+		case "repeat":
+			c.gradient.Spread = RepeatSpread
+		case "mirror":
+			c.gradient.Spread = ReflectSpread
+		}
+		for i, name := range []string{"startColor", "centerColor", "endColor"} {
+			c.colors[i] = optionnalColor{}
+			if v, ok := attrs[name]; ok {
+				_, c.colors[i], err = parseVectorDrawableColor(v)
+			}
+		}
+	case "item":
+		if c.gradient == nil {
+			return nil
+		}
+		_, plain, e := parseVectorDrawableColor(attrs["color"])
+		if e != nil {
+			return e
+		}
+		c.gradient.Stops = append(c.gradient.Stops, gradientStop(number("offset", 0), plain))
+	}
+	return err
+}
+
+func (c *vectorDrawableCursor) end(ee simplexml.EndElement) error {
+	switch ee.Name.Local {
+	case "group":
+		if len(c.groups) > 1 {
+			c.groups = c.groups[:len(c.groups)-1]
+		}
+	case "gradient":
+		if c.gradient == nil {
+			return nil
+		}
+		grad := *c.gradient
+		c.gradient = nil
+		if len(grad.Stops) == 0 {
+			for i, plain := range c.colors {
+				if plain.valid {
+					grad.Stops = append(grad.Stops, gradientStop(float64(i)/2, plain))
+				}
+			}
+		}
+		var pattern Pattern = grad
+		if grad.Direction == nil {
+			// The sweep gradient is not supported, the first color is used.
+			pattern = nil
+			if len(grad.Stops) > 0 {
+				r, g, b, a := grad.Stops[0].StopColor.RGBA()
+				pattern = NewPlainColor(uint8(r>>8), uint8(g>>8), uint8(b>>8), uint8(a>>8))
+			}
+		}
+		switch c.attr {
+		case "fillColor":
+			c.path.Style.FillerColor = pattern
+		case "strokeColor":
+			c.path.Style.LinerColor = pattern
+		}
+	case "attr":
+		c.attr = ""
+	case "path":
+		if c.path == nil {
+			return nil
+		}
+		path := c.path
+		c.path = nil
+		if start, end, offset := c.trim[0], c.trim[1], c.trim[2]; start != 0 || end != 1 {
+			path.Path = trimPath(path.Path, start, end, offset)
+		}
+		if c.tint != nil {
+			if path.Style.FillerColor != nil {
+				path.Style.FillerColor, path.Style.FillOpacity = c.tint, path.Style.FillOpacity*c.tintA
+			}
+			if path.Style.LinerColor != nil {
+				path.Style.LinerColor, path.Style.LineOpacity = c.tint, path.Style.LineOpacity*c.tintA
+			}
+		}
+		if path.Style.LineWidth <= 0 {
+			path.Style.LinerColor = nil
+		}
+		c.icon.SVGPaths = append(c.icon.SVGPaths, c.clip(*path)...)
+	}
+	return nil
+}
+
+// clip returns the paths drawn by the path, inside the <clip-path> of the
+// current group. The fill and the outline of the stroke are intersected with
+// the clip, in the coordinates of the viewport, and both are filled. The path
+// is unchanged if it's entirely inside the clip.
+func (c *vectorDrawableCursor) clip(path SvgPath) []SvgPath {
+	group := c.groups[len(c.groups)-1]
+	if !group.clipped {
+		return []SvgPath{path}
+	}
+
+	m := path.Style.Transform
+	var fill, stroke Path
+	if path.Style.FillerColor != nil {
+		if fill = path.Path.Transform(m); !path.Style.UseNonZeroWinding {
+			fill = fill.NonZero(clipTolerance)
+		}
+	}
+	if path.Style.LinerColor != nil {
+		scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+		stroke = path.Path.Transform(m).StrokeOutline(path.Style.LineWidth*scale, path.Style.strokeJoin(), clipTolerance)
+	}
+	if fill.Difference(group.clip, clipTolerance) == nil && stroke.Difference(group.clip, clipTolerance) == nil {
+		return []SvgPath{path}
+	}
+
+	var paths []SvgPath
+	if fill = fill.Intersection(group.clip, clipTolerance); fill != nil {
+		clipped := path
+		clipped.Path, clipped.Style.Transform, clipped.Style.UseNonZeroWinding = fill, Identity, true
+		clipped.Style.LinerColor = nil
+		paths = append(paths, clipped)
+	}
+	if stroke = stroke.Intersection(group.clip, clipTolerance); stroke != nil {
+		clipped := path
+		clipped.Path, clipped.Style.Transform, clipped.Style.UseNonZeroWinding = stroke, Identity, true
+		clipped.Style.FillerColor, clipped.Style.FillOpacity = path.Style.LinerColor, path.Style.LineOpacity
+		clipped.Style.LinerColor = nil
+		paths = append(paths, clipped)
+	}
+	return paths
+}
+
+// gradientStop returns the stop with the color, where the alpha of the
+// color is the opacity of the stop.
+func gradientStop(offset float64, c optionnalColor) GradStop {
+	if !c.valid {
+		return GradStop{Offset: offset, StopColor: color.NRGBA{A: 0xff}, Opacity: 0}
+	}
+	return GradStop{
+		Offset:    offset,
+		StopColor: color.NRGBA{R: c.color.R, G: c.color.G, B: c.color.B, A: 0xff},
+		Opacity:   float64(c.color.A) / 0xff,
+	}
+}
+
+// parseVectorDrawableColor parses the color, which is either #RGB, #ARGB,
+// #RRGGBB or #AARRGGBB, or a reference. The pattern is only returned
+// for references, otherwise the color is valid.
+func parseVectorDrawableColor(v string) (Pattern, optionnalColor, error) {
+	switch {
+	case strings.HasPrefix(v, "?"):
+		return CurrentColor{}, optionnalColor{}, nil
+	case strings.HasPrefix(v, "@android:color/"):
+		switch name := strings.TrimPrefix(v, "@android:color/"); name {
+		case "transparent":
+			return nil, toOptColor(NewPlainColor(0, 0, 0, 0)), nil
+		case "white", "black":
+			return nil, toOptColor(PlainColor{NRGBA: color.NRGBAModel.Convert(colornames.Map[name]).(color.NRGBA)}), nil
+		}
+	case strings.HasPrefix(v, "@color/"):
+		return Variable{Name: strings.TrimPrefix(v, "@color/"), Fallback: NewPlainColor(0, 0, 0, 0xff)}, optionnalColor{}, nil
+	case strings.HasPrefix(v, "#"):
+		hex := v[1:]
+		switch len(hex) {
+		case 3, 4:
+			expanded := make([]byte, 0, 2*len(hex))
+			for i := range hex {
+				expanded = append(expanded, hex[i], hex[i])
+			}
+			hex = string(expanded)
+		}
+		if len(hex) == 6 {
+			hex = "ff" + hex
+		}
+		n, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil || len(hex) != 8 {
+			return nil, optionnalColor{}, fmt.Errorf("vectordrawable: invalid color %q", v)
+		}
+		return nil, toOptColor(NewPlainColor(uint8(n>>16), uint8(n>>8), uint8(n), uint8(n>>24))), nil
+	}
+	return nil, optionnalColor{}, fmt.Errorf("vectordrawable: unsupported color %q", v)
+}
+
+// WriteVectorDrawable writes the SVGRender as Android VectorDrawable XML.
+// The transforms are applied to the paths, and the dashes are applied
+// to the strokes, since VectorDrawable doesn't support them. The
+// animations are not written.
+//
+// The Variable is written as the color resource, such as `@color/primary`,
+// and the CurrentColor as `?android:attr/colorControlNormal`.
+func (s *SVGRender) WriteVectorDrawable(w io.Writer) error {
+	var body strings.Builder
+	usesAapt := false
+	viewport := Identity.Translate(-s.ViewBox.X, -s.ViewBox.Y)
+	for _, svgp := range s.SVGPaths {
+		style := svgp.Style
+		m := viewport.Mult(style.Transform)
+		scale := math.Sqrt(math.Abs(m.A*m.D - m.B*m.C))
+
+		fill, stroke := style.FillerColor, style.LinerColor
+		if fill == nil && stroke == nil {
+			continue
+		}
+		dashed := stroke != nil && style.Dash.pattern() != nil
+		if dashed && fill != nil {
+			// The dashes only apply to the stroke, which is written
+			// as another path.
+			if err := writeVectorDrawablePath(&body, svgp, m, scale, fill, nil, &usesAapt); err != nil {
+				return err
+			}
+			fill = nil
+		}
+		if dashed {
+			svgp.Path = svgp.Path.dash(style.Dash, dashTolerance/scale)
+		}
+		if err := writeVectorDrawablePath(&body, svgp, m, scale, fill, stroke, &usesAapt); err != nil {
+			return err
+		}
+	}
+
+	var namespaces string
+	if usesAapt {
+		namespaces = ` xmlns:aapt="http://schemas.android.com/aapt"`
+	}
+	_, err := fmt.Fprintf(w, `<vector xmlns:android="http://schemas.android.com/apk/res/android"%s
+    android:width="%sdp"
+    android:height="%sdp"
+    android:viewportWidth="%s"
+    android:viewportHeight="%s">
+%s</vector>
+`, namespaces, formatFloat(s.ViewBox.W), formatFloat(s.ViewBox.H), formatFloat(s.ViewBox.W), formatFloat(s.ViewBox.H), body.String())
+	return err
+}
+
+// writeVectorDrawablePath writes the <path>, with the given fill
+// and stroke, after applying the transform `m`.
+func writeVectorDrawablePath(w *strings.Builder, svgp SvgPath, m Matrix2D, scale float64, fill, stroke Pattern, usesAapt *bool) error {
+	style := svgp.Style
+	path := svgp.Path.Transform(m)
+
+	w.WriteString("    <path")
+	if svgp.ID != "" {
+		fmt.Fprintf(w, "\n        android:name=\"%s\"", escapeAttr(svgp.ID))
+	}
+	fmt.Fprintf(w, "\n        android:pathData=\"%s\"", pathData(path))
+
+	var gradients []string
+	if fill != nil {
+		color, gradient := vectorDrawableColor(fill, svgp, m, scale)
+		if gradient != "" {
+			gradients = append(gradients, "        <aapt:attr name=\"android:fillColor\">\n"+gradient+"        </aapt:attr>\n")
+		} else {
+			fmt.Fprintf(w, "\n        android:fillColor=\"%s\"", color)
+		}
+		if style.FillOpacity != 1 {
+			fmt.Fprintf(w, "\n        android:fillAlpha=\"%s\"", formatFloat(style.FillOpacity))
+		}
+		if !style.UseNonZeroWinding {
+			w.WriteString("\n        android:fillType=\"evenOdd\"")
+		}
+	}
+	if stroke != nil {
+		color, gradient := vectorDrawableColor(stroke, svgp, m, scale)
+		if gradient != "" {
+			gradients = append(gradients, "        <aapt:attr name=\"android:strokeColor\">\n"+gradient+"        </aapt:attr>\n")
+		} else {
+			fmt.Fprintf(w, "\n        android:strokeColor=\"%s\"", color)
+		}
+		if style.LineOpacity != 1 {
+			fmt.Fprintf(w, "\n        android:strokeAlpha=\"%s\"", formatFloat(style.LineOpacity))
+		}
+		fmt.Fprintf(w, "\n        android:strokeWidth=\"%s\"", formatFloat(style.LineWidth*scale))
+		switch style.Join.TrailLineCap {
+		case RoundCap:
+			w.WriteString("\n        android:strokeLineCap=\"round\"")
+		case SquareCap:
+			w.WriteString("\n        android:strokeLineCap=\"square\"")
+		}
+		switch style.Join.LineJoin {
+		case Round:
+			w.WriteString("\n        android:strokeLineJoin=\"round\"")
+		case Bevel:
+			w.WriteString("\n        android:strokeLineJoin=\"bevel\"")
+		}
+		if limit := style.Join.MiterLimit; limit != 4 && limit > 0 {
+			fmt.Fprintf(w, "\n        android:strokeMiterLimit=\"%s\"", formatFloat32(limit))
+		}
+	}
+
+	if len(gradients) == 0 {
+		w.WriteString("/>\n")
+		return nil
+	}
+	*usesAapt = true
+	w.WriteString(">\n")
+	for _, g := range gradients {
+		w.WriteString(g)
+	}
+	w.WriteString("    </path>\n")
+	return nil
+}
+
+// vectorDrawableColor returns the color attribute of the pattern, or the
+// <gradient> of the <aapt:attr>.
+func vectorDrawableColor(p Pattern, svgp SvgPath, m Matrix2D, scale float64) (color, gradient string) {
+	switch p := p.(type) {
+	case PlainColor:
+		return formatVectorDrawableColor(p.NRGBA), ""
+	case CurrentColor:
+		return "?android:attr/colorControlNormal", ""
+	case Variable:
+		return "@color/" + p.Name, ""
+	case Gradient:
+		return "", vectorDrawableGradient(p, svgp, m, scale)
+	}
+	return "#FF000000", ""
+}
+
+// vectorDrawableGradient returns the <gradient>, in the coordinates
+// of the transformed path.
+func vectorDrawableGradient(g Gradient, svgp SvgPath, m Matrix2D, scale float64) string {
+	gm := g.userTransform(svgp.Path, m)
+	gscale := math.Sqrt(math.Abs(gm.A*gm.D - gm.B*gm.C))
+
+	var s strings.Builder
+	switch d := g.Direction.(type) {
+	case Linear:
+		x1, y1 := gm.Transform(d[0], d[1])
+		x2, y2 := gm.Transform(d[2], d[3])
+		fmt.Fprintf(&s, `            <gradient
+                android:type="linear"
+                android:startX="%s"
+                android:startY="%s"
+                android:endX="%s"
+                android:endY="%s"`, formatFloat(x1), formatFloat(y1), formatFloat(x2), formatFloat(y2))
+	case Radial:
+		cx, cy := gm.Transform(d[0], d[1])
+		fmt.Fprintf(&s, `            <gradient
+                android:type="radial"
+                android:centerX="%s"
+                android:centerY="%s"
+                android:gradientRadius="%s"`, formatFloat(cx), formatFloat(cy), formatFloat(d[4]*gscale))
+	}
+	switch g.Spread {
+	case RepeatSpread:
+		s.WriteString("\n                android:tileMode=\"repeat\"")
+	case ReflectSpread:
+		s.WriteString("\n                android:tileMode=\"mirror\"")
+	}
+	s.WriteString(">\n")
+	for _, stop := range g.Stops {
+		c := color.NRGBA{A: 0xff}
+		if stop.StopColor != nil {
+			c = color.NRGBAModel.Convert(stop.StopColor).(color.NRGBA)
+		}
+		c.A = uint8(math.Round(float64(c.A) * stop.Opacity))
+		value := formatVectorDrawableColor(c)
+		if stop.Variable != "" {
+			value = "@color/" + stop.Variable
+		}
+		fmt.Fprintf(&s, "                <item android:offset=\"%s\" android:color=\"%s\"/>\n", formatFloat(stop.Offset), value)
+	}
+	s.WriteString("            </gradient>\n")
+	return s.String()
+}
+
+func formatVectorDrawableColor(c color.NRGBA) string {
+	return fmt.Sprintf("#%02X%02X%02X%02X", c.A, c.R, c.G, c.B)
+}
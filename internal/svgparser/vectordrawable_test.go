@@ -0,0 +1,202 @@
+package svgparser
+
+import (
+	"bytes"
+	"math"
+	"strings"
+	"testing"
+
+	"gioui.org/f32"
+)
+
+const testVectorDrawable = `<vector xmlns:android="http://schemas.android.com/apk/res/android"
+	xmlns:aapt="http://schemas.android.com/aapt"
+	android:width="24dp" android:height="24dp"
+	android:viewportWidth="24" android:viewportHeight="24">
+	<group android:name="rotated" android:rotation="90" android:pivotX="12" android:pivotY="12">
+		<path android:name="square" android:pathData="M4,4h8v8h-8z" android:fillColor="#802196F3" android:fillType="evenOdd"/>
+	</group>
+	<group android:translateX="2" android:scaleX="2">
+		<clip-path android:pathData="M-2,0h14v24h-14z"/>
+		<path android:pathData="M0,20L10,20" android:strokeColor="@color/primary" android:strokeWidth="1.5"
+			android:strokeLineCap="round" android:strokeAlpha="0.5"/>
+	</group>
+	<path android:pathData="M0,0h24v4h-24z">
+		<aapt:attr name="android:fillColor">
+			<gradient android:type="linear" android:startX="0" android:startY="0" android:endX="24" android:endY="0">
+				<item android:offset="0" android:color="#FFFF0000"/>
+				<item android:offset="1" android:color="#000000FF"/>
+			</gradient>
+		</aapt:attr>
+	</path>
+	<path android:pathData="M0,0L10,0" android:strokeColor="?attr/colorControlNormal" android:strokeWidth="1" android:trimPathEnd="0.5"/>
+</vector>`
+
+func TestReadVectorDrawable(t *testing.T) {
+	icon, err := ReadVectorDrawable(strings.NewReader(testVectorDrawable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if icon.ViewBox != (Bounds{W: 24, H: 24}) {
+		t.Errorf("unexpected viewBox %v", icon.ViewBox)
+	}
+	if len(icon.SVGPaths) != 4 {
+		t.Fatalf("expected 4 paths, got %d", len(icon.SVGPaths))
+	}
+
+	square := icon.SVGPaths[0]
+	if square.ID != "square" || len(square.Parents) != 1 || square.Parents[0] != "rotated" {
+		t.Errorf("unexpected names %q and %v", square.ID, square.Parents)
+	}
+	if square.Style.FillerColor != NewPlainColor(0x21, 0x96, 0xf3, 0xff) || !almostEqual(square.Style.FillOpacity, 0x80/255.0) {
+		t.Errorf("unexpected fill %v with opacity %v", square.Style.FillerColor, square.Style.FillOpacity)
+	}
+	if square.Style.UseNonZeroWinding || square.Style.LinerColor != nil {
+		t.Errorf("unexpected style %v", square.Style)
+	}
+	if x, y := square.Style.Transform.Transform(4, 4); math.Abs(x-20) > 1e-9 || math.Abs(y-4) > 1e-9 {
+		t.Errorf("expected the rotation around the pivot, got %v, %v", x, y)
+	}
+
+	line := icon.SVGPaths[1]
+	if v, ok := line.Style.LinerColor.(Variable); !ok || v.Name != "primary" {
+		t.Errorf("expected the variable, got %v", line.Style.LinerColor)
+	}
+	if line.Style.FillerColor != nil || line.Style.LineWidth != 1.5 || line.Style.LineOpacity != 0.5 || line.Style.Join.TrailLineCap != RoundCap {
+		t.Errorf("unexpected style %v", line.Style)
+	}
+	if x, y := line.Style.Transform.Transform(10, 20); x != 22 || y != 20 {
+		t.Errorf("expected the translated and scaled point, got %v, %v", x, y)
+	}
+
+	gradient, ok := icon.SVGPaths[2].Style.FillerColor.(Gradient)
+	if !ok || len(gradient.Stops) != 2 || gradient.Stops[1].Opacity != 0 || gradient.Direction != (Linear{0, 0, 24, 0}) {
+		t.Errorf("unexpected gradient %v", icon.SVGPaths[2].Style.FillerColor)
+	}
+
+	trimmed := icon.SVGPaths[3]
+	if _, ok := trimmed.Style.LinerColor.(CurrentColor); !ok {
+		t.Errorf("expected the current color, got %v", trimmed.Style.LinerColor)
+	}
+	if bounds := icon.PathBounds(3, DrawOptions{Transform: Identity}); bounds.Max.X != 5.5 {
+		t.Errorf("expected the trimmed path, got %v", bounds)
+	}
+
+	for _, invalid := range []string{
+		`<svg></svg>`,
+		`<vector android:viewportWidth="a"></vector>`,
+		`<vector><path android:pathData="M0,0" android:fillColor="#12345"/></vector>`,
+	} {
+		if _, err := ReadVectorDrawable(strings.NewReader(invalid)); err == nil {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestVectorDrawableClipPath(t *testing.T) {
+	icon, err := ReadVectorDrawable(strings.NewReader(`<vector xmlns:android="http://schemas.android.com/apk/res/android"
+		android:width="24dp" android:height="24dp" android:viewportWidth="24" android:viewportHeight="24">
+		<group android:translateX="2">
+			<clip-path android:pathData="M0,0h10v10h-10z"/>
+			<path android:name="clipped" android:pathData="M0,0h20v20h-20z" android:fillColor="#FF0000"
+				android:strokeColor="#0000FF" android:strokeWidth="2"/>
+			<group>
+				<clip-path android:pathData="M0,0h4v4h-4z"/>
+				<path android:name="nested" android:pathData="M2,2h4v4h-4z" android:fillColor="#00FF00"/>
+			</group>
+			<path android:name="inside" android:pathData="M2,2h4v4h-4z" android:fillColor="#00FF00"/>
+		</group>
+		<path android:name="outside" android:pathData="M0,0h24v24h-24z" android:fillColor="#000000"/>
+	</vector>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, path := range icon.SVGPaths {
+		ids = append(ids, path.ID)
+	}
+	if strings.Join(ids, " ") != "clipped clipped nested inside outside" {
+		t.Fatalf("unexpected paths %v", ids)
+	}
+
+	// The fill and the stroke are clipped, and both are filled.
+	fill, stroke := icon.SVGPaths[0], icon.SVGPaths[1]
+	if fill.Style.FillerColor != NewPlainColor(0xff, 0, 0, 0xff) || fill.Style.LinerColor != nil {
+		t.Errorf("unexpected fill %v", fill.Style)
+	}
+	if stroke.Style.FillerColor != NewPlainColor(0, 0, 0xff, 0xff) || stroke.Style.LinerColor != nil {
+		t.Errorf("unexpected stroke %v", stroke.Style)
+	}
+	for i, expected := range []f32.Rectangle{
+		f32.Rect(2, 0, 12, 10),
+		f32.Rect(2, 0, 12, 10),
+		f32.Rect(4, 2, 6, 4),
+		f32.Rect(4, 2, 8, 6),
+		f32.Rect(0, 0, 24, 24),
+	} {
+		got := icon.TightPathBounds(i, DrawOptions{Transform: Identity})
+		if !nearRect(got, expected, 1e-2) {
+			t.Errorf("path %d: expected %v, got %v", i, expected, got)
+		}
+	}
+
+	// The paths inside the clip are unchanged.
+	if inside := icon.SVGPaths[3]; inside.Style.Transform != Identity.Translate(2, 0) {
+		t.Errorf("expected the unchanged path, got %v", inside.Style.Transform)
+	}
+}
+
+func TestWriteVectorDrawable(t *testing.T) {
+	icon, err := ReadIcon(strings.NewReader(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="2 2 20 20">
+		<defs>
+			<linearGradient id="g" x1="0" y1="0" x2="1" y2="0">
+				<stop offset="0" stop-color="red"/>
+				<stop offset="1" stop-color="blue" stop-opacity="0.5"/>
+			</linearGradient>
+		</defs>
+		<rect id="box" x="4" y="4" width="8" height="8" fill="url(#g)" transform="translate(2 0)"/>
+		<path d="M4 20 H20" fill="none" stroke="var(--primary, red)" stroke-width="2" stroke-linecap="round" transform="scale(0.5)"/>
+		<path d="M4 4 H20" fill="currentColor" stroke="black" stroke-dasharray="4"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := icon.WriteVectorDrawable(&out); err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`xmlns:aapt="http://schemas.android.com/aapt"`,
+		`android:viewportWidth="20"`,
+		`android:name="box"`,
+		`android:pathData="M4 2L12 2L12 10L4 10Z"`,
+		`<item android:offset="1" android:color="#800000FF"/>`,
+		`android:strokeColor="@color/primary"`,
+		`android:strokeWidth="1"`,
+		`android:fillColor="?android:attr/colorControlNormal"`,
+		`android:pathData="M2 2L6 2M10 2L14 2"`,
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("expected %s in:\n%s", expected, out.String())
+		}
+	}
+
+	// The paths are the same, after reading the VectorDrawable. The dashed
+	// stroke is written as another path.
+	read, err := ReadVectorDrawable(&out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read.SVGPaths) != 4 {
+		t.Fatalf("expected 4 paths, got %d", len(read.SVGPaths))
+	}
+	for i := range icon.SVGPaths[:2] {
+		o := DrawOptions{Transform: icon.TargetTransform(0, 0, 20, 20)}
+		expected, got := icon.PathBounds(i, o), read.PathBounds(i, DrawOptions{Transform: Identity})
+		if math.Abs(float64(expected.Min.X-got.Min.X)) > 1e-3 || math.Abs(float64(expected.Max.Y-got.Max.Y)) > 1e-3 {
+			t.Errorf("path %d: expected %v, got %v", i, expected, got)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package svgraster
+
+import (
+	"image"
+	"math"
+	"sort"
+
+	"gioui.org/f32"
+)
+
+// evenOddSamples is the number of scanlines for each row of pixels. The
+// horizontal coverage of each scanline is exact.
+const evenOddSamples = 16
+
+// evenOddMask returns the coverage of the polygons, using the even-odd
+// rule, since the vector.Rasterizer only supports the non-zero rule.
+func evenOddMask(polygons [][]f32.Point, bounds image.Rectangle) *image.Alpha {
+	mask := image.NewAlpha(bounds)
+
+	type edge struct{ a, b f32.Point }
+	var edges []edge
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, polygon := range polygons {
+		for i, a := range polygon {
+			b := polygon[(i+1)%len(polygon)]
+			if a.Y == b.Y {
+				continue
+			}
+			edges = append(edges, edge{a, b})
+			minY, maxY = math.Min(minY, float64(a.Y)), math.Max(maxY, float64(a.Y))
+			minY, maxY = math.Min(minY, float64(b.Y)), math.Max(maxY, float64(b.Y))
+		}
+	}
+	if len(edges) == 0 {
+		return mask
+	}
+
+	width := bounds.Dx()
+	coverage := make([]float64, width)
+	var crossings []float64
+	top, bottom := int(math.Max(math.Floor(minY), float64(bounds.Min.Y))), int(math.Min(math.Ceil(maxY), float64(bounds.Max.Y)))
+	for y := top; y < bottom; y++ {
+		for i := range coverage {
+			coverage[i] = 0
+		}
+		for s := 0; s < evenOddSamples; s++ {
+			sy := float64(y) + (float64(s)+0.5)/evenOddSamples
+			crossings = crossings[:0]
+			for _, e := range edges {
+				ay, by := float64(e.a.Y), float64(e.b.Y)
+				if (ay > sy) != (by > sy) {
+					crossings = append(crossings, float64(e.a.X)+(sy-ay)*float64(e.b.X-e.a.X)/(by-ay)-float64(bounds.Min.X))
+				}
+			}
+			sort.Float64s(crossings)
+			for i := 0; i+1 < len(crossings); i += 2 {
+				addSpan(coverage, crossings[i], crossings[i+1], 1.0/evenOddSamples)
+			}
+		}
+		row := mask.Pix[mask.PixOffset(bounds.Min.X, y):]
+		for x, c := range coverage {
+			row[x] = uint8(math.Round(math.Min(c, 1) * 0xff))
+		}
+	}
+	return mask
+}
+
+// addSpan adds the coverage `w` from x0 to x1, the pixels partially
+// covered receive the fraction of `w`.
+func addSpan(coverage []float64, x0, x1, w float64) {
+	x0, x1 = math.Max(x0, 0), math.Min(x1, float64(len(coverage)))
+	if x0 >= x1 {
+		return
+	}
+	i0, i1 := int(x0), int(x1)
+	if i0 == i1 {
+		coverage[i0] += (x1 - x0) * w
+		return
+	}
+	coverage[i0] += (float64(i0+1) - x0) * w
+	for i := i0 + 1; i < i1; i++ {
+		coverage[i] += w
+	}
+	if i1 < len(coverage) {
+		coverage[i1] += (x1 - float64(i1)) * w
+	}
+}
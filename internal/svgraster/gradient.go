@@ -0,0 +1,146 @@
+package svgraster
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// gradientImage is the image.Image of the gradient, in the coordinates
+// of the Driver.
+type gradientImage struct {
+	gradient svgparser.Gradient
+	inverse  svgparser.Matrix2D // inverse maps the pixels to the Direction
+	stops    []gradientStop
+}
+
+// gradientStop is the stop with the alpha-premultiplied color.
+type gradientStop struct {
+	offset     float64
+	r, g, b, a float64
+}
+
+// newGradientImage returns the image of the gradient, the stops without
+// color uses the CurrentColor. It returns false if nothing is drawn.
+func newGradientImage(g svgparser.Gradient, current color.NRGBA, opacity float64) (*gradientImage, bool) {
+	det := g.Matrix.A*g.Matrix.D - g.Matrix.B*g.Matrix.C
+	if len(g.Stops) == 0 || det == 0 || g.Direction == nil {
+		return nil, false
+	}
+	img := &gradientImage{gradient: g, inverse: g.Matrix.Invert()}
+	offset := 0.0
+	for _, s := range g.Stops {
+		// The offset must be between 0 and 1, and equal or greater
+		// than the previous offset.
+		offset = math.Max(offset, math.Min(math.Max(s.Offset, 0), 1))
+		c := current
+		if s.StopColor != nil {
+			c = color.NRGBAModel.Convert(s.StopColor).(color.NRGBA)
+		}
+		a := float64(c.A) / 0xff * s.Opacity * math.Min(opacity, 1)
+		img.stops = append(img.stops, gradientStop{
+			offset: offset,
+			r:      float64(c.R) / 0xff * a,
+			g:      float64(c.G) / 0xff * a,
+			b:      float64(c.B) / 0xff * a,
+			a:      a,
+		})
+	}
+	return img, true
+}
+
+func (g *gradientImage) ColorModel() color.Model { return color.RGBA64Model }
+
+func (g *gradientImage) Bounds() image.Rectangle {
+	return image.Rect(-1e9, -1e9, 1e9, 1e9)
+}
+
+func (g *gradientImage) At(x, y int) color.Color {
+	px, py := g.inverse.Transform(float64(x)+0.5, float64(y)+0.5)
+	t, ok := g.offset(px, py)
+	if !ok {
+		return color.RGBA64{}
+	}
+	switch g.gradient.Spread {
+	case svgparser.RepeatSpread:
+		t -= math.Floor(t)
+	case svgparser.ReflectSpread:
+		t = math.Abs(t - 2*math.Floor(t/2+0.5))
+	}
+
+	var c gradientStop
+	switch first, last := g.stops[0], g.stops[len(g.stops)-1]; {
+	case t <= first.offset:
+		c = first
+	case t >= last.offset:
+		c = last
+	default:
+		for i := 1; i < len(g.stops); i++ {
+			a, b := g.stops[i-1], g.stops[i]
+			if t > b.offset {
+				continue
+			}
+			w := 0.0
+			if b.offset > a.offset {
+				w = (t - a.offset) / (b.offset - a.offset)
+			}
+			c = gradientStop{
+				r: a.r + (b.r-a.r)*w,
+				g: a.g + (b.g-a.g)*w,
+				b: a.b + (b.b-a.b)*w,
+				a: a.a + (b.a-a.a)*w,
+			}
+			break
+		}
+	}
+	f := func(v float64) uint16 { return uint16(math.Round(math.Min(math.Max(v, 0), 1) * 0xffff)) }
+	return color.RGBA64{R: f(c.r), G: f(c.g), B: f(c.b), A: f(c.a)}
+}
+
+// offset returns the offset of the gradient at the point, in the
+// coordinates of the Direction, before the spread. It returns false
+// if the point is outside of the radial gradient.
+func (g *gradientImage) offset(x, y float64) (float64, bool) {
+	switch d := g.gradient.Direction.(type) {
+	case svgparser.Linear:
+		dx, dy := d[2]-d[0], d[3]-d[1]
+		dd := dx*dx + dy*dy
+		if dd == 0 {
+			return 1, true
+		}
+		return ((x-d[0])*dx + (y-d[1])*dy) / dd, true
+	case svgparser.Radial:
+		// The gradient is the circles interpolated from the focal circle,
+		// at 0, to the end circle, at 1. The offset is the greatest of
+		// the circles which contains the point.
+		cx, cy, fx, fy, r, fr := d[0], d[1], d[2], d[3], d[4], d[5]
+		cdx, cdy, dr := cx-fx, cy-fy, r-fr
+		pdx, pdy := x-fx, y-fy
+		a := cdx*cdx + cdy*cdy - dr*dr
+		b := pdx*cdx + pdy*cdy + fr*dr
+		c := pdx*pdx + pdy*pdy - fr*fr
+		if math.Abs(a) < 1e-9 {
+			if b == 0 {
+				return 0, false
+			}
+			t := c / (2 * b)
+			return t, fr+t*dr >= 0
+		}
+		disc := b*b - a*c
+		if disc < 0 {
+			return 0, false
+		}
+		sq := math.Sqrt(disc)
+		t0, t1 := (b+sq)/a, (b-sq)/a
+		if t0 < t1 {
+			t0, t1 = t1, t0
+		}
+		if fr+t0*dr >= 0 {
+			return t0, true
+		}
+		return t1, fr+t1*dr >= 0
+	}
+	return 0, false
+}
@@ -0,0 +1,166 @@
+package svgraster
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+
+	"gioui.org/f32"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+	"golang.org/x/image/vector"
+)
+
+// tolerance is the maximum error, in pixels, of the flattened curves used
+// to create the outline of strokes and to fill the even-odd paths.
+const tolerance = 0.05
+
+// Driver draws into the Image, with anti-aliasing, using
+// the golang.org/x/image/vector rasterizer.
+type Driver struct {
+	Image *image.RGBA
+	Scale float32     // Scale is applied to the width of the strokes
+	Color color.NRGBA // Color is the CurrentColor
+
+	rasterizer *vector.Rasterizer
+}
+
+func (d *Driver) SetupDrawers(willFill, willStroke bool) (f svgparser.Filler, s svgparser.Stroker) {
+	if d.rasterizer == nil {
+		d.rasterizer = vector.NewRasterizer(d.Image.Rect.Dx(), d.Image.Rect.Dy())
+	}
+	if willFill {
+		f = &filler{driver: d, nonZero: true}
+	}
+	if willStroke {
+		s = &stroker{driver: d}
+	}
+	return f, s
+}
+
+// fill fills the path, using the non-zero or the even-odd rule.
+func (d *Driver) fill(path svgparser.Path, pattern svgparser.Pattern, opacity float64, nonZero bool) {
+	if len(path) == 0 || opacity <= 0 {
+		return
+	}
+
+	var src image.Image
+	switch p := pattern.(type) {
+	case svgparser.CurrentColor:
+		src = image.NewUniform(multiplyAlpha(d.Color, opacity))
+	case svgparser.PlainColor:
+		src = image.NewUniform(multiplyAlpha(p.NRGBA, opacity))
+	case svgparser.Gradient:
+		g, ok := newGradientImage(p, d.Color, opacity)
+		if !ok {
+			return
+		}
+		src = g
+	default:
+		return
+	}
+
+	bounds := d.Image.Rect
+	if !nonZero {
+		mask := evenOddMask(path.Polygons(tolerance), bounds)
+		draw.DrawMask(d.Image, bounds, src, bounds.Min, mask, bounds.Min, draw.Over)
+		return
+	}
+
+	z := d.rasterizer
+	z.Reset(bounds.Dx(), bounds.Dy())
+
+	// The rasterizer starts at zero, so the points are moved
+	// by the origin of the image.
+	o := f32.Pt(float32(bounds.Min.X), float32(bounds.Min.Y))
+	open := false
+	for _, op := range path {
+		switch op := op.(type) {
+		case svgparser.OpMoveTo:
+			if open {
+				z.ClosePath()
+			}
+			p := f32.Point(op).Sub(o)
+			z.MoveTo(p.X, p.Y)
+			open = true
+		case svgparser.OpLineTo:
+			p := f32.Point(op).Sub(o)
+			z.LineTo(p.X, p.Y)
+		case svgparser.OpQuadTo:
+			b, c := op[0].Sub(o), op[1].Sub(o)
+			z.QuadTo(b.X, b.Y, c.X, c.Y)
+		case svgparser.OpCubicTo:
+			b, c, e := op[0].Sub(o), op[1].Sub(o), op[2].Sub(o)
+			z.CubeTo(b.X, b.Y, c.X, c.Y, e.X, e.Y)
+		case svgparser.OpClose:
+			z.ClosePath()
+			open = false
+		}
+	}
+	if open {
+		z.ClosePath()
+	}
+	z.Draw(d.Image, bounds, src, bounds.Min)
+}
+
+type filler struct {
+	driver  *Driver
+	path    svgparser.Path
+	nonZero bool
+}
+
+func (f *filler) Start(a f32.Point)            { f.path.Start(a) }
+func (f *filler) Line(b f32.Point)             { f.path.Line(b) }
+func (f *filler) QuadBezier(b, c f32.Point)    { f.path.QuadBezier(b, c) }
+func (f *filler) CubeBezier(b, c, d f32.Point) { f.path.CubeBezier(b, c, d) }
+
+func (f *filler) Stop(closeLoop bool) {
+	// The sub-paths are always closed when filled.
+	if len(f.path) > 0 && closeLoop {
+		f.path.Stop(true)
+	}
+}
+
+func (f *filler) SetWinding(useNonZeroWinding bool) {
+	f.nonZero = useNonZeroWinding
+}
+
+func (f *filler) Draw(color svgparser.Pattern, opacity float64) {
+	f.driver.fill(f.path, color, opacity, f.nonZero)
+	f.path = nil
+}
+
+type stroker struct {
+	driver  *Driver
+	path    svgparser.Path
+	options svgparser.StrokeOptions
+}
+
+func (s *stroker) Start(a f32.Point)            { s.path.Start(a) }
+func (s *stroker) Line(b f32.Point)             { s.path.Line(b) }
+func (s *stroker) QuadBezier(b, c f32.Point)    { s.path.QuadBezier(b, c) }
+func (s *stroker) CubeBezier(b, c, d f32.Point) { s.path.CubeBezier(b, c, d) }
+
+func (s *stroker) Stop(closeLoop bool) {
+	if len(s.path) > 0 && closeLoop {
+		s.path.Stop(true)
+	}
+}
+
+func (s *stroker) SetStrokeOptions(options svgparser.StrokeOptions) {
+	s.options = options
+}
+
+func (s *stroker) Draw(color svgparser.Pattern, opacity float64) {
+	width := float64(s.options.LineWidth * s.driver.Scale)
+	s.driver.fill(s.path.StrokeOutline(width, s.options.Join, tolerance), color, opacity, true)
+	s.path = nil
+}
+
+// multiplyAlpha returns the alpha-premultiplied color, with the
+// given opacity.
+func multiplyAlpha(c color.NRGBA, opacity float64) color.RGBA64 {
+	a := float64(c.A) * 0x101 * math.Min(opacity, 1)
+	f := func(v uint8) uint16 { return uint16(math.Round(float64(v) * 0x101 * a / 0xffff)) }
+	return color.RGBA64{R: f(c.R), G: f(c.G), B: f(c.B), A: uint16(math.Round(a))}
+}
@@ -0,0 +1,22 @@
+package giosvg
+
+import (
+	"github.com/inkeliz/giosvg/internal/svgparser"
+)
+
+// Morph returns the Vector which draws the shape between the Documents a
+// and b, at t between 0 (a) and 1 (b), such as the transition from a "play"
+// to a "pause" icon. The changes made by Element are included.
+//
+// The paths are matched in the document order, and the paths without match,
+// when the Documents have a different number of paths, fade in or out.
+func Morph(a, b *Document, t float32) Vector {
+	return morph(a, b, t).Vector()
+}
+
+// morph returns the Document drawn by the Vector of Morph.
+func morph(a, b *Document, t float32) *Document {
+	overridesA, _ := a.snapshot()
+	overridesB, _ := b.snapshot()
+	return &Document{render: svgparser.Morph(a.render, overridesA, b.render, overridesB, float64(t))}
+}
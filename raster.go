@@ -0,0 +1,38 @@
+package giosvg
+
+import (
+	"image"
+	"image/color"
+
+	"gioui.org/f32"
+	"github.com/inkeliz/giosvg/internal/svgparser"
+	"github.com/inkeliz/giosvg/internal/svgraster"
+)
+
+// Rasterize draws the Document into a new image, without GPU, such as
+// for tray icons and notifications. The Document keeps the aspect ratio
+// of the viewBox, within the width and height. The `currentColor` is black,
+// see RasterizeTheme.
+func Rasterize(doc *Document, width, height int) *image.RGBA {
+	return RasterizeTheme(doc, Theme{}, color.NRGBA{A: 0xff}, width, height)
+}
+
+// RasterizeTheme is like Rasterize, but using the colors replaced by the
+// given Theme, and the given color as the `currentColor`.
+func RasterizeTheme(doc *Document, theme Theme, current color.NRGBA, width, height int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width <= 0 || height <= 0 {
+		return img
+	}
+
+	_, transform, scale := doc.target(Constraints{Max: f32.Pt(float32(width), float32(height))})
+	overrides, _ := doc.snapshot()
+	doc.render.DrawWith(&svgraster.Driver{Image: img, Scale: scale, Color: current}, svgparser.DrawOptions{
+		Transform: transform,
+		Opacity:   1.0,
+		Colors:    svgparser.ColorMap(theme.Colors),
+		Variables: theme.Variables,
+		Overrides: overrides,
+	})
+	return img
+}
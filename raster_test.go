@@ -0,0 +1,77 @@
+package giosvg
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestRasterize(t *testing.T) {
+	doc, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<defs>
+			<linearGradient id="g" x1="0" y1="0" x2="1" y2="0">
+				<stop offset="0" stop-color="red"/>
+				<stop offset="1" stop-color="blue"/>
+			</linearGradient>
+		</defs>
+		<path d="M10 10H50V50H10Z M20 20H40V40H20Z" fill="currentColor" fill-rule="evenodd"/>
+		<path d="M60 10V50" stroke="#00ff00" stroke-width="10"/>
+		<rect x="0" y="60" width="100" height="20" fill="url(#g)"/>
+		<rect x="0" y="90" width="100" height="10" fill="red" fill-opacity="0.5"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	img := RasterizeTheme(doc, Theme{}, color.NRGBA{B: 0xff, A: 0xff}, 200, 200)
+	if img.Bounds().Dx() != 200 || img.Bounds().Dy() != 200 {
+		t.Fatalf("unexpected bounds %v", img.Bounds())
+	}
+	checkPixels(t, img, []pixelProbe{
+		{30, 30, color.RGBA{B: 0xff, A: 0xff}},          // current color
+		{60, 60, color.RGBA{}},                          // even-odd hole
+		{120, 50, color.RGBA{G: 0xff, A: 0xff}},         // stroke
+		{106, 50, color.RGBA{}},                         // outside of the stroke
+		{1, 140, color.RGBA{R: 0xfe, B: 0x01, A: 0xff}}, // start of the gradient
+		{198, 140, color.RGBA{R: 0x01, B: 0xfe, A: 0xff}},
+		{100, 190, color.RGBA{R: 0x80, A: 0x80}}, // opacity
+	})
+
+	// The even-odd rule applies to self-intersecting paths too.
+	star, err := NewDocument([]byte(`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 100 100">
+		<path d="M50 0 L80 100 L0 35 H100 L20 100 Z" fill-rule="evenodd"/>
+	</svg>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	img = Rasterize(star, 100, 100)
+	checkPixels(t, img, []pixelProbe{
+		{50, 55, color.RGBA{}},        // the center of the star
+		{50, 20, color.RGBA{A: 0xff}}, // the tip of the star
+	})
+
+	if img := Rasterize(doc, 0, 10); img.Bounds().Dx() != 0 {
+		t.Errorf("expected the empty image, got %v", img.Bounds())
+	}
+}
+
+func similarColor(a, b color.RGBA) bool {
+	d := func(x, y uint8) bool { return x-y < 3 || y-x < 3 }
+	return d(a.R, b.R) && d(a.G, b.G) && d(a.B, b.B) && d(a.A, b.A)
+}
+
+// pixelProbe is the expected color of the pixel at x, y.
+type pixelProbe struct {
+	x, y     int
+	expected color.RGBA
+}
+
+// checkPixels compares the color of each probe, see similarColor.
+func checkPixels(t *testing.T, img *image.RGBA, probes []pixelProbe) {
+	t.Helper()
+	for _, probe := range probes {
+		if got := img.RGBAAt(probe.x, probe.y); !similarColor(got, probe.expected) {
+			t.Errorf("expected %v at %d, %d, got %v", probe.expected, probe.x, probe.y, got)
+		}
+	}
+}